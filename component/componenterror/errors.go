@@ -26,4 +26,11 @@ var (
 	// factory methods that create the entity if the particular telemetry
 	// data type is not supported by the receiver, exporter or processor.
 	ErrDataTypeIsNotSupported = errors.New("telemetry type is not supported")
+
+	// ErrUnknownKind is returned by the hosts when a factory of a component.Kind they don't know is requested.
+	ErrUnknownKind = errors.New("unknown component kind")
+
+	// ErrFactoryNotFound is returned by the hosts when no factory is registered for the requested
+	// component type of a known component.Kind.
+	ErrFactoryNotFound = errors.New("factory not found")
 )
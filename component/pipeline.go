@@ -0,0 +1,30 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package component // import "go.opentelemetry.io/collector/component"
+
+import (
+	"go.opentelemetry.io/collector/config"
+)
+
+// PipelineInfo describes the shape of a built pipeline by the IDs of its components.
+// This is an experimental type that may change or even be removed completely.
+type PipelineInfo struct {
+	// Receivers are the receivers sending data to the pipeline.
+	Receivers []config.ComponentID
+	// Processors are the processors of the pipeline, in the order the data goes through them.
+	Processors []config.ComponentID
+	// Exporters are the exporters receiving data from the pipeline.
+	Exporters []config.ComponentID
+}
@@ -0,0 +1,82 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package component // import "go.opentelemetry.io/collector/component"
+
+import (
+	"go.opentelemetry.io/collector/config"
+)
+
+// Status represents the status of a component as reported to the host.
+// This is an experimental type that may change or even be removed completely.
+type Status int
+
+const (
+	_ Status = iota // skip 0, start statuses from 1.
+	// StatusStarting indicates that the component is starting.
+	StatusStarting
+	// StatusHealthy indicates that the component is running as expected.
+	StatusHealthy
+	// StatusRecoverableError indicates that the component encountered an error that it may recover from.
+	StatusRecoverableError
+	// StatusPermanentError indicates that the component encountered an error that it can't recover from.
+	StatusPermanentError
+)
+
+// String returns the string representation of the Status.
+func (s Status) String() string {
+	switch s {
+	case StatusStarting:
+		return "Starting"
+	case StatusHealthy:
+		return "Healthy"
+	case StatusRecoverableError:
+		return "RecoverableError"
+	case StatusPermanentError:
+		return "PermanentError"
+	}
+	return ""
+}
+
+// StatusEvent is the last status reported by a component, with the error that caused it if any.
+// This is an experimental type that may change or even be removed completely.
+type StatusEvent struct {
+	Status Status
+	Err    error
+}
+
+// AggregateStatus returns the most severe status of the given events, where errors are
+// more severe than StatusStarting, which is more severe than StatusHealthy.
+// Returns StatusHealthy if there are no events.
+func AggregateStatus(events map[config.ComponentID]StatusEvent) Status {
+	agg := StatusHealthy
+	for _, ev := range events {
+		if statusSeverity(ev.Status) > statusSeverity(agg) {
+			agg = ev.Status
+		}
+	}
+	return agg
+}
+
+func statusSeverity(s Status) int {
+	switch s {
+	case StatusStarting:
+		return 1
+	case StatusRecoverableError:
+		return 2
+	case StatusPermanentError:
+		return 3
+	}
+	return 0
+}
@@ -0,0 +1,48 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package component
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/collector/config"
+)
+
+func TestStatusString(t *testing.T) {
+	assert.Equal(t, "Starting", StatusStarting.String())
+	assert.Equal(t, "Healthy", StatusHealthy.String())
+	assert.Equal(t, "RecoverableError", StatusRecoverableError.String())
+	assert.Equal(t, "PermanentError", StatusPermanentError.String())
+	assert.Equal(t, "", Status(0).String())
+}
+
+func TestAggregateStatus(t *testing.T) {
+	assert.Equal(t, StatusHealthy, AggregateStatus(nil))
+
+	events := map[config.ComponentID]StatusEvent{
+		config.NewComponentID("a"): {Status: StatusHealthy},
+		config.NewComponentID("b"): {Status: StatusStarting},
+	}
+	assert.Equal(t, StatusStarting, AggregateStatus(events))
+
+	events[config.NewComponentID("c")] = StatusEvent{Status: StatusRecoverableError, Err: errors.New("retry")}
+	assert.Equal(t, StatusRecoverableError, AggregateStatus(events))
+
+	events[config.NewComponentID("d")] = StatusEvent{Status: StatusPermanentError, Err: errors.New("fatal")}
+	assert.Equal(t, StatusPermanentError, AggregateStatus(events))
+}
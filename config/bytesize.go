@@ -0,0 +1,112 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config // import "go.opentelemetry.io/collector/config"
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// ByteSize is a number of bytes, which can be configured as an integer or as a human readable string,
+// e.g. "512KiB", "10MiB" or "1.5GB", see ParseByteSize.
+type ByteSize int64
+
+// Byte size units.
+const (
+	Byte     ByteSize = 1
+	Kilobyte          = 1000 * Byte
+	Megabyte          = 1000 * Kilobyte
+	Gigabyte          = 1000 * Megabyte
+	Terabyte          = 1000 * Gigabyte
+	Kibibyte          = 1024 * Byte
+	Mebibyte          = 1024 * Kibibyte
+	Gibibyte          = 1024 * Mebibyte
+	Tebibyte          = 1024 * Gibibyte
+)
+
+// byteSizeUnits are the supported units, in the order they are listed in the errors.
+var byteSizeUnits = []struct {
+	name string
+	size ByteSize
+}{
+	{"B", Byte},
+	{"KB", Kilobyte},
+	{"MB", Megabyte},
+	{"GB", Gigabyte},
+	{"TB", Terabyte},
+	{"KiB", Kibibyte},
+	{"MiB", Mebibyte},
+	{"GiB", Gibibyte},
+	{"TiB", Tebibyte},
+}
+
+// ParseByteSize parses a number of bytes, optionally followed by a SI unit (KB, MB, GB, TB, powers of 1000)
+// or an IEC unit (KiB, MiB, GiB, TiB, powers of 1024), e.g. "10MiB" or "1.5 GB". Units are case-sensitive, so
+// that bits are never mistaken for bytes, e.g. "10Gb" is an error. Fractional sizes are rounded down to a
+// whole number of bytes.
+func ParseByteSize(s string) (ByteSize, error) {
+	str := strings.TrimSpace(s)
+	i := strings.IndexFunc(str, func(r rune) bool {
+		return (r < '0' || r > '9') && r != '.'
+	})
+	number, unit := str, ""
+	if i >= 0 {
+		number, unit = str[:i], strings.TrimSpace(str[i:])
+	}
+	value, err := strconv.ParseFloat(number, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid byte size %q: expected a non-negative number followed by an optional unit", s)
+	}
+
+	multiplier, ok := byteSizeUnit(unit)
+	if !ok {
+		names := make([]string, 0, len(byteSizeUnits))
+		for _, u := range byteSizeUnits {
+			names = append(names, u.name)
+		}
+		return 0, fmt.Errorf("invalid byte size %q: unknown unit %q, valid units are %s", s, unit, strings.Join(names, ", "))
+	}
+
+	size := value * float64(multiplier)
+	if size >= math.MaxInt64 {
+		return 0, fmt.Errorf("invalid byte size %q: overflows int64", s)
+	}
+	return ByteSize(size), nil
+}
+
+// byteSizeUnit returns the size of the unit, a number without unit is a number of bytes.
+func byteSizeUnit(unit string) (ByteSize, bool) {
+	if unit == "" {
+		return Byte, true
+	}
+	for _, u := range byteSizeUnits {
+		if u.name == unit {
+			return u.size, true
+		}
+	}
+	return 0, false
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface, parsing the text with ParseByteSize.
+func (bs *ByteSize) UnmarshalText(text []byte) error {
+	size, err := ParseByteSize(string(text))
+	if err != nil {
+		return err
+	}
+	*bs = size
+	return nil
+}
@@ -0,0 +1,81 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseByteSize(t *testing.T) {
+	var testCases = []struct {
+		str      string
+		expected ByteSize
+	}{
+		{str: "0", expected: 0},
+		{str: "1024", expected: 1024},
+		{str: "10B", expected: 10},
+		{str: "10KB", expected: 10 * 1000},
+		{str: "10MB", expected: 10 * 1000 * 1000},
+		{str: "1.5GB", expected: 1500 * 1000 * 1000},
+		{str: "2TB", expected: 2 * 1000 * 1000 * 1000 * 1000},
+		{str: "512KiB", expected: 512 * 1024},
+		{str: "10MiB", expected: 10 * 1024 * 1024},
+		{str: " 1 GiB ", expected: 1024 * 1024 * 1024},
+		{str: "1TiB", expected: 1024 * 1024 * 1024 * 1024},
+		{str: "1.5B", expected: 1},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.str, func(t *testing.T) {
+			size, err := ParseByteSize(tt.str)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, size)
+		})
+	}
+}
+
+func TestParseByteSizeInvalid(t *testing.T) {
+	_, err := ParseByteSize("10Gb")
+	assert.EqualError(t, err, `invalid byte size "10Gb": unknown unit "Gb", valid units are B, KB, MB, GB, TB, KiB, MiB, GiB, TiB`)
+	_, err = ParseByteSize("10GBs")
+	assert.EqualError(t, err, `invalid byte size "10GBs": unknown unit "GBs", valid units are B, KB, MB, GB, TB, KiB, MiB, GiB, TiB`)
+
+	for _, str := range []string{"", "MiB", "-10MiB", "1.2.3KB", "10000000TiB"} {
+		_, err = ParseByteSize(str)
+		assert.Error(t, err, str)
+	}
+}
+
+type TestByteSizeConfig struct {
+	Limit ByteSize `mapstructure:"limit"`
+	Spike ByteSize `mapstructure:"spike"`
+}
+
+func TestByteSizeUnmarshal(t *testing.T) {
+	cfgMap := NewMapFromStringMap(map[string]interface{}{
+		"limit": "4GiB",
+		"spike": 1024,
+	})
+	cfg := &TestByteSizeConfig{}
+	require.NoError(t, cfgMap.UnmarshalExact(cfg))
+	assert.Equal(t, &TestByteSizeConfig{Limit: 4 * Gibibyte, Spike: Kibibyte}, cfg)
+
+	cfgMap = NewMapFromStringMap(map[string]interface{}{"limit": "4 gigs"})
+	err := cfgMap.UnmarshalExact(&TestByteSizeConfig{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `invalid byte size "4 gigs": unknown unit "gigs"`)
+}
@@ -273,6 +273,8 @@ func (gss *GRPCServerSettings) ToListener() (net.Listener, error) {
 }
 
 // ToServerOption maps configgrpc.GRPCServerSettings to a slice of server options for gRPC.
+// The returned options include a server interceptor that starts a span for every incoming call using
+// settings.TracerProvider, so handlers can link their own spans to it via trace.SpanContextFromContext(ctx).
 func (gss *GRPCServerSettings) ToServerOption(host component.Host, settings component.TelemetrySettings) ([]grpc.ServerOption, error) {
 	var opts []grpc.ServerOption
 
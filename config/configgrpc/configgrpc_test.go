@@ -27,7 +27,11 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/peer"
 
@@ -641,6 +645,45 @@ func TestReceiveOnUnixDomainSocket(t *testing.T) {
 	s.Stop()
 }
 
+func TestServerSpanContextInHandler(t *testing.T) {
+	sr := new(tracetest.SpanRecorder)
+	set := componenttest.NewNopTelemetrySettings()
+	set.TracerProvider = sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(sr))
+
+	gss := &GRPCServerSettings{
+		NetAddr: confignet.NetAddr{
+			Endpoint:  "localhost:0",
+			Transport: "tcp",
+		},
+	}
+	ln, err := gss.ToListener()
+	require.NoError(t, err)
+	opts, err := gss.ToServerOption(componenttest.NewNopHost(), set)
+	require.NoError(t, err)
+	s := grpc.NewServer(opts...)
+	mock := &grpcTraceServer{}
+	ptraceotlp.RegisterServer(s, mock)
+
+	go func() {
+		_ = s.Serve(ln)
+	}()
+	defer s.Stop()
+
+	cc, err := grpc.Dial(ln.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithBlock())
+	require.NoError(t, err)
+	defer cc.Close()
+	client := ptraceotlp.NewClient(cc)
+	ctx, cancelFunc := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancelFunc()
+	_, err = client.Export(ctx, ptraceotlp.NewRequest())
+	require.NoError(t, err)
+
+	sc := trace.SpanContextFromContext(mock.recordedContext)
+	assert.True(t, sc.IsValid())
+	require.Len(t, sr.Ended(), 1)
+	assert.Equal(t, sr.Ended()[0].SpanContext(), sc)
+}
+
 func TestContextWithClient(t *testing.T) {
 	testCases := []struct {
 		desc       string
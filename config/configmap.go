@@ -15,15 +15,29 @@
 package config // import "go.opentelemetry.io/collector/config"
 
 import (
+	"bytes"
 	"context"
 	"encoding"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"os"
 	"reflect"
+	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/knadh/koanf"
 	"github.com/knadh/koanf/maps"
 	"github.com/knadh/koanf/providers/confmap"
 	"github.com/mitchellh/mapstructure"
+	"gopkg.in/yaml.v2"
+	yamlv3 "gopkg.in/yaml.v3"
+
+	"go.opentelemetry.io/collector/config/internal/yamlerror"
 )
 
 const (
@@ -35,23 +49,289 @@ const (
 // (in the future components as well) to build backwards compatible config converters.
 type MapConverterFunc func(context.Context, *Map) error
 
+// MapOption represents the possible options for the config.Map constructors.
+type MapOption func(*Map)
+
+// WithLowercaseKeys lowercases all the keys when they are loaded, set or merged into the config.Map,
+// and the keys passed to Get, IsSet, Set and Sub, so that lookups and merges are case-insensitive.
+// When two keys differ only in case, nested maps are merged and for other values the key that
+// comes last in lexicographic order wins, e.g. "receivers" wins over "Receivers".
+// It is disabled by default because component names, e.g. in the service pipelines, are case-sensitive.
+func WithLowercaseKeys() MapOption {
+	return func(l *Map) {
+		l.lowercaseKeys = true
+	}
+}
+
 // NewMap creates a new empty config.Map instance.
-func NewMap() *Map {
-	return &Map{k: koanf.New(KeyDelimiter)}
+func NewMap(opts ...MapOption) *Map {
+	p := &Map{k: koanf.New(KeyDelimiter), keyIndex: map[string]struct{}{}}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
 }
 
 // NewMapFromStringMap creates a config.Map from a map[string]interface{}.
-func NewMapFromStringMap(data map[string]interface{}) *Map {
-	p := NewMap()
+func NewMapFromStringMap(data map[string]interface{}, opts ...MapOption) *Map {
+	p := NewMap(opts...)
 	// Cannot return error because the koanf instance is empty.
-	_ = p.k.Load(confmap.Provider(data, KeyDelimiter), nil)
+	_ = p.k.Load(confmap.Provider(p.normalizeValue(data).(map[string]interface{}), KeyDelimiter), nil)
+	p.trackKeys(p.k.Keys())
 	return p
 }
 
+// NewMapFromFlatMap creates a config.Map from a flat map[string]interface{}, where every key is the path
+// to the value with the path elements separated by sep, e.g. "typed.options.integer.example". It is the
+// inverse of Map.Flatten: nested maps whose keys are exactly the indexes 0 to n-1 are converted to slices.
+func NewMapFromFlatMap(data map[string]interface{}, sep string) *Map {
+	nested := map[string]interface{}{}
+	for key, value := range data {
+		parts := strings.Split(key, sep)
+		m := nested
+		for _, part := range parts[:len(parts)-1] {
+			sub, ok := m[part].(map[string]interface{})
+			if !ok {
+				sub = map[string]interface{}{}
+				m[part] = sub
+			}
+			m = sub
+		}
+		m[parts[len(parts)-1]] = value
+	}
+	return NewMapFromStringMap(indexedMapsToSlices(nested).(map[string]interface{}))
+}
+
+// indexedMapsToSlices recursively converts the maps whose keys are exactly the indexes 0 to n-1 to slices.
+func indexedMapsToSlices(value interface{}) interface{} {
+	m, ok := value.(map[string]interface{})
+	if !ok {
+		return value
+	}
+	for k, v := range m {
+		m[k] = indexedMapsToSlices(v)
+	}
+	if len(m) == 0 {
+		return m
+	}
+	slice := make([]interface{}, len(m))
+	for k, v := range m {
+		i, err := strconv.Atoi(k)
+		if err != nil || i < 0 || i >= len(m) || strconv.Itoa(i) != k {
+			return m
+		}
+		slice[i] = v
+	}
+	return slice
+}
+
+// NewMapFromReader creates a config.Map by reading all the content from the given io.Reader.
+// The content can be either YAML or JSON. JSON content is decoded with JSON number semantics,
+// so integer numbers are returned as int64 and all other numbers as float64.
+//
+// YAML anchors, aliases and merge keys (`<<: *anchor`) are resolved: the merged entries are
+// expanded into the map, and the keys defined next to the merge key override the merged ones.
+//
+// An error is returned if the same key is defined more than once in the same map, since the
+// last value would silently replace the previous ones.
+func NewMapFromReader(r io.Reader, opts ...MapOption) (*Map, error) {
+	content, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the config: %w", err)
+	}
+
+	if err = checkDuplicateKeys(content); err != nil {
+		return nil, err
+	}
+
+	if trimmed := bytes.TrimSpace(content); len(trimmed) > 0 && trimmed[0] == '{' && json.Valid(trimmed) {
+		dec := json.NewDecoder(bytes.NewReader(trimmed))
+		dec.UseNumber()
+		var data map[string]interface{}
+		if err = dec.Decode(&data); err != nil {
+			return nil, fmt.Errorf("unable to parse json: %w", err)
+		}
+		return newOrderedMap(convertJSONNumbers(data).(map[string]interface{}), trimmed, opts), nil
+	}
+
+	var data map[string]interface{}
+	if err = yaml.Unmarshal(content, &data); err != nil {
+		return nil, fmt.Errorf("unable to parse yaml: %w", yamlerror.Wrap(content, err))
+	}
+	return newOrderedMap(data, content, opts), nil
+}
+
+// checkDuplicateKeys returns an error naming the key and the line of the first key defined more
+// than once in the same map. Keys merged with merge keys (`<<: *anchor`) are allowed to be overridden.
+func checkDuplicateKeys(content []byte) error {
+	var root yamlv3.Node
+	if err := yamlv3.Unmarshal(content, &root); err != nil {
+		return fmt.Errorf("unable to parse yaml: %w", yamlerror.Wrap(content, err))
+	}
+	return checkNodeDuplicateKeys(&root, "")
+}
+
+func checkNodeDuplicateKeys(node *yamlv3.Node, prefix string) error {
+	switch node.Kind {
+	case yamlv3.DocumentNode, yamlv3.SequenceNode:
+		for i, child := range node.Content {
+			childPrefix := prefix
+			if node.Kind == yamlv3.SequenceNode {
+				childPrefix = joinKey(prefix, strconv.Itoa(i))
+			}
+			if err := checkNodeDuplicateKeys(child, childPrefix); err != nil {
+				return err
+			}
+		}
+	case yamlv3.MappingNode:
+		lines := map[string]int{}
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			keyNode, valueNode := node.Content[i], node.Content[i+1]
+			if keyNode.Tag == "!!merge" {
+				continue
+			}
+			key := joinKey(prefix, keyNode.Value)
+			if line, ok := lines[keyNode.Value]; ok {
+				return fmt.Errorf("duplicate key %q at line %d, first defined at line %d", key, keyNode.Line, line)
+			}
+			lines[keyNode.Value] = keyNode.Line
+			if err := checkNodeDuplicateKeys(valueNode, key); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func joinKey(prefix, key string) string {
+	if prefix == "" {
+		return key
+	}
+	return prefix + KeyDelimiter + key
+}
+
+// newOrderedMap creates a config.Map from data, remembering the order in which the keys appear in content.
+func newOrderedMap(data map[string]interface{}, content []byte, opts []MapOption) *Map {
+	p := NewMap(opts...)
+	// Cannot return error because the koanf instance is empty.
+	_ = p.k.Load(confmap.Provider(p.normalizeValue(data).(map[string]interface{}), KeyDelimiter), nil)
+	var ms yaml.MapSlice
+	if err := yaml.Unmarshal(content, &ms); err == nil {
+		for _, k := range flattenMapSliceKeys(ms, "", nil) {
+			p.trackKeys([]string{p.normalizeKey(k)})
+		}
+	}
+	p.trackKeys(p.k.Keys())
+	// Drop the keys of the document that do not hold a value, e.g. the merge keys.
+	p.pruneKeys()
+	return p
+}
+
+func flattenMapSliceKeys(ms yaml.MapSlice, prefix string, keys []string) []string {
+	for _, item := range ms {
+		key := fmt.Sprint(item.Key)
+		if prefix != "" {
+			key = prefix + KeyDelimiter + key
+		}
+		if sub, ok := item.Value.(yaml.MapSlice); ok && len(sub) > 0 {
+			keys = flattenMapSliceKeys(sub, key, keys)
+			continue
+		}
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// convertJSONNumbers replaces all the json.Number values with either an int64 or a float64.
+func convertJSONNumbers(value interface{}) interface{} {
+	switch v := value.(type) {
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i
+		}
+		// Cannot fail since the number was already validated by the decoder.
+		f, _ := v.Float64()
+		return f
+	case []interface{}:
+		for i := range v {
+			v[i] = convertJSONNumbers(v[i])
+		}
+		return v
+	case map[string]interface{}:
+		for k := range v {
+			v[k] = convertJSONNumbers(v[k])
+		}
+		return v
+	default:
+		return v
+	}
+}
+
 // Map represents the raw configuration map for the OpenTelemetry Collector.
 // The config.Map can be unmarshalled into the Collector's config using the "configunmarshaler" package.
 type Map struct {
 	k *koanf.Koanf
+	// keys remembers the insertion order of the keys holding a value, and keyIndex holds the same keys.
+	// Both are kept up to date by every method modifying k, so that reading them is safe concurrently.
+	keys     []string
+	keyIndex map[string]struct{}
+	// lowercaseKeys is set by WithLowercaseKeys.
+	lowercaseKeys bool
+}
+
+// normalizeKey returns the key lowercased if WithLowercaseKeys is enabled, otherwise the key as is.
+func (l *Map) normalizeKey(key string) string {
+	if l.lowercaseKeys {
+		return strings.ToLower(key)
+	}
+	return key
+}
+
+// normalizeValue returns a copy of the value with all the nested map keys lowercased if WithLowercaseKeys
+// is enabled, otherwise the value as is.
+func (l *Map) normalizeValue(value interface{}) interface{} {
+	if l.lowercaseKeys {
+		return lowercaseMapKeys(value)
+	}
+	return value
+}
+
+func lowercaseMapKeys(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		// Sort the keys to deterministically choose the value when keys differ only in case.
+		sort.Strings(keys)
+		ret := make(map[string]interface{}, len(v))
+		for _, k := range keys {
+			lk := strings.ToLower(k)
+			lv := lowercaseMapKeys(v[k])
+			if existing, ok := ret[lk].(map[string]interface{}); ok {
+				if lvMap, ok := lv.(map[string]interface{}); ok {
+					maps.Merge(lvMap, existing)
+					continue
+				}
+			}
+			ret[lk] = lv
+		}
+		return ret
+	case map[interface{}]interface{}:
+		strMap := make(map[string]interface{}, len(v))
+		for k, mv := range v {
+			strMap[fmt.Sprint(k)] = mv
+		}
+		return lowercaseMapKeys(strMap)
+	case []interface{}:
+		ret := make([]interface{}, len(v))
+		for i, sv := range v {
+			ret[i] = lowercaseMapKeys(sv)
+		}
+		return ret
+	}
+	return value
 }
 
 // AllKeys returns all keys holding a value, regardless of where they are set.
@@ -60,10 +340,103 @@ func (l *Map) AllKeys() []string {
 	return l.k.Keys()
 }
 
+// Keys returns all keys holding a value in insertion order.
+// Nested keys are returned with a KeyDelimiter separator.
+//
+// The order is the one in which keys appear in the source document for a Map created with NewMapFromReader.
+// Keys added with Set or Merge are appended at the end, in lexical order when added at the same time.
+// Keys of a Map created from a map[string]interface{} are in lexical order.
+func (l *Map) Keys() []string {
+	return append([]string(nil), l.keys...)
+}
+
+// trackKeys appends to the insertion order all the given keys that are not yet tracked.
+func (l *Map) trackKeys(keys []string) {
+	for _, k := range keys {
+		if _, ok := l.keyIndex[k]; !ok {
+			l.keyIndex[k] = struct{}{}
+			l.keys = append(l.keys, k)
+		}
+	}
+}
+
+// pruneKeys removes from the insertion order the keys that no longer hold a value,
+// because they were deleted or replaced by a map, or their parent map was replaced.
+func (l *Map) pruneKeys() {
+	all := l.k.Keys()
+	exists := make(map[string]struct{}, len(all))
+	for _, k := range all {
+		exists[k] = struct{}{}
+	}
+	keys := l.keys[:0]
+	for _, k := range l.keys {
+		if _, ok := exists[k]; ok {
+			keys = append(keys, k)
+		} else {
+			delete(l.keyIndex, k)
+		}
+	}
+	l.keys = keys
+}
+
+// replacesKeys returns true if setting the value for the key removes keys holding a value: the nested
+// keys if the key holds a map, the key itself if the value is a map, or a parent key not holding a map.
+func (l *Map) replacesKeys(key string, value interface{}) bool {
+	_, tracked := l.keyIndex[key]
+	if !tracked && l.k.Exists(key) {
+		return true
+	}
+	if _, isMap := value.(map[string]interface{}); tracked && isMap {
+		return true
+	}
+	for i := 0; i < len(key); i++ {
+		if strings.HasPrefix(key[i:], KeyDelimiter) {
+			if _, ok := l.keyIndex[key[:i]]; ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+type unmarshalSettings struct {
+	weaklyTypedInput bool
+	tagName          string
+}
+
+// UnmarshalOption represents the possible options for Map.Unmarshal and Map.UnmarshalExact.
+type UnmarshalOption func(*unmarshalSettings)
+
+// WithWeaklyTypedInput enables or disables the weak conversions between config field values and
+// primitive types, e.g. the string "true" into a bool field or the string "10" into an int field.
+// Weakly typed input is enabled by default, since expanded environment variables are always strings.
+func WithWeaklyTypedInput(enabled bool) UnmarshalOption {
+	return func(set *unmarshalSettings) {
+		set.weaklyTypedInput = enabled
+	}
+}
+
+// WithTagName sets the name of the struct field tag used to read the keys of the fields, e.g. "json"
+// to reuse structs already tagged for encoding/json. It defaults to "mapstructure". The options of the
+// tag, e.g. ",squash", are the ones supported by mapstructure, whatever the tag name.
+func WithTagName(name string) UnmarshalOption {
+	return func(set *unmarshalSettings) {
+		set.tagName = name
+	}
+}
+
 // Unmarshal unmarshalls the config into a struct.
 // Tags on the fields of the structure must be properly set.
-func (l *Map) Unmarshal(rawVal interface{}) error {
-	decoder, err := mapstructure.NewDecoder(decoderConfig(rawVal))
+// Fields of type time.Duration are parsed from strings like "30s" or "1m30s",
+// invalid durations return an error containing the key of the field.
+// An error is returned if the same key is declared by two fields, e.g. by two squashed structs.
+//
+// Integers in YAML are loaded as int, and in JSON as int64, and both can be decoded into any integer or
+// float field. Numbers with a decimal point or an exponent, e.g. 1.0 or 3.14, are loaded as float64 and
+// can be decoded into an integer field only if they have no fractional part and fit in the field type,
+// otherwise an error is returned instead of truncating them.
+func (l *Map) Unmarshal(rawVal interface{}, opts ...UnmarshalOption) error {
+	decoder, err := mapstructure.NewDecoder(decoderConfig(rawVal, opts...))
 	if err != nil {
 		return err
 	}
@@ -71,8 +444,8 @@ func (l *Map) Unmarshal(rawVal interface{}) error {
 }
 
 // UnmarshalExact unmarshalls the config into a struct, erroring if a field is nonexistent.
-func (l *Map) UnmarshalExact(rawVal interface{}) error {
-	dc := decoderConfig(rawVal)
+func (l *Map) UnmarshalExact(rawVal interface{}, opts ...UnmarshalOption) error {
+	dc := decoderConfig(rawVal, opts...)
 	dc.ErrorUnused = true
 	decoder, err := mapstructure.NewDecoder(dc)
 	if err != nil {
@@ -83,64 +456,522 @@ func (l *Map) UnmarshalExact(rawVal interface{}) error {
 
 // Get can retrieve any value given the key to use.
 func (l *Map) Get(key string) interface{} {
-	return l.k.Get(key)
+	return l.k.Get(l.normalizeKey(key))
+}
+
+// GetValue returns the value for the key, descending the KeyDelimiter separated path elements of the key
+// through the nested maps, e.g. "service::telemetry::logs::level". It returns nil if the key is not set,
+// and an error if a value along the path, other than the last one, is not a map.
+func (l *Map) GetValue(key string) (interface{}, error) {
+	var value interface{} = l.ToStringMap()
+	path := strings.Split(l.normalizeKey(key), KeyDelimiter)
+	for i, name := range path {
+		m, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("config key %q is not a map", strings.Join(path[:i], KeyDelimiter))
+		}
+		if value, ok = m[name]; !ok {
+			return nil, nil
+		}
+	}
+	return value, nil
+}
+
+// GetString returns the value for the key and true if it is a string, otherwise an empty string and false.
+func (l *Map) GetString(key string) (string, bool) {
+	v, ok := l.Get(key).(string)
+	return v, ok
+}
+
+// GetInt returns the value for the key and true if it is an integer, otherwise 0 and false.
+// Values of any integer type are accepted, as long as they fit in an int.
+func (l *Map) GetInt(key string) (int, bool) {
+	rv := reflect.ValueOf(l.Get(key))
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i := rv.Int()
+		if int64(int(i)) != i {
+			return 0, false
+		}
+		return int(i), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u := rv.Uint()
+		if u > math.MaxInt64 || uint64(int(u)) != u {
+			return 0, false
+		}
+		return int(u), true
+	}
+	return 0, false
+}
+
+// GetBool returns the value for the key and true if it is a bool, otherwise false and false.
+func (l *Map) GetBool(key string) (bool, bool) {
+	v, ok := l.Get(key).(bool)
+	return v, ok
+}
+
+// GetStringSlice returns the value for the key and true if it is a slice containing only strings,
+// otherwise nil and false.
+func (l *Map) GetStringSlice(key string) ([]string, bool) {
+	switch v := l.Get(key).(type) {
+	case []string:
+		return v, true
+	case []interface{}:
+		ret := make([]string, 0, len(v))
+		for _, e := range v {
+			str, ok := e.(string)
+			if !ok {
+				return nil, false
+			}
+			ret = append(ret, str)
+		}
+		return ret, true
+	}
+	return nil, false
 }
 
 // Set sets the value for the key.
 func (l *Map) Set(key string, value interface{}) {
+	key = l.normalizeKey(key)
+	value = l.normalizeValue(value)
+	prune := l.replacesKeys(key, value)
 	// koanf doesn't offer a direct setting mechanism so merging is required.
 	merged := koanf.New(KeyDelimiter)
 	_ = merged.Load(confmap.Provider(map[string]interface{}{key: value}, KeyDelimiter), nil)
 	// TODO (issue 4467): return this error on `Set`.
 	_ = l.k.Merge(merged)
+	if prune {
+		l.pruneKeys()
+	}
+	l.trackKeys(merged.Keys())
+}
+
+// Delete removes the value for the key, including all the nested values if the key holds a map,
+// and then removes the parent maps left empty. It returns false if the key was not set.
+func (l *Map) Delete(key string) bool {
+	key = l.normalizeKey(key)
+	if key == "" || !l.k.Exists(key) {
+		return false
+	}
+	l.k.Delete(key)
+	l.pruneKeys()
+	return true
 }
 
 // IsSet checks to see if the key has been set in any of the data locations.
 // IsSet is case-insensitive for a key.
 func (l *Map) IsSet(key string) bool {
-	return l.k.Exists(key)
+	return l.k.Exists(l.normalizeKey(key))
+}
+
+type mergeSettings struct {
+	appendSlices bool
+}
+
+// MergeOption represents the possible options for Map.Merge.
+type MergeOption func(*mergeSettings)
+
+// WithMergeAppendSlices appends the slices from the input configuration to the slices
+// with the same key in the existing config, instead of replacing them.
+func WithMergeAppendSlices() MergeOption {
+	return func(set *mergeSettings) {
+		set.appendSlices = true
+	}
 }
 
 // Merge merges the input given configuration into the existing config.
+// In case of conflicts the input configuration wins: nested maps are merged key by key,
+// while any other value, including slices, is replaced by the one in the input configuration.
 // Note that the given map may be modified.
-func (l *Map) Merge(in *Map) error {
-	return l.k.Merge(in.k)
+// If WithLowercaseKeys is enabled, the keys of the input configuration are lowercased before merging.
+func (l *Map) Merge(in *Map, opts ...MergeOption) error {
+	set := mergeSettings{}
+	for _, opt := range opts {
+		opt(&set)
+	}
+	if l.lowercaseKeys && !in.lowercaseKeys {
+		lowered := NewMapFromStringMap(in.ToStringMap(), WithLowercaseKeys())
+		lowered.keys, lowered.keyIndex = nil, map[string]struct{}{}
+		for _, k := range in.Keys() {
+			lowered.trackKeys([]string{strings.ToLower(k)})
+		}
+		in = lowered
+	}
+	if set.appendSlices {
+		for _, k := range in.AllKeys() {
+			inSlice, ok := in.Get(k).([]interface{})
+			if !ok {
+				continue
+			}
+			if slice, ok := l.Get(k).([]interface{}); ok {
+				in.Set(k, append(append(make([]interface{}, 0, len(slice)+len(inSlice)), slice...), inSlice...))
+			}
+		}
+	}
+	if err := l.k.Merge(in.k); err != nil {
+		return err
+	}
+	l.pruneKeys()
+	l.trackKeys(in.Keys())
+	return nil
+}
+
+// OverlayEnv sets the keys named by the environment variables starting with prefix, e.g. "OTELCOL_", to their values,
+// overriding the values already set. The rest of the variable name is lowercased and every "_" separates two
+// elements of the key path, while "__" stands for a "_" within an element, so OTELCOL_EXPORTERS_OTLP_ENDPOINT sets
+// "exporters::otlp::endpoint" and OTELCOL_PROCESSORS_BATCH_SEND__BATCH__SIZE sets "processors::batch::send_batch_size".
+// Keys with uppercase letters or other characters, e.g. "otlp/2", cannot be overridden.
+//
+// Values are parsed as YAML, so "true", "10" and "[a, b]" are set as a bool, an int and a slice. Any other value is
+// set as the string as is, including numbers that YAML would rewrite, e.g. "0123", and values that YAML would
+// drop, e.g. "" or "#abc". Variables with an empty element in the key path are ignored.
+//
+// A broad prefix also picks up the variables meant for other components, e.g. "OTEL_" picks up the
+// OpenTelemetry SDK variables OTEL_RESOURCE_ATTRIBUTES and OTEL_EXPORTER_OTLP_ENDPOINT, which are then set
+// as the bogus keys "resource::attributes" and "exporter::otlp::endpoint". Prefer a prefix dedicated to the
+// collector config.
+func (l *Map) OverlayEnv(prefix string) {
+	var names []string
+	for _, env := range os.Environ() {
+		if name := strings.SplitN(env, "=", 2)[0]; strings.HasPrefix(name, prefix) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		key, ok := envVarKey(name[len(prefix):])
+		if !ok {
+			continue
+		}
+		l.Set(key, envValue(os.Getenv(name)))
+	}
+}
+
+// envValue returns the value parsed as YAML if it is a bool, a number or a sequence of scalars, see Map.OverlayEnv,
+// and the value as is otherwise, including when it is empty or only a YAML comment, e.g. "#abc".
+func envValue(value string) interface{} {
+	var doc yamlv3.Node
+	if err := yamlv3.Unmarshal([]byte(value), &doc); err != nil || len(doc.Content) != 1 {
+		return value
+	}
+	node := doc.Content[0]
+	switch node.Kind {
+	case yamlv3.ScalarNode:
+		if v, ok := envScalar(node); ok {
+			return v
+		}
+	case yamlv3.SequenceNode:
+		seq := make([]interface{}, 0, len(node.Content))
+		for _, n := range node.Content {
+			if n.Kind != yamlv3.ScalarNode {
+				return value
+			}
+			v, ok := envScalar(n)
+			if !ok {
+				v = n.Value
+			}
+			seq = append(seq, v)
+		}
+		return seq
+	}
+	return value
+}
+
+// envScalar returns the value of the node and true if it is a bool or a number written the way YAML writes it
+// back, so that e.g. "0123" or "True" are not converted. It returns false for any other scalar.
+func envScalar(node *yamlv3.Node) (interface{}, bool) {
+	switch node.ShortTag() {
+	case "!!bool", "!!int", "!!float":
+	default:
+		return nil, false
+	}
+	var v interface{}
+	if err := node.Decode(&v); err != nil {
+		return nil, false
+	}
+	out, err := yamlv3.Marshal(v)
+	if err != nil || strings.TrimSuffix(string(out), "\n") != node.Value {
+		return nil, false
+	}
+	return v, true
+}
+
+// envVarKey returns the key path encoded in name, see Map.OverlayEnv, and false if an element of the path is empty.
+func envVarKey(name string) (string, bool) {
+	var elems []string
+	var elem strings.Builder
+	for i := 0; i < len(name); i++ {
+		if name[i] != '_' {
+			elem.WriteByte(name[i])
+			continue
+		}
+		if i+1 < len(name) && name[i+1] == '_' {
+			elem.WriteByte('_')
+			i++
+			continue
+		}
+		elems = append(elems, elem.String())
+		elem.Reset()
+	}
+	elems = append(elems, elem.String())
+	for _, e := range elems {
+		if e == "" {
+			return "", false
+		}
+	}
+	return strings.ToLower(strings.Join(elems, KeyDelimiter)), true
 }
 
 // Sub returns new Map instance representing a sub-config of this instance.
 // It returns an error is the sub-config is not a map[string]interface{} (use Get()), and an empty Map if none exists.
 func (l *Map) Sub(key string) (*Map, error) {
 	// Code inspired by the koanf "Cut" func, but returns an error instead of empty map for unsupported sub-config type.
+	key = l.normalizeKey(key)
 	data := l.Get(key)
+	sub := NewMap()
+	sub.lowercaseKeys = l.lowercaseKeys
 	if data == nil {
-		return NewMap(), nil
+		return sub, nil
 	}
 
 	if v, ok := data.(map[string]interface{}); ok {
-		return NewMapFromStringMap(v), nil
+		// Cannot return error because the koanf instance is empty.
+		_ = sub.k.Load(confmap.Provider(v, KeyDelimiter), nil)
+		prefix := key + KeyDelimiter
+		for _, k := range l.keys {
+			if strings.HasPrefix(k, prefix) {
+				sub.trackKeys([]string{k[len(prefix):]})
+			}
+		}
+		sub.trackKeys(sub.k.Keys())
+		return sub, nil
 	}
 
 	return nil, fmt.Errorf("unexpected sub-config value kind for key:%s value:%v kind:%v)", key, data, reflect.TypeOf(data).Kind())
 }
 
+// Clone returns a deep copy of the config.Map, including the nested maps and slices,
+// so changes to the returned config.Map are not reflected in the original and vice versa.
+func (l *Map) Clone() *Map {
+	keyIndex := make(map[string]struct{}, len(l.keyIndex))
+	for k := range l.keyIndex {
+		keyIndex[k] = struct{}{}
+	}
+	return &Map{
+		k:             l.k.Copy(),
+		keys:          append([]string(nil), l.keys...),
+		keyIndex:      keyIndex,
+		lowercaseKeys: l.lowercaseKeys,
+	}
+}
+
+// Validate returns an error listing all the keys holding a value that are neither one of the consumedKeys
+// nor nested under one of them. This allows to detect misspelled keys when the config.Map is consumed in parts,
+// e.g. using Sub for each section.
+func (l *Map) Validate(consumedKeys []string) error {
+	var unused []string
+	for _, k := range l.AllKeys() {
+		if !isConsumedKey(k, consumedKeys) {
+			unused = append(unused, k)
+		}
+	}
+	if len(unused) == 0 {
+		return nil
+	}
+	sort.Strings(unused)
+	return fmt.Errorf("config contains unused keys: %s", strings.Join(unused, ", "))
+}
+
+func isConsumedKey(key string, consumedKeys []string) bool {
+	for _, ck := range consumedKeys {
+		if key == ck || strings.HasPrefix(key, ck+KeyDelimiter) {
+			return true
+		}
+	}
+	return false
+}
+
+// Diff returns the sorted list of keys whose values differ between the two config.Map, including
+// keys set in only one of them. This allows to determine which parts of a configuration changed,
+// e.g. when the configuration is reloaded.
+func (l *Map) Diff(other *Map) []string {
+	changed := map[string]struct{}{}
+	for _, k := range l.AllKeys() {
+		if !reflect.DeepEqual(l.Get(k), other.Get(k)) {
+			changed[k] = struct{}{}
+		}
+	}
+	for _, k := range other.AllKeys() {
+		if !l.IsSet(k) {
+			changed[k] = struct{}{}
+		}
+	}
+	keys := make([]string, 0, len(changed))
+	for k := range changed {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
 // ToStringMap creates a map[string]interface{} from a Parser.
 func (l *Map) ToStringMap() map[string]interface{} {
 	return maps.Unflatten(l.k.All(), KeyDelimiter)
 }
 
-// decoderConfig returns a default mapstructure.DecoderConfig capable of parsing time.Duration
-// and weakly converting config field values to primitive types.  It also ensures that maps
-// whose values are nil pointer structs resolved to the zero value of the target struct (see
-// expandNilStructPointers). A decoder created from this mapstructure.DecoderConfig will decode
-// its contents to the result argument.
-func decoderConfig(result interface{}) *mapstructure.DecoderConfig {
+// ToYAML serializes the Map to YAML. Keys are written in insertion order, see Keys, so that a Map created
+// with NewMapFromReader is written back in the order of the source document. The keys of the maps nested
+// in slices are written in lexical order.
+func (l *Map) ToYAML() ([]byte, error) {
+	order := make(map[string]int)
+	for i, k := range l.Keys() {
+		// A map is ordered by the first of its nested keys.
+		for prefix := k; ; {
+			if _, ok := order[prefix]; !ok {
+				order[prefix] = i
+			}
+			idx := strings.LastIndex(prefix, KeyDelimiter)
+			if idx < 0 {
+				break
+			}
+			prefix = prefix[:idx]
+		}
+	}
+	return yaml.Marshal(orderedMapSlice(l.ToStringMap(), "", order))
+}
+
+// orderedMapSlice converts m, nested under prefix, to a yaml.MapSlice sorted by the given order of the keys.
+// The keys without an order are sorted last, in lexical order.
+func orderedMapSlice(m map[string]interface{}, prefix string, order map[string]int) yaml.MapSlice {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		oi, iok := order[joinKey(prefix, keys[i])]
+		oj, jok := order[joinKey(prefix, keys[j])]
+		if iok != jok {
+			return iok
+		}
+		if oi != oj {
+			return oi < oj
+		}
+		return keys[i] < keys[j]
+	})
+
+	ms := make(yaml.MapSlice, 0, len(keys))
+	for _, k := range keys {
+		value := m[k]
+		if sub, ok := value.(map[string]interface{}); ok {
+			value = orderedMapSlice(sub, joinKey(prefix, k), order)
+		}
+		ms = append(ms, yaml.MapItem{Key: k, Value: value})
+	}
+	return ms
+}
+
+// Flatten creates a flat map[string]interface{} from a Map, where every key is the path to a value
+// with the path elements separated by sep, e.g. {typed: {options: {integer: {example: 1234}}}} is
+// flattened to {"typed.options.integer.example": 1234}. Slice elements are flattened using their
+// index as path element, e.g. "a.0.b". Empty maps and slices are kept as values.
+func (l *Map) Flatten(sep string) map[string]interface{} {
+	flat := map[string]interface{}{}
+	for k, v := range l.ToStringMap() {
+		flatten(k, v, sep, flat)
+	}
+	return flat
+}
+
+func flatten(path string, value interface{}, sep string, flat map[string]interface{}) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if len(v) > 0 {
+			for k, mv := range v {
+				flatten(path+sep+k, mv, sep, flat)
+			}
+			return
+		}
+	case map[interface{}]interface{}:
+		if len(v) > 0 {
+			for k, mv := range v {
+				flatten(path+sep+fmt.Sprint(k), mv, sep, flat)
+			}
+			return
+		}
+	case []interface{}:
+		if len(v) > 0 {
+			for i, sv := range v {
+				flatten(path+sep+strconv.Itoa(i), sv, sep, flat)
+			}
+			return
+		}
+	}
+	flat[path] = value
+}
+
+// redactedValue replaces sensitive values in the output of Map.Redacted.
+const redactedValue = "<redacted>"
+
+// Redacted creates a map[string]interface{} like ToStringMap, where the value of every key matching one
+// of the sensitiveKeys is replaced with "<redacted>". A sensitive key without a KeyDelimiter matches
+// keys with that name at any level, e.g. "authorization", while a sensitive key with a KeyDelimiter
+// only matches the full path, e.g. "exporters::otlp::headers::authorization".
+func (l *Map) Redacted(sensitiveKeys []string) map[string]interface{} {
+	return redact("", l.ToStringMap(), sensitiveKeys).(map[string]interface{})
+}
+
+func redact(path string, value interface{}, sensitiveKeys []string) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for k, mv := range v {
+			kPath := k
+			if path != "" {
+				kPath = path + KeyDelimiter + k
+			}
+			if isSensitiveKey(k, kPath, sensitiveKeys) {
+				v[k] = redactedValue
+				continue
+			}
+			v[k] = redact(kPath, mv, sensitiveKeys)
+		}
+	case []interface{}:
+		for i, sv := range v {
+			v[i] = redact(path+KeyDelimiter+strconv.Itoa(i), sv, sensitiveKeys)
+		}
+	}
+	return value
+}
+
+func isSensitiveKey(name string, path string, sensitiveKeys []string) bool {
+	for _, sk := range sensitiveKeys {
+		if sk == path || (sk == name && !strings.Contains(sk, KeyDelimiter)) {
+			return true
+		}
+	}
+	return false
+}
+
+// decoderConfig returns a default mapstructure.DecoderConfig capable of parsing time.Duration,
+// base64 encoded []byte and weakly converting config field values to primitive types (unless
+// disabled with WithWeaklyTypedInput). It also ensures that maps whose values are nil pointer
+// structs resolved to the zero value of the target struct (see expandNilStructPointers).
+// A decoder created from this mapstructure.DecoderConfig will decode its contents to the result argument.
+func decoderConfig(result interface{}, opts ...UnmarshalOption) *mapstructure.DecoderConfig {
+	set := unmarshalSettings{weaklyTypedInput: true, tagName: "mapstructure"}
+	for _, opt := range opts {
+		opt(&set)
+	}
 	return &mapstructure.DecoderConfig{
 		Result:           result,
 		Metadata:         nil,
-		TagName:          "mapstructure",
-		WeaklyTypedInput: true,
+		TagName:          set.tagName,
+		WeaklyTypedInput: set.weaklyTypedInput,
 		DecodeHook: mapstructure.ComposeDecodeHookFunc(
+			squashedKeysCollisionHookFunc(set.tagName),
 			expandNilStructPointersHookFunc(),
+			floatToIntHookFunc(),
+			// Must run before StringToSliceHookFunc, which splits any string into a slice.
+			stringToBytesHookFunc(),
 			mapstructure.StringToSliceHookFunc(","),
 			mapKeyStringToMapKeyTextUnmarshalerHookFunc(),
 			mapstructure.StringToTimeDurationHookFunc(),
@@ -187,6 +1018,115 @@ func expandNilStructPointersHookFunc() mapstructure.DecodeHookFuncValue {
 	}
 }
 
+// squashedKeysCollisionHookFunc returns a DecodeHookFuncType that checks that the keys of a struct, read from the
+// tagName field tags, including the keys of its squashed structs, are unique. Otherwise, the same config value
+// is silently decoded into every field declaring the key, instead of the one expected by the config author.
+func squashedKeysCollisionHookFunc(tagName string) mapstructure.DecodeHookFuncType {
+	return func(f reflect.Type, t reflect.Type, data interface{}) (interface{}, error) {
+		if f.Kind() != reflect.Map || t.Kind() != reflect.Struct {
+			return data, nil
+		}
+		if err := checkSquashedKeys(t, tagName, "", map[string]string{}); err != nil {
+			return nil, err
+		}
+		return data, nil
+	}
+}
+
+// checkSquashedKeys records in fields the path of the field declaring every key of the struct type t,
+// descending into the squashed structs, and returns an error if a key is declared by two fields.
+func checkSquashedKeys(t reflect.Type, tagName string, path string, fields map[string]string) error {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" && !field.Anonymous {
+			// Unexported fields are never decoded.
+			continue
+		}
+		tagParts := strings.Split(field.Tag.Get(tagName), ",")
+		fieldPath := field.Name
+		if path != "" {
+			fieldPath = path + "." + field.Name
+		}
+		if hasTagOption(tagParts[1:], "squash") {
+			ft := field.Type
+			if ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if ft.Kind() != reflect.Struct {
+				continue
+			}
+			if err := checkSquashedKeys(ft, tagName, fieldPath, fields); err != nil {
+				return err
+			}
+			continue
+		}
+		if field.PkgPath != "" || hasTagOption(tagParts[1:], "remain") || tagParts[0] == "-" {
+			continue
+		}
+		key := strings.ToLower(field.Name)
+		if tagParts[0] != "" {
+			key = strings.ToLower(tagParts[0])
+		}
+		if other, ok := fields[key]; ok {
+			return fmt.Errorf("ambiguous key %q declared by both %s and %s, check the squashed structs", key, other, fieldPath)
+		}
+		fields[key] = fieldPath
+	}
+	return nil
+}
+
+func hasTagOption(options []string, option string) bool {
+	for _, o := range options {
+		if o == option {
+			return true
+		}
+	}
+	return false
+}
+
+// floatToIntHookFunc returns a DecodeHookFuncType that checks that a float is converted to an integer field
+// without losing precision, e.g. 1.0 can be decoded into an int field but 1.5 or 1e30 return an error,
+// instead of being silently truncated.
+func floatToIntHookFunc() mapstructure.DecodeHookFuncType {
+	return func(f reflect.Type, t reflect.Type, data interface{}) (interface{}, error) {
+		if f.Kind() != reflect.Float32 && f.Kind() != reflect.Float64 {
+			return data, nil
+		}
+
+		v := reflect.ValueOf(data).Float()
+		var lossless bool
+		switch t.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			// Converting a float out of the int64 range is implementation-specific, so check the range first.
+			lossless = v >= math.MinInt64 && v < math.MaxInt64 && !reflect.Zero(t).OverflowInt(int64(v))
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			lossless = v >= 0 && v < math.MaxUint64 && !reflect.Zero(t).OverflowUint(uint64(v))
+		default:
+			return data, nil
+		}
+		if !lossless || v != math.Trunc(v) {
+			return nil, fmt.Errorf("cannot decode %v into %s without losing precision", data, t)
+		}
+		return data, nil
+	}
+}
+
+// stringToBytesHookFunc returns a DecodeHookFuncType that decodes a base64 encoded string into a []byte field,
+// e.g. certificates embedded in the configuration.
+func stringToBytesHookFunc() mapstructure.DecodeHookFuncType {
+	return func(f reflect.Type, t reflect.Type, data interface{}) (interface{}, error) {
+		if f.Kind() != reflect.String || t != reflect.TypeOf([]byte(nil)) {
+			return data, nil
+		}
+
+		b, err := base64.StdEncoding.DecodeString(data.(string))
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode base64 string into []byte: %w", err)
+		}
+		return b, nil
+	}
+}
+
 // mapKeyStringToMapKeyTextUnmarshalerHookFunc returns a DecodeHookFuncType that checks that a conversion from
 // map[string]interface{} to map[encoding.TextUnmarshaler]interface{} does not overwrite keys,
 // when UnmarshalText produces equal elements from different strings (e.g. trims whitespaces).
@@ -207,17 +1147,22 @@ func mapKeyStringToMapKeyTextUnmarshalerHookFunc() mapstructure.DecodeHookFuncTy
 			return data, nil
 		}
 
-		m := reflect.MakeMap(reflect.MapOf(t.Key(), reflect.TypeOf(true)))
+		// Keep the original key for every unmarshaled key, to report both keys in case of a collision.
+		m := reflect.MakeMap(reflect.MapOf(t.Key(), reflect.TypeOf("")))
 		for k := range data.(map[string]interface{}) {
 			tKey := reflect.New(t.Key())
 			if err := tKey.Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(k)); err != nil {
 				return nil, err
 			}
 
-			if m.MapIndex(reflect.Indirect(tKey)).IsValid() {
-				return nil, fmt.Errorf("duplicate name %q after unmarshaling %v", k, tKey)
+			if orig := m.MapIndex(reflect.Indirect(tKey)); orig.IsValid() {
+				first, second := orig.String(), k
+				if second < first {
+					first, second = second, first
+				}
+				return nil, fmt.Errorf("duplicate name %q after unmarshaling %q and %q", reflect.Indirect(tKey).Interface(), first, second)
 			}
-			m.SetMapIndex(reflect.Indirect(tKey), reflect.ValueOf(true))
+			m.SetMapIndex(reflect.Indirect(tKey), reflect.ValueOf(k))
 		}
 		return data, nil
 	}
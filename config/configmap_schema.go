@@ -0,0 +1,340 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config // import "go.opentelemetry.io/collector/config"
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"go.uber.org/multierr"
+)
+
+// ValidateSchema validates the Map against the given JSON Schema and returns an error for every violation,
+// combined with multierr, each one identifying the key of the invalid value, e.g. "receivers::otlp::endpoint".
+//
+// The following subset of the JSON Schema draft 7 keywords is supported, other keywords are ignored:
+//   - type, enum, const;
+//   - properties, required, additionalProperties, minProperties, maxProperties;
+//   - items, minItems, maxItems;
+//   - minimum, maximum, exclusiveMinimum, exclusiveMaximum;
+//   - minLength, maxLength, pattern;
+//   - allOf, anyOf, oneOf, not;
+//   - $ref to a local definition, e.g. "#/definitions/endpoint" or "#/$defs/endpoint".
+func (l *Map) ValidateSchema(schema []byte) error {
+	var root interface{}
+	if err := json.Unmarshal(schema, &root); err != nil {
+		return fmt.Errorf("invalid schema: %w", err)
+	}
+	v := &schemaValidator{root: root, patterns: map[string]*regexp.Regexp{}}
+	return v.validate("", l.ToStringMap(), root, 0)
+}
+
+// maxSchemaRefDepth bounds the $ref resolutions along a key, to detect cyclic references.
+const maxSchemaRefDepth = 64
+
+type schemaValidator struct {
+	root     interface{}
+	patterns map[string]*regexp.Regexp
+}
+
+func (v *schemaValidator) validate(path string, value interface{}, schema interface{}, refDepth int) error {
+	switch s := schema.(type) {
+	case bool:
+		if !s {
+			return schemaError(path, "no value is allowed")
+		}
+		return nil
+	case map[string]interface{}:
+		return v.validateObjectSchema(path, value, s, refDepth)
+	}
+	return fmt.Errorf("invalid schema at %q: must be an object or a boolean", path)
+}
+
+func (v *schemaValidator) validateObjectSchema(path string, value interface{}, schema map[string]interface{}, refDepth int) error {
+	if ref, ok := schema["$ref"].(string); ok {
+		if refDepth >= maxSchemaRefDepth {
+			return fmt.Errorf("invalid schema: too many nested references resolving %q", ref)
+		}
+		resolved, err := v.resolveRef(ref)
+		if err != nil {
+			return err
+		}
+		// In draft 7, the other keywords next to $ref are ignored.
+		return v.validate(path, value, resolved, refDepth+1)
+	}
+
+	var errs error
+	if t, ok := schema["type"]; ok && !matchesSchemaType(value, t) {
+		// The other keywords are meaningless for a value of the wrong type.
+		return schemaError(path, "expected type %v, got %s", t, schemaTypeOf(value))
+	}
+	if enum, ok := schema["enum"].([]interface{}); ok && !containsSchemaValue(enum, value) {
+		errs = multierr.Append(errs, schemaError(path, "value %v is not one of %v", value, enum))
+	}
+	if c, ok := schema["const"]; ok && !schemaValuesEqual(c, value) {
+		errs = multierr.Append(errs, schemaError(path, "value %v is not equal to %v", value, c))
+	}
+
+	switch val := value.(type) {
+	case map[string]interface{}:
+		errs = multierr.Append(errs, v.validateObject(path, val, schema, refDepth))
+	case []interface{}:
+		errs = multierr.Append(errs, v.validateArray(path, val, schema, refDepth))
+	case string:
+		errs = multierr.Append(errs, v.validateString(path, val, schema))
+	default:
+		if f, ok := schemaNumber(value); ok {
+			errs = multierr.Append(errs, validateNumber(path, f, schema))
+		}
+	}
+
+	errs = multierr.Append(errs, v.validateCombinations(path, value, schema, refDepth))
+	return errs
+}
+
+func (v *schemaValidator) validateObject(path string, value map[string]interface{}, schema map[string]interface{}, refDepth int) error {
+	var errs error
+	if required, ok := schema["required"].([]interface{}); ok {
+		for _, r := range required {
+			if name, ok := r.(string); ok {
+				if _, ok := value[name]; !ok {
+					errs = multierr.Append(errs, schemaError(path, "missing required key %q", name))
+				}
+			}
+		}
+	}
+	if n, ok := schemaNumber(schema["minProperties"]); ok && float64(len(value)) < n {
+		errs = multierr.Append(errs, schemaError(path, "expected at least %v keys, got %d", n, len(value)))
+	}
+	if n, ok := schemaNumber(schema["maxProperties"]); ok && float64(len(value)) > n {
+		errs = multierr.Append(errs, schemaError(path, "expected at most %v keys, got %d", n, len(value)))
+	}
+
+	properties, _ := schema["properties"].(map[string]interface{})
+	additional, hasAdditional := schema["additionalProperties"]
+	// Sort the keys, so the errors are reported in a deterministic order.
+	keys := make([]string, 0, len(value))
+	for k := range value {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		keyPath := joinKey(path, k)
+		if propSchema, ok := properties[k]; ok {
+			errs = multierr.Append(errs, v.validate(keyPath, value[k], propSchema, refDepth))
+			continue
+		}
+		if !hasAdditional {
+			continue
+		}
+		if allowed, ok := additional.(bool); ok && !allowed {
+			errs = multierr.Append(errs, schemaError(keyPath, "key is not allowed"))
+			continue
+		}
+		errs = multierr.Append(errs, v.validate(keyPath, value[k], additional, refDepth))
+	}
+	return errs
+}
+
+func (v *schemaValidator) validateArray(path string, value []interface{}, schema map[string]interface{}, refDepth int) error {
+	var errs error
+	if n, ok := schemaNumber(schema["minItems"]); ok && float64(len(value)) < n {
+		errs = multierr.Append(errs, schemaError(path, "expected at least %v items, got %d", n, len(value)))
+	}
+	if n, ok := schemaNumber(schema["maxItems"]); ok && float64(len(value)) > n {
+		errs = multierr.Append(errs, schemaError(path, "expected at most %v items, got %d", n, len(value)))
+	}
+	if items, ok := schema["items"]; ok {
+		for i, item := range value {
+			errs = multierr.Append(errs, v.validate(joinKey(path, fmt.Sprint(i)), item, items, refDepth))
+		}
+	}
+	return errs
+}
+
+func (v *schemaValidator) validateString(path string, value string, schema map[string]interface{}) error {
+	var errs error
+	length := float64(len([]rune(value)))
+	if n, ok := schemaNumber(schema["minLength"]); ok && length < n {
+		errs = multierr.Append(errs, schemaError(path, "expected at least %v characters, got %v", n, length))
+	}
+	if n, ok := schemaNumber(schema["maxLength"]); ok && length > n {
+		errs = multierr.Append(errs, schemaError(path, "expected at most %v characters, got %v", n, length))
+	}
+	if pattern, ok := schema["pattern"].(string); ok {
+		re, found := v.patterns[pattern]
+		if !found {
+			var err error
+			if re, err = regexp.Compile(pattern); err != nil {
+				return fmt.Errorf("invalid schema pattern %q: %w", pattern, err)
+			}
+			v.patterns[pattern] = re
+		}
+		if !re.MatchString(value) {
+			errs = multierr.Append(errs, schemaError(path, "value %q does not match pattern %q", value, pattern))
+		}
+	}
+	return errs
+}
+
+func validateNumber(path string, value float64, schema map[string]interface{}) error {
+	var errs error
+	if n, ok := schemaNumber(schema["minimum"]); ok && value < n {
+		errs = multierr.Append(errs, schemaError(path, "value %v is less than the minimum %v", value, n))
+	}
+	if n, ok := schemaNumber(schema["maximum"]); ok && value > n {
+		errs = multierr.Append(errs, schemaError(path, "value %v is greater than the maximum %v", value, n))
+	}
+	if n, ok := schemaNumber(schema["exclusiveMinimum"]); ok && value <= n {
+		errs = multierr.Append(errs, schemaError(path, "value %v is not greater than the exclusive minimum %v", value, n))
+	}
+	if n, ok := schemaNumber(schema["exclusiveMaximum"]); ok && value >= n {
+		errs = multierr.Append(errs, schemaError(path, "value %v is not less than the exclusive maximum %v", value, n))
+	}
+	return errs
+}
+
+func (v *schemaValidator) validateCombinations(path string, value interface{}, schema map[string]interface{}, refDepth int) error {
+	var errs error
+	if allOf, ok := schema["allOf"].([]interface{}); ok {
+		for _, sub := range allOf {
+			errs = multierr.Append(errs, v.validate(path, value, sub, refDepth))
+		}
+	}
+	if anyOf, ok := schema["anyOf"].([]interface{}); ok && v.countValid(path, value, anyOf, refDepth) == 0 {
+		errs = multierr.Append(errs, schemaError(path, "value does not match any of the anyOf schemas"))
+	}
+	if oneOf, ok := schema["oneOf"].([]interface{}); ok {
+		if n := v.countValid(path, value, oneOf, refDepth); n != 1 {
+			errs = multierr.Append(errs, schemaError(path, "value matches %d of the oneOf schemas, expected exactly 1", n))
+		}
+	}
+	if not, ok := schema["not"]; ok && v.validate(path, value, not, refDepth) == nil {
+		errs = multierr.Append(errs, schemaError(path, "value must not match the not schema"))
+	}
+	return errs
+}
+
+func (v *schemaValidator) countValid(path string, value interface{}, schemas []interface{}, refDepth int) int {
+	valid := 0
+	for _, sub := range schemas {
+		if v.validate(path, value, sub, refDepth) == nil {
+			valid++
+		}
+	}
+	return valid
+}
+
+// resolveRef resolves a JSON pointer to a location of the root schema, e.g. "#/definitions/endpoint".
+func (v *schemaValidator) resolveRef(ref string) (interface{}, error) {
+	if ref == "#" {
+		return v.root, nil
+	}
+	if !strings.HasPrefix(ref, "#/") {
+		return nil, fmt.Errorf("invalid schema: unsupported reference %q, only local references are supported", ref)
+	}
+	current := v.root
+	for _, token := range strings.Split(ref[2:], "/") {
+		token = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+		obj, ok := current.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("invalid schema: unresolved reference %q", ref)
+		}
+		if current, ok = obj[token]; !ok {
+			return nil, fmt.Errorf("invalid schema: unresolved reference %q", ref)
+		}
+	}
+	return current, nil
+}
+
+func schemaError(path string, format string, args ...interface{}) error {
+	return fmt.Errorf("%q: %s", path, fmt.Sprintf(format, args...))
+}
+
+// schemaTypeOf returns the JSON Schema type of a value of the Map.
+func schemaTypeOf(value interface{}) string {
+	switch value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case string:
+		return "string"
+	case map[string]interface{}:
+		return "object"
+	case []interface{}:
+		return "array"
+	}
+	if f, ok := schemaNumber(value); ok {
+		if f == math.Trunc(f) {
+			return "integer"
+		}
+		return "number"
+	}
+	return fmt.Sprintf("%T", value)
+}
+
+func matchesSchemaType(value interface{}, t interface{}) bool {
+	switch tt := t.(type) {
+	case string:
+		actual := schemaTypeOf(value)
+		return actual == tt || (tt == "number" && actual == "integer")
+	case []interface{}:
+		for _, sub := range tt {
+			if matchesSchemaType(value, sub) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// schemaNumber returns the value as a float64, if it is a number.
+func schemaNumber(value interface{}) (float64, bool) {
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(rv.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(rv.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return rv.Float(), true
+	}
+	return 0, false
+}
+
+func containsSchemaValue(values []interface{}, value interface{}) bool {
+	for _, v := range values {
+		if schemaValuesEqual(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// schemaValuesEqual compares a value of the schema, decoded from JSON, with a value of the Map,
+// numbers are equal if they have the same value regardless of their Go type.
+func schemaValuesEqual(schemaValue interface{}, value interface{}) bool {
+	if a, ok := schemaNumber(schemaValue); ok {
+		b, ok := schemaNumber(value)
+		return ok && a == b
+	}
+	return reflect.DeepEqual(schemaValue, value)
+}
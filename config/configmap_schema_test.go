@@ -0,0 +1,145 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/multierr"
+)
+
+const testSchema = `{
+	"$schema": "http://json-schema.org/draft-07/schema#",
+	"type": "object",
+	"required": ["receivers", "service"],
+	"additionalProperties": false,
+	"definitions": {
+		"endpoint": {"type": "string", "pattern": "^[a-z0-9.]+:[0-9]+$"}
+	},
+	"properties": {
+		"receivers": {
+			"type": "object",
+			"minProperties": 1,
+			"additionalProperties": {
+				"type": ["object", "null"],
+				"properties": {
+					"endpoint": {"$ref": "#/definitions/endpoint"},
+					"workers": {"type": "integer", "minimum": 1, "maximum": 16},
+					"ratio": {"type": "number", "exclusiveMaximum": 1},
+					"mode": {"enum": ["push", "pull"]}
+				}
+			}
+		},
+		"exporters": {
+			"type": "object",
+			"additionalProperties": {
+				"anyOf": [
+					{"type": "null"},
+					{"type": "object", "required": ["endpoint"]}
+				]
+			}
+		},
+		"service": {
+			"type": "object",
+			"properties": {
+				"extensions": {"type": "array", "items": {"type": "string", "minLength": 1}, "maxItems": 2}
+			}
+		}
+	}
+}`
+
+func TestMapValidateSchema(t *testing.T) {
+	cfgMap := NewMapFromStringMap(map[string]interface{}{
+		"receivers": map[string]interface{}{
+			"otlp": map[string]interface{}{
+				"endpoint": "localhost:4317",
+				"workers":  4,
+				"ratio":    0.5,
+				"mode":     "push",
+			},
+			"nop": nil,
+		},
+		"exporters": map[string]interface{}{
+			"nop":  nil,
+			"otlp": map[string]interface{}{"endpoint": "localhost:4317"},
+		},
+		"service": map[string]interface{}{
+			"extensions": []interface{}{"health_check"},
+		},
+	})
+	assert.NoError(t, cfgMap.ValidateSchema([]byte(testSchema)))
+}
+
+func TestMapValidateSchemaErrors(t *testing.T) {
+	cfgMap := NewMapFromStringMap(map[string]interface{}{
+		"receivers": map[string]interface{}{
+			"otlp": map[string]interface{}{
+				"endpoint": "localhost",
+				"workers":  32,
+				"ratio":    1,
+				"mode":     "poll",
+			},
+			"other": "value",
+		},
+		"exporters": map[string]interface{}{
+			"otlp": map[string]interface{}{"insecure": true},
+		},
+		"service": map[string]interface{}{
+			"extensions": []interface{}{"health_check", "", "pprof"},
+		},
+		"unknown": true,
+	})
+	err := cfgMap.ValidateSchema([]byte(testSchema))
+	require.Error(t, err)
+	assert.Equal(t, []string{
+		`"exporters::otlp": value does not match any of the anyOf schemas`,
+		`"receivers::other": expected type [object null], got string`,
+		`"receivers::otlp::endpoint": value "localhost" does not match pattern "^[a-z0-9.]+:[0-9]+$"`,
+		`"receivers::otlp::mode": value poll is not one of [push pull]`,
+		`"receivers::otlp::ratio": value 1 is not less than the exclusive maximum 1`,
+		`"receivers::otlp::workers": value 32 is greater than the maximum 16`,
+		`"service::extensions": expected at most 2 items, got 3`,
+		`"service::extensions::1": expected at least 1 characters, got 0`,
+		`"unknown": key is not allowed`,
+	}, errorStrings(multierr.Errors(err)))
+
+	err = NewMap().ValidateSchema([]byte(testSchema))
+	assert.Equal(t, []string{
+		`"": missing required key "receivers"`,
+		`"": missing required key "service"`,
+	}, errorStrings(multierr.Errors(err)))
+}
+
+func TestMapValidateSchemaInvalid(t *testing.T) {
+	cfgMap := NewMapFromStringMap(map[string]interface{}{"key": "value"})
+	assert.Error(t, cfgMap.ValidateSchema([]byte(`{`)))
+	assert.EqualError(t, cfgMap.ValidateSchema([]byte(`{"properties": {"key": {"$ref": "#/definitions/missing"}}}`)),
+		`invalid schema: unresolved reference "#/definitions/missing"`)
+	assert.EqualError(t, cfgMap.ValidateSchema([]byte(`{"properties": {"key": {"$ref": "other.json"}}}`)),
+		`invalid schema: unsupported reference "other.json", only local references are supported`)
+	assert.Error(t, cfgMap.ValidateSchema([]byte(`{"$ref": "#"}`)))
+	assert.NoError(t, cfgMap.ValidateSchema([]byte(`true`)))
+	assert.EqualError(t, cfgMap.ValidateSchema([]byte(`false`)), `"": no value is allowed`)
+}
+
+func errorStrings(errs []error) []string {
+	strs := make([]string, 0, len(errs))
+	for _, err := range errs {
+		strs = append(strs, err.Error())
+	}
+	return strs
+}
@@ -15,12 +15,17 @@
 package config
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"io/ioutil"
+	"math"
 	"path/filepath"
 	"strings"
+	"sync"
 	"testing"
+	"testing/iotest"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -222,7 +227,9 @@ func TestMapKeyStringToMapKeyTextUnmarshalerHookFuncDuplicateID(t *testing.T) {
 	}
 	cfgMap := NewMapFromStringMap(stringMap)
 	cfg := &TestIDConfig{}
-	assert.Error(t, cfgMap.UnmarshalExact(cfg))
+	err := cfgMap.UnmarshalExact(cfg)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `duplicate name "string" after unmarshaling "string" and "string_"`)
 }
 
 func TestMapKeyStringToMapKeyTextUnmarshalerHookFuncErrorUnmarshal(t *testing.T) {
@@ -236,3 +243,1022 @@ func TestMapKeyStringToMapKeyTextUnmarshalerHookFuncErrorUnmarshal(t *testing.T)
 	cfg := &TestIDConfig{}
 	assert.Error(t, cfgMap.UnmarshalExact(cfg))
 }
+
+func TestMapSub(t *testing.T) {
+	cfgMap := NewMapFromStringMap(map[string]interface{}{
+		"receivers": map[string]interface{}{
+			"nop": map[string]interface{}{
+				"endpoint": "localhost:4317",
+			},
+			"nop/myreceiver": nil,
+		},
+		"service": map[string]interface{}{
+			"extensions": []interface{}{"nop"},
+		},
+	})
+
+	sub, err := cfgMap.Sub("receivers::nop")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"endpoint": "localhost:4317"}, sub.ToStringMap())
+
+	sub, err = cfgMap.Sub("receivers")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"nop::endpoint", "nop/myreceiver"}, sub.AllKeys())
+
+	// Absent and nil values return an empty Map.
+	sub, err = cfgMap.Sub("receivers::nop/myreceiver")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{}, sub.ToStringMap())
+
+	sub, err = cfgMap.Sub("exporters")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{}, sub.ToStringMap())
+
+	// Values that are not maps return an error.
+	_, err = cfgMap.Sub("service::extensions")
+	assert.Error(t, err)
+
+	_, err = cfgMap.Sub("receivers::nop::endpoint")
+	assert.Error(t, err)
+}
+
+func TestMapValidate(t *testing.T) {
+	cfgMap, err := newMapFromFile(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+	assert.NoError(t, cfgMap.Validate([]string{"receivers", "processors", "exporters", "extensions", "service"}))
+	assert.NoError(t, cfgMap.Validate([]string{"receivers", "processors", "exporters", "extensions", "service::extensions", "service::pipelines"}))
+
+	cfgMap.Set("recievers::nop", nil)
+	cfgMap.Set("service::pipelines::traces::unknown", "value")
+	assert.EqualError(t,
+		cfgMap.Validate([]string{"receivers", "processors", "exporters", "extensions", "service::extensions", "service::pipelines::traces::receivers", "service::pipelines::traces::processors", "service::pipelines::traces::exporters"}),
+		"config contains unused keys: recievers::nop, service::pipelines::traces::unknown")
+
+	// A consumed key must match a full path segment.
+	assert.EqualError(t, NewMapFromStringMap(map[string]interface{}{"receivers_extra": 1}).Validate([]string{"receivers"}),
+		"config contains unused keys: receivers_extra")
+}
+
+func TestMapMerge(t *testing.T) {
+	cfgMap, err := newMapFromFile(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+	override, err := newMapFromFile(filepath.Join("testdata", "config_override.yaml"))
+	require.NoError(t, err)
+
+	require.NoError(t, cfgMap.Merge(override))
+	assert.Equal(t, map[string]interface{}{"endpoint": "localhost:4317"}, cfgMap.Get("exporters::nop/myexporter"))
+	assert.Nil(t, cfgMap.Get("exporters::nop"))
+	assert.True(t, cfgMap.IsSet("exporters::nop"))
+	assert.Equal(t, map[string]interface{}{
+		"receivers":  []interface{}{"nop"},
+		"processors": []interface{}{"nop/myprocessor"},
+		"exporters":  []interface{}{"nop/myexporter"},
+	}, cfgMap.Get("service::pipelines::traces"))
+	assert.Equal(t, []interface{}{"nop"}, cfgMap.Get("service::extensions"))
+}
+
+func TestMapMergeAppendSlices(t *testing.T) {
+	cfgMap, err := newMapFromFile(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+	override, err := newMapFromFile(filepath.Join("testdata", "config_override.yaml"))
+	require.NoError(t, err)
+	override.Set("service::extensions", "nop/myextension")
+
+	require.NoError(t, cfgMap.Merge(override, WithMergeAppendSlices()))
+	assert.Equal(t, map[string]interface{}{
+		"receivers":  []interface{}{"nop"},
+		"processors": []interface{}{"nop", "nop/myprocessor"},
+		"exporters":  []interface{}{"nop", "nop/myexporter"},
+	}, cfgMap.Get("service::pipelines::traces"))
+	// Values that are not slices in both configs are still replaced.
+	assert.Equal(t, "nop/myextension", cfgMap.Get("service::extensions"))
+}
+
+func TestMapOverlayEnv(t *testing.T) {
+	t.Setenv("OVERLAY_TEST_EXPORTERS_OTLP_ENDPOINT", "localhost:4317")
+	t.Setenv("OVERLAY_TEST_PROCESSORS_BATCH_SEND__BATCH__SIZE", "100")
+	t.Setenv("OVERLAY_TEST_EXPORTERS_OTLP_TLS_INSECURE", "true")
+	t.Setenv("OVERLAY_TEST_EXPORTERS_OTLP_HEADERS_LIST", "[a, b]")
+	t.Setenv("OVERLAY_TEST_EXPORTERS_OTLP_COMPRESSION", "{invalid")
+	t.Setenv("OVERLAY_TEST_EXPORTERS_OTLP_EMPTY", "")
+	t.Setenv("OVERLAY_TEST_EXPORTERS_OTLP_COMMENT", "#abc")
+	t.Setenv("OVERLAY_TEST_EXPORTERS_OTLP_LEADING__ZERO", "0123")
+	t.Setenv("OVERLAY_TEST_EXPORTERS_OTLP_CAPITALIZED", "True")
+	t.Setenv("OVERLAY_TEST_EXPORTERS_OTLP_FLOAT", "1.5")
+	t.Setenv("OVERLAY_TEST_EXPORTERS_OTLP_MAP", "{a: b}")
+	t.Setenv("OVERLAY_TEST_EXPORTERS_OTLP_MIXED", "[0123, 1, true, ~]")
+	t.Setenv("OVERLAY_TEST_RECEIVERS_", "ignored")
+	t.Setenv("OVERLAY_TEST__RECEIVERS", "ignored")
+	t.Setenv("OTHER_EXPORTERS_OTLP_ENDPOINT", "ignored")
+
+	cfgMap := NewMapFromStringMap(map[string]interface{}{
+		"exporters": map[string]interface{}{
+			"otlp": map[string]interface{}{
+				"endpoint": "example.com:4317",
+				"timeout":  "10s",
+			},
+		},
+	})
+	cfgMap.OverlayEnv("OVERLAY_TEST_")
+	assert.Equal(t, map[string]interface{}{
+		"exporters": map[string]interface{}{
+			"otlp": map[string]interface{}{
+				"endpoint":     "localhost:4317",
+				"timeout":      "10s",
+				"tls":          map[string]interface{}{"insecure": true},
+				"headers":      map[string]interface{}{"list": []interface{}{"a", "b"}},
+				"compression":  "{invalid",
+				"empty":        "",
+				"comment":      "#abc",
+				"leading_zero": "0123",
+				"capitalized":  "True",
+				"float":        1.5,
+				"map":          "{a: b}",
+				"mixed":        []interface{}{"0123", 1, true, "~"},
+			},
+		},
+		"processors": map[string]interface{}{
+			"batch": map[string]interface{}{"send_batch_size": 100},
+		},
+	}, cfgMap.ToStringMap())
+}
+
+func TestNewMapFromReader(t *testing.T) {
+	yamlContent, err := ioutil.ReadFile(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+	cfgMap, err := NewMapFromReader(bytes.NewReader(yamlContent))
+	require.NoError(t, err)
+	expected, err := newMapFromFile(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+	assert.Equal(t, expected.ToStringMap(), cfgMap.ToStringMap())
+
+	jsonContent, err := ioutil.ReadFile(filepath.Join("testdata", "basic_types.json"))
+	require.NoError(t, err)
+	cfgMap, err = NewMapFromReader(bytes.NewReader(jsonContent))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"typed.options": map[string]interface{}{
+			"floating.point.example": 3.14,
+			"integer.example":        int64(1234),
+			"bool.example":           false,
+			"string.example":         "this is a string",
+			"nil.example":            nil,
+		},
+	}, cfgMap.ToStringMap())
+
+	cfgMap, err = NewMapFromReader(strings.NewReader(`{"list": [1, 2.5, {"key": 3}]}`))
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{int64(1), 2.5, map[string]interface{}{"key": int64(3)}}, cfgMap.Get("list"))
+
+	// YAML flow mappings which are not valid JSON are still accepted.
+	cfgMap, err = NewMapFromReader(strings.NewReader(`{key: value}`))
+	require.NoError(t, err)
+	assert.Equal(t, "value", cfgMap.Get("key"))
+
+	cfgMap, err = NewMapFromReader(strings.NewReader(``))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{}, cfgMap.ToStringMap())
+}
+
+func TestNewMapFromReaderYAMLMergeKeys(t *testing.T) {
+	content, err := ioutil.ReadFile(filepath.Join("testdata", "yaml_merge.yaml"))
+	require.NoError(t, err)
+	cfgMap, err := NewMapFromReader(bytes.NewReader(content))
+	require.NoError(t, err)
+
+	tls := map[string]interface{}{
+		"insecure":  false,
+		"ca_file":   "/var/lib/certs/ca.pem",
+		"cert_file": "/var/lib/certs/cert.pem",
+	}
+	assert.Equal(t, tls, cfgMap.Get("exporters::otlp::tls"))
+	assert.Equal(t, map[string]interface{}{
+		"insecure":  true,
+		"ca_file":   "/var/lib/certs/ca.pem",
+		"cert_file": "/var/lib/certs/cert.pem",
+	}, cfgMap.Get("exporters::otlp/insecure::tls"))
+	assert.False(t, cfgMap.IsSet("exporters::otlp/insecure::tls::<<"))
+	assert.False(t, cfgMap.IsSet("<<"))
+
+	// The merged keys are reachable with the key path.
+	assert.Equal(t, "/var/lib/certs/ca.pem", cfgMap.Get("exporters::otlp/insecure::tls::ca_file"))
+	for _, k := range cfgMap.AllKeys() {
+		assert.NotContains(t, k, "<<")
+	}
+}
+
+func TestNewMapFromReaderDuplicateKeys(t *testing.T) {
+	var testCases = []struct {
+		name        string
+		content     string
+		expectedErr string
+	}{
+		{
+			name:        "top_level",
+			content:     "exporters:\n  otlp:\nreceivers:\n  otlp:\nexporters:\n  logging:\n",
+			expectedErr: `duplicate key "exporters" at line 5, first defined at line 1`,
+		},
+		{
+			name:        "nested",
+			content:     "exporters:\n  otlp:\n    endpoint: a\n    endpoint: b\n",
+			expectedErr: `duplicate key "exporters::otlp::endpoint" at line 4, first defined at line 3`,
+		},
+		{
+			name:        "in_list",
+			content:     "list:\n  - key: a\n    key: b\n",
+			expectedErr: `duplicate key "list::0::key" at line 3, first defined at line 2`,
+		},
+		{
+			name:        "json",
+			content:     `{"key": 1, "key": 2}`,
+			expectedErr: `duplicate key "key" at line 1, first defined at line 1`,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := NewMapFromReader(strings.NewReader(test.content))
+			assert.EqualError(t, err, test.expectedErr)
+		})
+	}
+
+	// The same key in different maps, or overriding a merged key, is allowed.
+	cfgMap, err := NewMapFromReader(strings.NewReader("a:\n  key: 1\nb:\n  <<: {key: 2}\n  key: 3\n"))
+	require.NoError(t, err)
+	assert.Equal(t, 1, cfgMap.Get("a::key"))
+	assert.Equal(t, 3, cfgMap.Get("b::key"))
+}
+
+func TestNewMapFromReaderError(t *testing.T) {
+	_, err := NewMapFromReader(strings.NewReader(`[1, 2]`))
+	assert.Error(t, err)
+
+	_, err = NewMapFromReader(iotest.ErrReader(errors.New("read error")))
+	assert.Error(t, err)
+}
+
+func TestMapTypedGetters(t *testing.T) {
+	cfgMap, err := newMapFromFile(filepath.Join("testdata", "basic_types.yaml"))
+	require.NoError(t, err)
+
+	str, ok := cfgMap.GetString("typed.options::string.example")
+	assert.True(t, ok)
+	assert.Equal(t, "this is a string", str)
+	_, ok = cfgMap.GetString("typed.options::integer.example")
+	assert.False(t, ok)
+
+	i, ok := cfgMap.GetInt("typed.options::integer.example")
+	assert.True(t, ok)
+	assert.Equal(t, 1234, i)
+	_, ok = cfgMap.GetInt("typed.options::floating.point.example")
+	assert.False(t, ok)
+
+	b, ok := cfgMap.GetBool("typed.options::bool.example")
+	assert.True(t, ok)
+	assert.False(t, b)
+	_, ok = cfgMap.GetBool("typed.options::nil.example")
+	assert.False(t, ok)
+
+	// Dotted keys are not nested keys.
+	_, ok = cfgMap.GetInt("typed::options::integer::example")
+	assert.False(t, ok)
+
+	embedded, err := newMapFromFile(filepath.Join("testdata", "embedded_keys.yaml"))
+	require.NoError(t, err)
+	i, ok = embedded.GetInt("typed::options::integer::example")
+	assert.True(t, ok)
+	assert.Equal(t, 1234, i)
+	_, ok = embedded.GetInt("typed.options::integer.example")
+	assert.False(t, ok)
+}
+
+func TestMapGetIntTypes(t *testing.T) {
+	cfgMap := NewMapFromStringMap(map[string]interface{}{
+		"int64":  int64(12),
+		"uint8":  uint8(12),
+		"uint64": uint64(math.MaxUint64),
+		"string": "12",
+	})
+	i, ok := cfgMap.GetInt("int64")
+	assert.True(t, ok)
+	assert.Equal(t, 12, i)
+	i, ok = cfgMap.GetInt("uint8")
+	assert.True(t, ok)
+	assert.Equal(t, 12, i)
+	_, ok = cfgMap.GetInt("uint64")
+	assert.False(t, ok)
+	_, ok = cfgMap.GetInt("string")
+	assert.False(t, ok)
+	_, ok = cfgMap.GetInt("missing")
+	assert.False(t, ok)
+}
+
+func TestMapGetStringSlice(t *testing.T) {
+	cfgMap, err := newMapFromFile(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+	exts, ok := cfgMap.GetStringSlice("service::extensions")
+	assert.True(t, ok)
+	assert.Equal(t, []string{"nop"}, exts)
+	_, ok = cfgMap.GetStringSlice("service::pipelines")
+	assert.False(t, ok)
+
+	cfgMap.Set("strings", []string{"a", "b"})
+	strs, ok := cfgMap.GetStringSlice("strings")
+	assert.True(t, ok)
+	assert.Equal(t, []string{"a", "b"}, strs)
+
+	cfgMap.Set("mixed", []interface{}{"a", 1})
+	_, ok = cfgMap.GetStringSlice("mixed")
+	assert.False(t, ok)
+}
+
+func TestMapKeysOrder(t *testing.T) {
+	content, err := ioutil.ReadFile(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+	cfgMap, err := NewMapFromReader(bytes.NewReader(content))
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"receivers::nop",
+		"receivers::nop/myreceiver",
+		"processors::nop",
+		"processors::nop/myprocessor",
+		"exporters::nop",
+		"exporters::nop/myexporter",
+		"extensions::nop",
+		"extensions::nop/myextension",
+		"service::extensions",
+		"service::pipelines::traces::receivers",
+		"service::pipelines::traces::processors",
+		"service::pipelines::traces::exporters",
+	}, cfgMap.Keys())
+
+	sub, err := cfgMap.Sub("service::pipelines::traces")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"receivers", "processors", "exporters"}, sub.Keys())
+
+	// New keys are appended, existing keys keep their position.
+	cfgMap.Set("service::telemetry::logs::level", "debug")
+	cfgMap.Set("receivers::nop", map[string]interface{}{"endpoint": "localhost:4317"})
+	cfgMap.Set("exporters::nop/myexporter", "replaced")
+	keys := cfgMap.Keys()
+	assert.Equal(t, "exporters::nop/myexporter", keys[4])
+	assert.Equal(t, []string{"service::telemetry::logs::level", "receivers::nop::endpoint"}, keys[len(keys)-2:])
+	assert.NotContains(t, keys, "receivers::nop")
+	assert.ElementsMatch(t, cfgMap.AllKeys(), keys)
+}
+
+func TestMapKeysReplaced(t *testing.T) {
+	cfgMap := NewMapFromStringMap(map[string]interface{}{
+		"a": map[string]interface{}{"x": 1, "y": 2},
+		"b": 3,
+	})
+	cfgMap.Set("b::z", 4)
+	assert.Equal(t, []string{"a::x", "a::y", "b::z"}, cfgMap.Keys())
+	cfgMap.Set("a", 5)
+	assert.Equal(t, []string{"b::z", "a"}, cfgMap.Keys())
+	assert.True(t, cfgMap.Delete("b::z"))
+	assert.Equal(t, []string{"a"}, cfgMap.Keys())
+	require.NoError(t, cfgMap.Merge(NewMapFromStringMap(map[string]interface{}{"a": map[string]interface{}{"w": 6}})))
+	assert.Equal(t, []string{"a::w"}, cfgMap.Keys())
+	assert.ElementsMatch(t, cfgMap.AllKeys(), cfgMap.Keys())
+}
+
+func TestMapKeysConcurrentReads(t *testing.T) {
+	cfgMap, err := NewMapFromReader(strings.NewReader("b: 1\na: 2\n"))
+	require.NoError(t, err)
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.Equal(t, []string{"b", "a"}, cfgMap.Keys())
+		}()
+	}
+	wg.Wait()
+}
+
+func TestMapKeysOrderJSON(t *testing.T) {
+	cfgMap, err := NewMapFromReader(strings.NewReader(`{"b": {"y": 1, "x": 2}, "a": 3}`))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"b::y", "b::x", "a"}, cfgMap.Keys())
+}
+
+func TestMapKeysOrderMerge(t *testing.T) {
+	cfgMap, err := NewMapFromReader(strings.NewReader("b: 1\na: 2\n"))
+	require.NoError(t, err)
+	override, err := NewMapFromReader(strings.NewReader("d: 3\na: 4\nc: 5\n"))
+	require.NoError(t, err)
+	require.NoError(t, cfgMap.Merge(override))
+	assert.Equal(t, []string{"b", "a", "d", "c"}, cfgMap.Keys())
+
+	// Maps created from a map[string]interface{} are in lexical order.
+	assert.Equal(t, []string{"a", "b"}, NewMapFromStringMap(map[string]interface{}{"b": 1, "a": 2}).Keys())
+}
+
+func TestMapToYAML(t *testing.T) {
+	content := `receivers:
+  otlp:
+    protocols:
+      http: null
+      grpc:
+        endpoint: 0.0.0.0:4317
+exporters:
+  logging:
+    loglevel: debug
+service:
+  pipelines:
+    traces:
+      receivers:
+      - otlp
+      exporters:
+      - logging
+      - b: 2
+        a: 1
+`
+	cfgMap, err := NewMapFromReader(strings.NewReader(content))
+	require.NoError(t, err)
+
+	out, err := cfgMap.ToYAML()
+	require.NoError(t, err)
+	// The keys of the maps nested in slices are sorted.
+	assert.Equal(t, strings.Replace(content, "      - b: 2\n        a: 1\n", "      - a: 1\n        b: 2\n", 1), string(out))
+
+	// The serialized Map is loaded back to the same Map.
+	reloaded, err := NewMapFromReader(bytes.NewReader(out))
+	require.NoError(t, err)
+	assert.Equal(t, cfgMap.ToStringMap(), reloaded.ToStringMap())
+	assert.Equal(t, cfgMap.Keys(), reloaded.Keys())
+
+	// Keys set after loading are written after the existing ones.
+	cfgMap.Set("exporters::logging::sampling_initial", 5)
+	cfgMap.Set("extensions::zpages", map[string]interface{}{})
+	out, err = cfgMap.ToYAML()
+	require.NoError(t, err)
+	assert.Contains(t, string(out), "    loglevel: debug\n    sampling_initial: 5\nservice:\n")
+	assert.True(t, strings.HasSuffix(string(out), "extensions:\n  zpages: {}\n"))
+
+	out, err = NewMapFromStringMap(map[string]interface{}{"b": 1, "a": map[string]interface{}{"d": true, "c": "value"}}).ToYAML()
+	require.NoError(t, err)
+	assert.Equal(t, "a:\n  c: value\n  d: true\nb: 1\n", string(out))
+}
+
+func TestMapFlatten(t *testing.T) {
+	cfgMap := NewMapFromStringMap(map[string]interface{}{
+		"typed": map[string]interface{}{
+			"options": map[string]interface{}{
+				"integer": map[string]interface{}{"example": 1234},
+			},
+		},
+		"list": []interface{}{
+			"value",
+			map[string]interface{}{"key": true},
+			map[interface{}]interface{}{"other": 1.5},
+		},
+		"empty_map":   map[string]interface{}{},
+		"empty_slice": []interface{}{},
+	})
+
+	expected := map[string]interface{}{
+		"typed.options.integer.example": 1234,
+		"list.0":                        "value",
+		"list.1.key":                    true,
+		"list.2.other":                  1.5,
+		"empty_map":                     map[string]interface{}{},
+		"empty_slice":                   []interface{}{},
+	}
+	assert.Equal(t, expected, cfgMap.Flatten("."))
+}
+
+func TestNewMapFromFlatMap(t *testing.T) {
+	cfgMap := NewMapFromFlatMap(map[string]interface{}{
+		"typed_options_integer_example": 1234,
+		"list_0":                        "value",
+		"list_1_key":                    true,
+		"not_list_0":                    "a",
+		"not_list_2":                    "b",
+		"empty":                         map[string]interface{}{},
+	}, "_")
+
+	expected := map[string]interface{}{
+		"typed": map[string]interface{}{
+			"options": map[string]interface{}{
+				"integer": map[string]interface{}{"example": 1234},
+			},
+		},
+		"list": []interface{}{
+			"value",
+			map[string]interface{}{"key": true},
+		},
+		"not": map[string]interface{}{
+			"list": map[string]interface{}{"0": "a", "2": "b"},
+		},
+		"empty": map[string]interface{}{},
+	}
+	assert.Equal(t, expected, cfgMap.ToStringMap())
+	assert.Equal(t, cfgMap.ToStringMap(), NewMapFromFlatMap(cfgMap.Flatten("_"), "_").ToStringMap())
+}
+
+func TestMapLowercaseKeys(t *testing.T) {
+	cfgMap := NewMapFromStringMap(map[string]interface{}{
+		"Receivers": map[string]interface{}{
+			"OTLP": map[string]interface{}{"Endpoint": "localhost:4317"},
+		},
+		"receivers": map[string]interface{}{
+			"jaeger": map[string]interface{}{},
+		},
+		"List":  []interface{}{map[interface{}]interface{}{"Key": "value"}},
+		"Value": "first",
+		"value": "second",
+	}, WithLowercaseKeys())
+
+	expected := map[string]interface{}{
+		"receivers": map[string]interface{}{
+			"otlp":   map[string]interface{}{"endpoint": "localhost:4317"},
+			"jaeger": map[string]interface{}{},
+		},
+		"list":  []interface{}{map[string]interface{}{"key": "value"}},
+		"value": "second",
+	}
+	assert.Equal(t, expected, cfgMap.ToStringMap())
+
+	assert.True(t, cfgMap.IsSet("RECEIVERS::otlp"))
+	assert.Equal(t, "localhost:4317", cfgMap.Get("receivers::OTLP::endpoint"))
+
+	cfgMap.Set("Exporters::Logging", map[string]interface{}{"LogLevel": "debug"})
+	assert.Equal(t, "debug", cfgMap.Get("exporters::logging::loglevel"))
+
+	sub, err := cfgMap.Sub("Receivers")
+	require.NoError(t, err)
+	assert.Equal(t, "localhost:4317", sub.Get("OTLP::Endpoint"))
+
+	require.NoError(t, cfgMap.Merge(NewMapFromStringMap(map[string]interface{}{
+		"Receivers": map[string]interface{}{"OTLP": map[string]interface{}{"Endpoint": "localhost:55680"}},
+	})))
+	assert.Equal(t, "localhost:55680", cfgMap.Get("receivers::otlp::endpoint"))
+	assert.NotContains(t, cfgMap.AllKeys(), "Receivers::OTLP::Endpoint")
+}
+
+func TestMapLowercaseKeysFromReader(t *testing.T) {
+	cfgMap, err := NewMapFromReader(strings.NewReader("Receivers:\n  OTLP:\n    Endpoint: localhost:4317\nExporters:\n  Logging: {}\n"), WithLowercaseKeys())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"receivers::otlp::endpoint", "exporters::logging"}, cfgMap.Keys())
+}
+
+func TestMapCaseSensitiveByDefault(t *testing.T) {
+	cfgMap := NewMapFromStringMap(map[string]interface{}{"Receivers": map[string]interface{}{"OTLP": nil}})
+	assert.Equal(t, []string{"Receivers::OTLP"}, cfgMap.AllKeys())
+	assert.Nil(t, cfgMap.Get("receivers"))
+}
+
+func TestMapGetValue(t *testing.T) {
+	cfgMap := NewMapFromStringMap(map[string]interface{}{
+		"service": map[string]interface{}{
+			"telemetry": map[string]interface{}{
+				"logs": map[string]interface{}{"level": "debug"},
+			},
+			"extensions": []interface{}{"zpages"},
+		},
+		"empty": nil,
+	})
+
+	value, err := cfgMap.GetValue("service::telemetry::logs::level")
+	require.NoError(t, err)
+	assert.Equal(t, "debug", value)
+
+	value, err = cfgMap.GetValue("service::telemetry::logs")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"level": "debug"}, value)
+
+	value, err = cfgMap.GetValue("service::telemetry::metrics::level")
+	require.NoError(t, err)
+	assert.Nil(t, value)
+
+	value, err = cfgMap.GetValue("empty")
+	require.NoError(t, err)
+	assert.Nil(t, value)
+
+	_, err = cfgMap.GetValue("service::telemetry::logs::level::other")
+	assert.EqualError(t, err, `config key "service::telemetry::logs::level" is not a map`)
+
+	_, err = cfgMap.GetValue("service::extensions::0")
+	assert.EqualError(t, err, `config key "service::extensions" is not a map`)
+}
+
+func TestMapClone(t *testing.T) {
+	orig := NewMapFromStringMap(map[string]interface{}{
+		"exporters": map[string]interface{}{
+			"otlp": map[string]interface{}{
+				"endpoint": "localhost:4317",
+				"headers":  map[string]interface{}{"tenant": "template"},
+			},
+		},
+		"list": []interface{}{"a", map[string]interface{}{"b": "c"}},
+	})
+	clone := orig.Clone()
+	assert.Equal(t, orig.ToStringMap(), clone.ToStringMap())
+	assert.Equal(t, orig.Keys(), clone.Keys())
+
+	clone.Set("exporters::otlp::headers::tenant", "tenant1")
+	clone.Get("list").([]interface{})[1].(map[string]interface{})["b"] = "changed"
+	require.NoError(t, clone.Merge(NewMapFromStringMap(map[string]interface{}{
+		"exporters": map[string]interface{}{"otlp": map[string]interface{}{"compression": "gzip"}},
+	})))
+
+	assert.Equal(t, "template", orig.Get("exporters::otlp::headers::tenant"))
+	assert.False(t, orig.IsSet("exporters::otlp::compression"))
+	assert.Equal(t, "c", orig.Get("list").([]interface{})[1].(map[string]interface{})["b"])
+	assert.Equal(t, "tenant1", clone.Get("exporters::otlp::headers::tenant"))
+	assert.Equal(t, "gzip", clone.Get("exporters::otlp::compression"))
+
+	lowercase := NewMapFromStringMap(map[string]interface{}{"Key": "value"}, WithLowercaseKeys()).Clone()
+	assert.Equal(t, "value", lowercase.Get("KEY"))
+}
+
+func TestMapDelete(t *testing.T) {
+	cfgMap := NewMapFromStringMap(map[string]interface{}{
+		"service": map[string]interface{}{
+			"pipelines": map[string]interface{}{
+				"traces":  map[string]interface{}{"receivers": []interface{}{"otlp"}},
+				"metrics": map[string]interface{}{"receivers": []interface{}{"otlp"}},
+			},
+		},
+		"receivers": map[string]interface{}{
+			"otlp": map[string]interface{}{"endpoint": "localhost:4317"},
+		},
+	})
+
+	assert.True(t, cfgMap.Delete("service::pipelines::traces"))
+	assert.False(t, cfgMap.IsSet("service::pipelines::traces"))
+	assert.True(t, cfgMap.IsSet("service::pipelines::metrics::receivers"))
+
+	// Parent maps left empty are removed.
+	assert.True(t, cfgMap.Delete("receivers::otlp::endpoint"))
+	assert.False(t, cfgMap.IsSet("receivers"))
+
+	assert.False(t, cfgMap.Delete("receivers::otlp"))
+	assert.False(t, cfgMap.Delete("service::pipelines::logs"))
+	assert.False(t, cfgMap.Delete(""))
+
+	expected := map[string]interface{}{
+		"service": map[string]interface{}{
+			"pipelines": map[string]interface{}{
+				"metrics": map[string]interface{}{"receivers": []interface{}{"otlp"}},
+			},
+		},
+	}
+	assert.Equal(t, expected, cfgMap.ToStringMap())
+	assert.Equal(t, []string{"service::pipelines::metrics::receivers"}, cfgMap.AllKeys())
+}
+
+func TestMapDiff(t *testing.T) {
+	current := NewMapFromStringMap(map[string]interface{}{
+		"receivers": map[string]interface{}{
+			"otlp": map[string]interface{}{"endpoint": "localhost:4317"},
+		},
+		"exporters": map[string]interface{}{
+			"logging": map[string]interface{}{"loglevel": "info"},
+			"otlp":    map[string]interface{}{"endpoint": "backend:4317"},
+		},
+		"service": map[string]interface{}{
+			"extensions": []interface{}{"zpages"},
+		},
+	})
+	updated := NewMapFromStringMap(map[string]interface{}{
+		"receivers": map[string]interface{}{
+			"otlp": map[string]interface{}{"endpoint": "localhost:4317"},
+		},
+		"exporters": map[string]interface{}{
+			"logging": map[string]interface{}{"loglevel": "debug"},
+		},
+		"processors": map[string]interface{}{
+			"batch": map[string]interface{}{"timeout": "1s"},
+		},
+		"service": map[string]interface{}{
+			"extensions": []interface{}{"zpages", "pprof"},
+		},
+	})
+
+	expected := []string{
+		"exporters::logging::loglevel",
+		"exporters::otlp::endpoint",
+		"processors::batch::timeout",
+		"service::extensions",
+	}
+	assert.Equal(t, expected, current.Diff(updated))
+	assert.Equal(t, expected, updated.Diff(current))
+	assert.Empty(t, current.Diff(current))
+}
+
+func TestMapRedacted(t *testing.T) {
+	cfgMap := NewMapFromStringMap(map[string]interface{}{
+		"exporters": map[string]interface{}{
+			"otlp": map[string]interface{}{
+				"endpoint": "localhost:4317",
+				"headers": map[string]interface{}{
+					"authorization": "Bearer token",
+					"tenant":        "tenant-1",
+				},
+			},
+			"otlphttp": map[string]interface{}{
+				"headers": map[string]interface{}{
+					"authorization": "Bearer other",
+				},
+				"password": "secret",
+			},
+		},
+		"extensions": map[string]interface{}{
+			"auth": map[string]interface{}{
+				"users": []interface{}{
+					map[string]interface{}{"name": "user", "password": "secret"},
+				},
+				"tls": map[string]interface{}{"key_file": "/key"},
+			},
+		},
+	})
+
+	redacted := cfgMap.Redacted([]string{"password", "exporters::otlp::headers::authorization", "extensions::auth::tls"})
+	assert.Equal(t, map[string]interface{}{
+		"exporters": map[string]interface{}{
+			"otlp": map[string]interface{}{
+				"endpoint": "localhost:4317",
+				"headers": map[string]interface{}{
+					"authorization": "<redacted>",
+					"tenant":        "tenant-1",
+				},
+			},
+			"otlphttp": map[string]interface{}{
+				"headers": map[string]interface{}{
+					"authorization": "Bearer other",
+				},
+				"password": "<redacted>",
+			},
+		},
+		"extensions": map[string]interface{}{
+			"auth": map[string]interface{}{
+				"users": []interface{}{
+					map[string]interface{}{"name": "user", "password": "<redacted>"},
+				},
+				"tls": "<redacted>",
+			},
+		},
+	}, redacted)
+
+	// The Map itself is not modified.
+	assert.Equal(t, "secret", cfgMap.Get("exporters::otlphttp::password"))
+}
+
+type TestDurationConfig struct {
+	Timeout  time.Duration `mapstructure:"timeout"`
+	Interval time.Duration `mapstructure:"interval"`
+	Nested   struct {
+		Delay time.Duration `mapstructure:"delay"`
+	} `mapstructure:"nested"`
+}
+
+func TestStringToTimeDurationHookFunc(t *testing.T) {
+	stringMap := map[string]interface{}{
+		"timeout":  "30s",
+		"interval": "1m30s",
+		"nested": map[string]interface{}{
+			"delay": "500ms",
+		},
+	}
+	cfgMap := NewMapFromStringMap(stringMap)
+	cfg := &TestDurationConfig{}
+	assert.NoError(t, cfgMap.UnmarshalExact(cfg))
+	assert.Equal(t, 30*time.Second, cfg.Timeout)
+	assert.Equal(t, 90*time.Second, cfg.Interval)
+	assert.Equal(t, 500*time.Millisecond, cfg.Nested.Delay)
+}
+
+func TestStringToTimeDurationHookFuncInvalid(t *testing.T) {
+	stringMap := map[string]interface{}{
+		"nested": map[string]interface{}{
+			"delay": "thirty",
+		},
+	}
+	cfgMap := NewMapFromStringMap(stringMap)
+	cfg := &TestDurationConfig{}
+	err := cfgMap.UnmarshalExact(cfg)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "nested.delay")
+	assert.Contains(t, err.Error(), `invalid duration "thirty"`)
+}
+
+type TestBytesConfig struct {
+	Cert []byte `mapstructure:"cert"`
+}
+
+func TestStringToBytesHookFunc(t *testing.T) {
+	cfgMap := NewMapFromStringMap(map[string]interface{}{
+		"cert": "Y2VydGlmaWNhdGU=",
+	})
+	cfg := &TestBytesConfig{}
+	assert.NoError(t, cfgMap.UnmarshalExact(cfg))
+	assert.Equal(t, []byte("certificate"), cfg.Cert)
+}
+
+func TestStringToBytesHookFuncInvalid(t *testing.T) {
+	cfgMap := NewMapFromStringMap(map[string]interface{}{
+		"cert": "not base64!",
+	})
+	cfg := &TestBytesConfig{}
+	err := cfgMap.UnmarshalExact(cfg)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "'cert'")
+	assert.Contains(t, err.Error(), "failed to decode base64 string into []byte")
+}
+
+type TestSquashedEndpoint struct {
+	Endpoint string `mapstructure:"endpoint"`
+}
+
+type TestSquashedTimeout struct {
+	Timeout  time.Duration `mapstructure:"timeout"`
+	Endpoint string        `mapstructure:"endpoint"`
+}
+
+type TestSquashedCollisionConfig struct {
+	TestSquashedEndpoint `mapstructure:",squash"`
+	Nested               struct {
+		*TestSquashedTimeout `mapstructure:",squash"`
+		Other                TestSquashedEndpoint `mapstructure:",squash"`
+	} `mapstructure:"nested"`
+}
+
+type TestSquashedNoCollisionConfig struct {
+	TestSquashedEndpoint `mapstructure:",squash"`
+	Timeout              time.Duration          `mapstructure:"timeout"`
+	Remain               map[string]interface{} `mapstructure:",remain"`
+}
+
+func TestSquashedKeysCollisionHookFunc(t *testing.T) {
+	cfgMap := NewMapFromStringMap(map[string]interface{}{
+		"endpoint": "localhost:4317",
+		"nested":   map[string]interface{}{"endpoint": "localhost:4318"},
+	})
+	err := cfgMap.Unmarshal(&TestSquashedCollisionConfig{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `ambiguous key "endpoint" declared by both TestSquashedTimeout.Endpoint and Other.Endpoint`)
+
+	cfgMap = NewMapFromStringMap(map[string]interface{}{
+		"endpoint": "localhost:4317",
+		"timeout":  "5s",
+		"extra":    "value",
+	})
+	cfg := &TestSquashedNoCollisionConfig{}
+	require.NoError(t, cfgMap.Unmarshal(cfg))
+	assert.Equal(t, "localhost:4317", cfg.Endpoint)
+	assert.Equal(t, 5*time.Second, cfg.Timeout)
+	assert.Equal(t, map[string]interface{}{"extra": "value"}, cfg.Remain)
+}
+
+type TestNumbersConfig struct {
+	Int    int     `mapstructure:"int"`
+	Int8   int8    `mapstructure:"int8"`
+	Uint   uint    `mapstructure:"uint"`
+	Float  float64 `mapstructure:"float"`
+	Nested struct {
+		Int int64 `mapstructure:"int"`
+	} `mapstructure:"nested"`
+}
+
+func TestFloatToIntHookFunc(t *testing.T) {
+	cfgMap, err := NewMapFromReader(strings.NewReader(`
+int: 1.0
+int8: -128.0
+uint: 1e3
+float: 3
+nested:
+  int: 1234
+`))
+	require.NoError(t, err)
+	cfg := &TestNumbersConfig{}
+	require.NoError(t, cfgMap.Unmarshal(cfg))
+	assert.Equal(t, 1, cfg.Int)
+	assert.Equal(t, int8(-128), cfg.Int8)
+	assert.Equal(t, uint(1000), cfg.Uint)
+	assert.Equal(t, float64(3), cfg.Float)
+	assert.Equal(t, int64(1234), cfg.Nested.Int)
+
+	tests := []struct {
+		name        string
+		cfg         map[string]interface{}
+		expectedErr string
+	}{
+		{
+			name:        "fractional",
+			cfg:         map[string]interface{}{"int": 1.5},
+			expectedErr: "cannot decode 1.5 into int without losing precision",
+		},
+		{
+			name:        "nested_fractional",
+			cfg:         map[string]interface{}{"nested": map[string]interface{}{"int": 0.1}},
+			expectedErr: "cannot decode 0.1 into int64 without losing precision",
+		},
+		{
+			name:        "overflow",
+			cfg:         map[string]interface{}{"int8": 128.0},
+			expectedErr: "cannot decode 128 into int8 without losing precision",
+		},
+		{
+			name:        "out_of_int64_range",
+			cfg:         map[string]interface{}{"int": 1e30},
+			expectedErr: "cannot decode 1e+30 into int without losing precision",
+		},
+		{
+			name:        "negative_uint",
+			cfg:         map[string]interface{}{"uint": -1.0},
+			expectedErr: "cannot decode -1 into uint without losing precision",
+		},
+		{
+			name:        "nan",
+			cfg:         map[string]interface{}{"int": math.NaN()},
+			expectedErr: "cannot decode NaN into int without losing precision",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := NewMapFromStringMap(tt.cfg).Unmarshal(&TestNumbersConfig{})
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.expectedErr)
+		})
+	}
+}
+
+type TestJSONTagsConfig struct {
+	Endpoint string        `json:"endpoint"`
+	Timeout  time.Duration `json:"timeout,omitempty"`
+	Cert     []byte        `json:"cert"`
+	Port     int           `json:"port"`
+	Ignored  string        `json:"-" mapstructure:"ignored"`
+}
+
+type TestJSONTagsCollisionConfig struct {
+	TestJSONTagsConfig `json:",squash"`
+	Other              string `json:"endpoint"`
+}
+
+func TestMapUnmarshalWithTagName(t *testing.T) {
+	stringMap := map[string]interface{}{
+		"endpoint": "localhost:4317",
+		"timeout":  "5s",
+		"cert":     "Y2VydA==",
+		"port":     4317.0,
+		"ignored":  "value",
+	}
+
+	cfg := &TestJSONTagsConfig{}
+	require.NoError(t, NewMapFromStringMap(stringMap).Unmarshal(cfg, WithTagName("json")))
+	assert.Equal(t, &TestJSONTagsConfig{
+		Endpoint: "localhost:4317",
+		Timeout:  5 * time.Second,
+		Cert:     []byte("cert"),
+		Port:     4317,
+	}, cfg)
+
+	// The mapstructure tags are used by default.
+	cfg = &TestJSONTagsConfig{}
+	require.NoError(t, NewMapFromStringMap(stringMap).Unmarshal(cfg))
+	assert.Equal(t, "value", cfg.Ignored)
+
+	// The hooks apply with the alternate tag name.
+	err := NewMapFromStringMap(map[string]interface{}{"port": 1.5}).Unmarshal(&TestJSONTagsConfig{}, WithTagName("json"))
+	assert.ErrorContains(t, err, "cannot decode 1.5 into int without losing precision")
+	err = NewMapFromStringMap(map[string]interface{}{"endpoint": "localhost"}).Unmarshal(&TestJSONTagsCollisionConfig{}, WithTagName("json"))
+	assert.ErrorContains(t, err, `ambiguous key "endpoint" declared by both TestJSONTagsConfig.Endpoint and Other`)
+
+	// Unknown keys are detected with the alternate tag name.
+	err = NewMapFromStringMap(stringMap).UnmarshalExact(&TestJSONTagsConfig{}, WithTagName("json"))
+	assert.ErrorContains(t, err, "ignored")
+}
+
+type TestWeaklyTypedConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+	Port    int  `mapstructure:"port"`
+}
+
+func TestMapUnmarshalWeaklyTypedInput(t *testing.T) {
+	stringMap := map[string]interface{}{
+		"enabled": "true",
+		"port":    "4317",
+	}
+
+	cfg := &TestWeaklyTypedConfig{}
+	require.NoError(t, NewMapFromStringMap(stringMap).Unmarshal(cfg))
+	assert.Equal(t, &TestWeaklyTypedConfig{Enabled: true, Port: 4317}, cfg)
+
+	cfg = &TestWeaklyTypedConfig{}
+	require.NoError(t, NewMapFromStringMap(stringMap).UnmarshalExact(cfg, WithWeaklyTypedInput(true)))
+	assert.Equal(t, &TestWeaklyTypedConfig{Enabled: true, Port: 4317}, cfg)
+
+	cfg = &TestWeaklyTypedConfig{}
+	err := NewMapFromStringMap(stringMap).UnmarshalExact(cfg, WithWeaklyTypedInput(false))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "'enabled' expected type 'bool', got unconvertible type 'string'")
+	assert.Contains(t, err.Error(), "'port' expected type 'int', got unconvertible type 'string'")
+
+	cfg = &TestWeaklyTypedConfig{}
+	require.NoError(t, NewMapFromStringMap(map[string]interface{}{"enabled": true, "port": 4317}).Unmarshal(cfg, WithWeaklyTypedInput(false)))
+	assert.Equal(t, &TestWeaklyTypedConfig{Enabled: true, Port: 4317}, cfg)
+}
@@ -0,0 +1,86 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package yamlerror adds the position of the failure to the YAML parse errors,
+// so the configuration files edited by hand can be fixed easily.
+package yamlerror // import "go.opentelemetry.io/collector/config/internal/yamlerror"
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// lineRegexp matches the errors returned by the YAML parsers that report the line of the failure.
+var lineRegexp = regexp.MustCompile(`^yaml: line (\d+): (.*)$`)
+
+// parseError is a YAML parse error at a known position of the content.
+type parseError struct {
+	line   int
+	column int
+	msg    string
+	err    error
+}
+
+func (e *parseError) Error() string {
+	if e.column > 0 {
+		return fmt.Sprintf("line %d, column %d: %s", e.line, e.column, e.msg)
+	}
+	return fmt.Sprintf("line %d: %s", e.line, e.msg)
+}
+
+func (e *parseError) Unwrap() error {
+	return e.err
+}
+
+// Wrap returns err, returned by the YAML parser for content, with the position of the failure.
+// If the failure is caused by a tab used for indentation, which YAML does not allow, the error
+// points to the first tab instead, since the parser reports confusing messages and lines for it.
+// Errors not reporting a line are returned unchanged.
+func Wrap(content []byte, err error) error {
+	if err == nil {
+		return nil
+	}
+	msg := err.Error()
+	if strings.Contains(msg, "tab character") || strings.Contains(msg, "cannot start any token") {
+		if line, column, ok := findTabIndentation(content); ok {
+			return &parseError{line: line, column: column, msg: "found a tab character used for indentation, use spaces instead", err: err}
+		}
+	}
+	m := lineRegexp.FindStringSubmatch(msg)
+	if m == nil {
+		return err
+	}
+	line, convErr := strconv.Atoi(m[1])
+	if convErr != nil {
+		return err
+	}
+	return &parseError{line: line, msg: m[2], err: err}
+}
+
+// findTabIndentation returns the line and column, starting at 1, of the first tab in the indentation of a line.
+func findTabIndentation(content []byte) (int, int, bool) {
+	for i, line := range strings.Split(string(content), "\n") {
+		for j, c := range line {
+			if c == '\t' {
+				return i + 1, j + 1, true
+			}
+			if c != ' ' {
+				break
+			}
+		}
+	}
+	return 0, 0, false
+}
@@ -0,0 +1,80 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package yamlerror
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v2"
+	yamlv3 "gopkg.in/yaml.v3"
+)
+
+func TestWrap(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected string
+	}{
+		{
+			name:     "tab_indentation",
+			content:  "receivers:\n\totlp:\n",
+			expected: "line 2, column 1: found a tab character used for indentation, use spaces instead",
+		},
+		{
+			name:     "tab_after_spaces",
+			content:  "receivers:\n  otlp:\n  \tprotocols:\n",
+			expected: "line 3, column 3: found a tab character used for indentation, use spaces instead",
+		},
+		{
+			name:     "tab_in_nested_map",
+			content:  "receivers:\n  otlp:\n\tjaeger:\n",
+			expected: "line 3, column 1: found a tab character used for indentation, use spaces instead",
+		},
+		{
+			name:     "invalid_mapping",
+			content:  "receivers:\n  otlp: 1\n   jaeger: [\n",
+			expected: "line 3: mapping values are not allowed in this context",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var node yamlv3.Node
+			errV3 := yamlv3.Unmarshal([]byte(tt.content), &node)
+			require.Error(t, errV3)
+			err := Wrap([]byte(tt.content), errV3)
+			assert.EqualError(t, err, tt.expected)
+			assert.True(t, errors.Is(err, errV3))
+
+			var data map[string]interface{}
+			errV2 := yaml.Unmarshal([]byte(tt.content), &data)
+			require.Error(t, errV2)
+			assert.EqualError(t, Wrap([]byte(tt.content), errV2), tt.expected)
+		})
+	}
+}
+
+func TestWrapWithoutLine(t *testing.T) {
+	assert.NoError(t, Wrap(nil, nil))
+
+	err := errors.New("yaml: mapping values are not allowed in this context")
+	assert.Equal(t, err, Wrap([]byte("a: b: c"), err))
+
+	// Tabs are reported only when they cause the failure.
+	err = errors.New("yaml: line 1: did not find expected key")
+	assert.EqualError(t, Wrap([]byte("a: |\n\ttext\n"), err), "line 1: did not find expected key")
+}
@@ -16,46 +16,100 @@ package expandmapconverter // import "go.opentelemetry.io/collector/config/mapco
 
 import (
 	"context"
+	"fmt"
+	"io/ioutil"
 	"os"
+	"strconv"
+	"strings"
 
 	"go.opentelemetry.io/collector/config"
 )
 
+const (
+	envPrefix    = "env:"
+	configPrefix = "config:"
+	filePrefix   = "file:"
+)
+
 // New returns a config.MapConverterFunc, that expands all environment variables for a given config.Map.
 //
+// The following forms are supported in string values, including the ones nested in maps and slices:
+//   - $VAR and ${VAR} are replaced by the value of the environment variable VAR;
+//   - ${env:VAR} is equivalent to ${VAR};
+//   - ${VAR:-default} and ${env:VAR:-default} are replaced by "default" if VAR is unset or empty;
+//   - ${VAR:?message} and ${env:VAR:?message} are replaced by the value of VAR, an error including
+//     "message" is returned if VAR is unset or empty;
+//   - ${config:KEY} is replaced by the value at the KEY path in the config.Map, using config.KeyDelimiter
+//     to separate the path elements, e.g. ${config:service::telemetry::logs::level}. If the whole string
+//     value is a single reference, the referenced value is used as is, keeping its type;
+//   - ${file:PATH} is replaced by the content of the file at PATH, with the leading and trailing
+//     white space removed, e.g. ${file:/var/run/secrets/token}.
+//
+// An error, including the key of the value, is returned if a variable is unset and has no default,
+// if a required variable is unset or empty,
+// if a referenced key is not set, if references form a cycle, or if a file cannot be read.
+//
 // Notice: This API is experimental.
 func New() config.MapConverterFunc {
 	return func(_ context.Context, cfgMap *config.Map) error {
+		// References are resolved against the original values, so values are expanded only once.
+		exp := &expander{orig: config.NewMapFromStringMap(cfgMap.ToStringMap()), resolving: map[string]bool{}}
 		for _, k := range cfgMap.AllKeys() {
-			cfgMap.Set(k, expandStringValues(cfgMap.Get(k)))
+			exp.resolving[k] = true
+			val, err := exp.expandStringValues(k, cfgMap.Get(k))
+			delete(exp.resolving, k)
+			if err != nil {
+				return err
+			}
+			cfgMap.Set(k, val)
 		}
 		return nil
 	}
 }
 
-func expandStringValues(value interface{}) interface{} {
+type expander struct {
+	orig *config.Map
+	// resolving contains the config references being resolved, to detect cycles.
+	resolving map[string]bool
+}
+
+func (exp *expander) expandStringValues(key string, value interface{}) (interface{}, error) {
 	switch v := value.(type) {
 	case string:
-		return expandEnv(v)
+		return exp.expandString(key, v)
 	case []interface{}:
 		nslice := make([]interface{}, 0, len(v))
-		for _, vint := range v {
-			nslice = append(nslice, expandStringValues(vint))
+		for i, vint := range v {
+			val, err := exp.expandStringValues(key+config.KeyDelimiter+strconv.Itoa(i), vint)
+			if err != nil {
+				return nil, err
+			}
+			nslice = append(nslice, val)
 		}
-		return nslice
+		return nslice, nil
 	case map[string]interface{}:
 		nmap := map[string]interface{}{}
 		for mk, mv := range v {
-			nmap[mk] = expandStringValues(mv)
+			val, err := exp.expandStringValues(key+config.KeyDelimiter+mk, mv)
+			if err != nil {
+				return nil, err
+			}
+			nmap[mk] = val
 		}
-		return nmap
+		return nmap, nil
 	default:
-		return v
+		return v, nil
 	}
 }
 
-func expandEnv(s string) string {
-	return os.Expand(s, func(str string) string {
+func (exp *expander) expandString(key string, s string) (interface{}, error) {
+	// A value that is a single config reference keeps the type of the referenced value.
+	if strings.HasPrefix(s, "${"+configPrefix) && strings.Index(s, "}") == len(s)-1 {
+		return exp.resolveConfig(key, s[len("${"+configPrefix):len(s)-1])
+	}
+
+	var err error
+	res := os.Expand(s, func(str string) string {
 		// This allows escaping environment variable substitution via $$, e.g.
 		// - $FOO will be substituted with env var FOO
 		// - $$FOO will be replaced with $FOO
@@ -63,6 +117,90 @@ func expandEnv(s string) string {
 		if str == "$" {
 			return "$"
 		}
-		return os.Getenv(str)
+		if strings.HasPrefix(str, configPrefix) {
+			val, cfgErr := exp.resolveConfig(key, strings.TrimPrefix(str, configPrefix))
+			if cfgErr != nil {
+				if err == nil {
+					err = cfgErr
+				}
+				return ""
+			}
+			return fmt.Sprint(val)
+		}
+		if strings.HasPrefix(str, filePrefix) {
+			val, fileErr := readFile(key, strings.TrimPrefix(str, filePrefix))
+			if fileErr != nil {
+				if err == nil {
+					err = fileErr
+				}
+				return ""
+			}
+			return val
+		}
+		val, envErr := expandEnvVar(key, str)
+		if envErr != nil && err == nil {
+			err = envErr
+		}
+		return val
 	})
+	return res, err
+}
+
+// resolveConfig returns the expanded value at the ref path in the original config.Map.
+func (exp *expander) resolveConfig(key string, ref string) (interface{}, error) {
+	if exp.resolving[ref] {
+		return nil, fmt.Errorf("failed to expand %q: cycle detected resolving config reference %q", key, ref)
+	}
+	if !exp.orig.IsSet(ref) {
+		return nil, fmt.Errorf("failed to expand %q: config key %q is not set", key, ref)
+	}
+	exp.resolving[ref] = true
+	defer delete(exp.resolving, ref)
+	return exp.expandStringValues(ref, exp.orig.Get(ref))
+}
+
+// readFile returns the trimmed content of the file at path.
+func readFile(key string, path string) (string, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to expand %q: unable to read file %q: %w", key, path, err)
+	}
+	return strings.TrimSpace(string(content)), nil
+}
+
+// expandEnvVar returns the value of an "[env:]NAME[:-default|:?message]" reference.
+func expandEnvVar(key string, str string) (string, error) {
+	name, op, word := parseEnvVar(str)
+	val, ok := os.LookupEnv(name)
+	switch op {
+	case '-':
+		if val == "" {
+			return word, nil
+		}
+		return val, nil
+	case '?':
+		if val != "" {
+			return val, nil
+		}
+		if word == "" {
+			word = "not set or empty"
+		}
+		return "", fmt.Errorf("failed to expand %q: environment variable %q is required: %s", key, name, word)
+	}
+	if !ok {
+		return "", fmt.Errorf("failed to expand %q: environment variable %q is not set", key, name)
+	}
+	return val, nil
+}
+
+// parseEnvVar splits an "[env:]NAME[:-default|:?message]" reference into its name, its operator,
+// '-' or '?', and the default value or error message. The operator is 0 for a plain reference.
+func parseEnvVar(str string) (name string, op byte, word string) {
+	str = strings.TrimPrefix(str, envPrefix)
+	for i := 0; i+1 < len(str); i++ {
+		if str[i] == ':' && (str[i+1] == '-' || str[i+1] == '?') {
+			return str[:i], str[i+1], str[i+2:]
+		}
+	}
+	return str, 0, ""
 }
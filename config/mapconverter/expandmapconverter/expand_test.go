@@ -16,6 +16,7 @@ package expandmapconverter
 
 import (
 	"context"
+	"io/ioutil"
 	"path/filepath"
 	"testing"
 
@@ -115,3 +116,219 @@ func TestNewExpandConverter_EscapedEnvVars(t *testing.T) {
 	require.NoError(t, New()(context.Background(), cfgMap))
 	assert.Equal(t, expectedMap, cfgMap.ToStringMap())
 }
+
+func TestNewExpandConverter_DefaultsAndPrefix(t *testing.T) {
+	t.Setenv("SET_VALUE", "set value")
+	t.Setenv("EMPTY_VALUE", "")
+
+	cfgMap := config.NewMapFromStringMap(
+		map[string]interface{}{
+			"env_prefix":       "${env:SET_VALUE}",
+			"default_unused":   "${SET_VALUE:-default}",
+			"default_unset":    "${UNSET_VALUE:-default}",
+			"default_empty":    "${EMPTY_VALUE:-default}",
+			"default_prefix":   "${env:UNSET_VALUE:-default}",
+			"default_blank":    "${UNSET_VALUE:-}",
+			"default_endpoint": "${env:UNSET_VALUE:-localhost:4317}",
+			"empty_no_default": "${EMPTY_VALUE}",
+			"required_set":     "${SET_VALUE:?must be set}",
+			"required_prefix":  "${env:SET_VALUE:?must be set}",
+			"nested": map[string]interface{}{
+				"list": []interface{}{"${UNSET_VALUE:-list default}"},
+			},
+		},
+	)
+	require.NoError(t, New()(context.Background(), cfgMap))
+
+	expectedMap := map[string]interface{}{
+		"env_prefix":       "set value",
+		"default_unused":   "set value",
+		"default_unset":    "default",
+		"default_empty":    "default",
+		"default_prefix":   "default",
+		"default_blank":    "",
+		"default_endpoint": "localhost:4317",
+		"empty_no_default": "",
+		"required_set":     "set value",
+		"required_prefix":  "set value",
+		"nested": map[string]interface{}{
+			"list": []interface{}{"list default"},
+		},
+	}
+	assert.Equal(t, expectedMap, cfgMap.ToStringMap())
+}
+
+func TestNewExpandConverter_UnsetVariable(t *testing.T) {
+	var testCases = []struct {
+		name        string
+		cfg         map[string]interface{}
+		expectedErr string
+	}{
+		{
+			name:        "string",
+			cfg:         map[string]interface{}{"key": "${UNSET_VALUE}"},
+			expectedErr: `failed to expand "key": environment variable "UNSET_VALUE" is not set`,
+		},
+		{
+			name:        "env_prefix",
+			cfg:         map[string]interface{}{"key": map[string]interface{}{"embedded": "${env:UNSET_VALUE}"}},
+			expectedErr: `failed to expand "key::embedded": environment variable "UNSET_VALUE" is not set`,
+		},
+		{
+			name:        "slice",
+			cfg:         map[string]interface{}{"key": []interface{}{"value", "$UNSET_VALUE"}},
+			expectedErr: `failed to expand "key::1": environment variable "UNSET_VALUE" is not set`,
+		},
+		{
+			name:        "map_in_slice",
+			cfg:         map[string]interface{}{"key": []interface{}{map[string]interface{}{"embedded": "$UNSET_VALUE"}}},
+			expectedErr: `failed to expand "key::0::embedded": environment variable "UNSET_VALUE" is not set`,
+		},
+		{
+			name:        "required_unset",
+			cfg:         map[string]interface{}{"key": "${env:UNSET_VALUE:?endpoint must be configured}"},
+			expectedErr: `failed to expand "key": environment variable "UNSET_VALUE" is required: endpoint must be configured`,
+		},
+		{
+			name:        "required_empty",
+			cfg:         map[string]interface{}{"key": "${EMPTY_VALUE:?endpoint must be configured}"},
+			expectedErr: `failed to expand "key": environment variable "EMPTY_VALUE" is required: endpoint must be configured`,
+		},
+		{
+			name:        "required_no_message",
+			cfg:         map[string]interface{}{"key": "${UNSET_VALUE:?}"},
+			expectedErr: `failed to expand "key": environment variable "UNSET_VALUE" is required: not set or empty`,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			t.Setenv("EMPTY_VALUE", "")
+			cfgMap := config.NewMapFromStringMap(test.cfg)
+			assert.EqualError(t, New()(context.Background(), cfgMap), test.expectedErr)
+		})
+	}
+}
+
+func TestNewExpandConverter_ConfigReferences(t *testing.T) {
+	t.Setenv("LOG_LEVEL", "debug")
+
+	cfgMap := config.NewMapFromStringMap(
+		map[string]interface{}{
+			"service": map[string]interface{}{
+				"telemetry": map[string]interface{}{
+					"logs": map[string]interface{}{
+						"level": "${LOG_LEVEL}",
+					},
+				},
+			},
+			"exporters": map[string]interface{}{
+				"logging": map[string]interface{}{
+					"loglevel": "${config:service::telemetry::logs::level}",
+					"escaped":  "$${config:service::telemetry::logs::level}",
+				},
+			},
+			"timeout":     5,
+			"same_type":   "${config:timeout}",
+			"embedded":    "level=${config:exporters::logging::loglevel},timeout=${config:timeout}",
+			"map_ref":     "${config:service::telemetry::logs}",
+			"list":        []interface{}{"${config:timeout}"},
+			"chained_ref": "${config:embedded}",
+		},
+	)
+	require.NoError(t, New()(context.Background(), cfgMap))
+
+	expectedMap := map[string]interface{}{
+		"service": map[string]interface{}{
+			"telemetry": map[string]interface{}{
+				"logs": map[string]interface{}{
+					"level": "debug",
+				},
+			},
+		},
+		"exporters": map[string]interface{}{
+			"logging": map[string]interface{}{
+				"loglevel": "debug",
+				"escaped":  "${config:service::telemetry::logs::level}",
+			},
+		},
+		"timeout":     5,
+		"same_type":   5,
+		"embedded":    "level=debug,timeout=5",
+		"map_ref":     map[string]interface{}{"level": "debug"},
+		"list":        []interface{}{5},
+		"chained_ref": "level=debug,timeout=5",
+	}
+	assert.Equal(t, expectedMap, cfgMap.ToStringMap())
+}
+
+func TestNewExpandConverter_ConfigReferencesError(t *testing.T) {
+	var testCases = []struct {
+		name        string
+		cfg         map[string]interface{}
+		expectedErr string
+	}{
+		{
+			name:        "not_set",
+			cfg:         map[string]interface{}{"key": "${config:missing}"},
+			expectedErr: `failed to expand "key": config key "missing" is not set`,
+		},
+		{
+			name:        "self_reference",
+			cfg:         map[string]interface{}{"key": "${config:key}"},
+			expectedErr: `failed to expand "key": cycle detected resolving config reference "key"`,
+		},
+		{
+			name: "cycle",
+			cfg: map[string]interface{}{
+				"a": "value ${config:b}",
+				"b": "value ${config:a}",
+			},
+			expectedErr: `failed to expand "b": cycle detected resolving config reference "a"`,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			cfgMap := config.NewMapFromStringMap(test.cfg)
+			assert.EqualError(t, New()(context.Background(), cfgMap), test.expectedErr)
+		})
+	}
+}
+
+func TestNewExpandConverter_FileReferences(t *testing.T) {
+	dir := t.TempDir()
+	tokenFile := filepath.Join(dir, "token")
+	require.NoError(t, ioutil.WriteFile(tokenFile, []byte("  secret token\n"), 0600))
+	t.Setenv("TOKEN_FILE", tokenFile)
+
+	cfgMap := config.NewMapFromStringMap(
+		map[string]interface{}{
+			"token":    "${file:" + tokenFile + "}",
+			"header":   "Bearer ${file:" + tokenFile + "}",
+			"list":     []interface{}{"${file:" + tokenFile + "}"},
+			"escaped":  "$${file:" + tokenFile + "}",
+			"env_path": "${TOKEN_FILE}",
+		},
+	)
+	require.NoError(t, New()(context.Background(), cfgMap))
+
+	expectedMap := map[string]interface{}{
+		"token":    "secret token",
+		"header":   "Bearer secret token",
+		"list":     []interface{}{"secret token"},
+		"escaped":  "${file:" + tokenFile + "}",
+		"env_path": tokenFile,
+	}
+	assert.Equal(t, expectedMap, cfgMap.ToStringMap())
+}
+
+func TestNewExpandConverter_FileReferencesError(t *testing.T) {
+	missing := filepath.Join(t.TempDir(), "missing")
+	cfgMap := config.NewMapFromStringMap(map[string]interface{}{
+		"key": map[string]interface{}{"token": "${file:" + missing + "}"},
+	})
+	err := New()(context.Background(), cfgMap)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `failed to expand "key::token": unable to read file "`+missing+`"`)
+}
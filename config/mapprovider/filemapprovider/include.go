@@ -0,0 +1,107 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filemapprovider // import "go.opentelemetry.io/collector/config/mapprovider/filemapprovider"
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"go.opentelemetry.io/collector/config/internal/yamlerror"
+)
+
+// includeTag is the YAML tag of the nodes replaced with the content of the file they reference.
+const includeTag = "!include"
+
+// readFile returns the content of the file at path, where every node tagged with includeTag,
+// e.g. `exporters: !include exporters.yaml`, is replaced with the content of the referenced file.
+// Relative paths are resolved against the directory of the including file.
+func readFile(path string) ([]byte, error) {
+	content, err := ioutil.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, err
+	}
+	// Fast path, keep the content untouched, and the lines reported in the errors, without includes.
+	if !strings.Contains(string(content), includeTag) {
+		return content, nil
+	}
+
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+	root, err := parseWithIncludes(absPath, content, nil)
+	if err != nil {
+		return nil, err
+	}
+	return yaml.Marshal(root)
+}
+
+// parseWithIncludes parses content, read from the file at absPath, and resolves its includes.
+// The stack contains the files including the current one, to detect include cycles.
+func parseWithIncludes(absPath string, content []byte, stack []string) (*yaml.Node, error) {
+	stack = append(stack, absPath)
+	root := &yaml.Node{}
+	if err := yaml.Unmarshal(content, root); err != nil {
+		return nil, fmt.Errorf("unable to parse the file %q: %w", absPath, yamlerror.Wrap(content, err))
+	}
+	if err := resolveIncludes(root, filepath.Dir(absPath), stack); err != nil {
+		return nil, err
+	}
+	return root, nil
+}
+
+func resolveIncludes(node *yaml.Node, dir string, stack []string) error {
+	if node.Kind == yaml.AliasNode {
+		// The anchored node is resolved where it is defined.
+		return nil
+	}
+	if node.Kind != yaml.ScalarNode || node.Tag != includeTag {
+		for _, child := range node.Content {
+			if err := resolveIncludes(child, dir, stack); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	path := node.Value
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(dir, path)
+	}
+	for _, p := range stack {
+		if p == path {
+			return fmt.Errorf("include cycle detected: %s", strings.Join(append(stack, path), " -> "))
+		}
+	}
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("unable to include the file %q at line %d: %w", node.Value, node.Line, err)
+	}
+	included, err := parseWithIncludes(path, content, stack)
+	if err != nil {
+		return err
+	}
+	if len(included.Content) == 0 {
+		// Empty file.
+		*node = yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null"}
+		return nil
+	}
+	*node = *included.Content[0]
+	return nil
+}
@@ -15,14 +15,11 @@
 package filemapprovider // import "go.opentelemetry.io/collector/config/mapprovider/filemapprovider"
 
 import (
+	"bytes"
 	"context"
 	"fmt"
-	"io/ioutil"
-	"path/filepath"
 	"strings"
 
-	"gopkg.in/yaml.v2"
-
 	"go.opentelemetry.io/collector/config"
 )
 
@@ -43,6 +40,15 @@ type mapProvider struct{}
 // `file:/path/to/file` - absolute path (unix, windows)
 // `file:c:/path/to/file` - absolute path including drive-letter (windows)
 // `file:c:\path\to\file` - absolute path including drive-letter (windows)
+//
+// YAML anchors, aliases and merge keys (`<<: *anchor`) are resolved when the file is parsed, so shared
+// blocks are expanded into every place they are referenced. Keys defined more than once in the same
+// map are reported as an error. Parse errors report the line of the failure, and a tab used for
+// indentation, which YAML does not allow, is reported with its line and column.
+//
+// A node tagged with `!include`, e.g. `exporters: !include exporters.yaml`, is replaced with the content
+// of the referenced file, relative to the directory of the including file. Included files can include
+// other files, include cycles are reported as an error.
 func New() config.MapProvider {
 	return &mapProvider{}
 }
@@ -52,18 +58,17 @@ func (fmp *mapProvider) Retrieve(_ context.Context, uri string, _ config.Watcher
 		return config.Retrieved{}, fmt.Errorf("%v uri is not supported by %v provider", uri, schemeName)
 	}
 
-	// Clean the path before using it.
-	content, err := ioutil.ReadFile(filepath.Clean(uri[len(schemeName)+1:]))
+	content, err := readFile(uri[len(schemeName)+1:])
 	if err != nil {
 		return config.Retrieved{}, fmt.Errorf("unable to read the file %v: %w", uri, err)
 	}
 
-	var data map[string]interface{}
-	if err = yaml.Unmarshal(content, &data); err != nil {
-		return config.Retrieved{}, fmt.Errorf("unable to parse yaml: %w", err)
+	cfgMap, err := config.NewMapFromReader(bytes.NewReader(content))
+	if err != nil {
+		return config.Retrieved{}, fmt.Errorf("unable to load the file %v: %w", uri, err)
 	}
 
-	return config.NewRetrievedFromMap(config.NewMapFromStringMap(data)), nil
+	return config.NewRetrievedFromMap(cfgMap), nil
 }
 
 func (*mapProvider) Scheme() string {
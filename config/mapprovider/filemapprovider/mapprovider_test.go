@@ -70,7 +70,71 @@ func TestRelativePath(t *testing.T) {
 		"processors::batch":         nil,
 		"exporters::otlp::endpoint": "localhost:4317",
 	})
-	assert.Equal(t, expectedMap, retMap)
+	assert.Equal(t, expectedMap.ToStringMap(), retMap.ToStringMap())
+	assert.NoError(t, fp.Shutdown(context.Background()))
+}
+
+func TestYAMLMergeKeys(t *testing.T) {
+	fp := New()
+	ret, err := fp.Retrieve(context.Background(), fileSchemePrefix+filepath.Join("testdata", "yaml-merge.yaml"), nil)
+	require.NoError(t, err)
+	retMap, err := ret.AsMap()
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"insecure":  true,
+		"ca_file":   "/var/lib/certs/ca.pem",
+		"cert_file": "/var/lib/certs/cert.pem",
+	}, retMap.Get("exporters::otlp/insecure::tls"))
+	assert.Equal(t, retMap.Get("tls_settings"), retMap.Get("exporters::otlp::tls"))
+	assert.NoError(t, fp.Shutdown(context.Background()))
+}
+
+func TestDuplicateKeys(t *testing.T) {
+	fp := New()
+	_, err := fp.Retrieve(context.Background(), fileSchemePrefix+filepath.Join("testdata", "duplicate-keys.yaml"), nil)
+	assert.EqualError(t, err, "unable to load the file file:"+filepath.Join("testdata", "duplicate-keys.yaml")+`: duplicate key "exporters" at line 7, first defined at line 1`)
+	assert.NoError(t, fp.Shutdown(context.Background()))
+}
+
+func TestTabIndentation(t *testing.T) {
+	fp := New()
+	_, err := fp.Retrieve(context.Background(), fileSchemePrefix+filepath.Join("testdata", "tab-indentation.yaml"), nil)
+	assert.EqualError(t, err, "unable to load the file file:"+filepath.Join("testdata", "tab-indentation.yaml")+
+		": unable to parse yaml: line 3, column 1: found a tab character used for indentation, use spaces instead")
+	assert.NoError(t, fp.Shutdown(context.Background()))
+}
+
+func TestInclude(t *testing.T) {
+	fp := New()
+	ret, err := fp.Retrieve(context.Background(), fileSchemePrefix+filepath.Join("testdata", "include", "main.yaml"), nil)
+	require.NoError(t, err)
+	retMap, err := ret.AsMap()
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"receivers": map[string]interface{}{
+			"otlp": map[string]interface{}{
+				"protocols": map[string]interface{}{
+					"grpc": map[string]interface{}{"endpoint": "0.0.0.0:4317"},
+				},
+			},
+		},
+		"exporters": map[string]interface{}{
+			"otlp": map[string]interface{}{"endpoint": "localhost:4317"},
+		},
+		"processors": map[string]interface{}{"batch": nil},
+	}, retMap.ToStringMap())
+	assert.NoError(t, fp.Shutdown(context.Background()))
+}
+
+func TestIncludeErrors(t *testing.T) {
+	fp := New()
+	_, err := fp.Retrieve(context.Background(), fileSchemePrefix+filepath.Join("testdata", "include", "cycle.yaml"), nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "include cycle detected")
+
+	_, err = fp.Retrieve(context.Background(), fileSchemePrefix+filepath.Join("testdata", "include", "missing.yaml"), nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `unable to include the file "non-existent.yaml" at line 1`)
 	assert.NoError(t, fp.Shutdown(context.Background()))
 }
 
@@ -84,7 +148,7 @@ func TestAbsolutePath(t *testing.T) {
 		"processors::batch":         nil,
 		"exporters::otlp::endpoint": "localhost:4317",
 	})
-	assert.Equal(t, expectedMap, retMap)
+	assert.Equal(t, expectedMap.ToStringMap(), retMap.ToStringMap())
 	assert.NoError(t, fp.Shutdown(context.Background()))
 }
 
@@ -0,0 +1,161 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config // import "go.opentelemetry.io/collector/config"
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"go.uber.org/multierr"
+)
+
+// Watcher retrieves a Map from a MapProvider, and retrieves it again every time the MapProvider reports
+// a change, pushing every new Map on the Maps channel. It allows to reload a configuration without
+// restarting the process, using Map.Diff to determine which parts of the configuration changed.
+//
+// If the Map fails to be retrieved again, or the MapProvider reports an error while watching for changes,
+// the error is pushed on the Errors channel and the last retrieved Map stays the current one, its
+// watch is kept open so that a later change, e.g. a fixed file, is still reported.
+//
+// The typical usage is the following:
+//
+//	w := config.NewWatcher(mapProvider, "file:/path/to/config")
+//	cfgMap, err := w.Retrieve(ctx)
+//	// Use cfgMap, then wait for a new Map or an error.
+//	select {
+//	case cfgMap = <-w.Maps():
+//	case err = <-w.Errors():
+//	}
+//	// repeat until it is time to shut down the Collector process.
+//	w.Close(ctx)
+type Watcher struct {
+	provider MapProvider
+	uri      string
+	maps     chan *Map
+	errs     chan error
+
+	// mu guards the fields below, and serializes the pushes on the channels.
+	mu        sync.Mutex
+	retrieved *Retrieved
+	closed    bool
+}
+
+// NewWatcher returns a new Watcher retrieving the Map at uri from the provider.
+// The provider is not shutdown by the Watcher.
+func NewWatcher(provider MapProvider, uri string) *Watcher {
+	return &Watcher{
+		provider: provider,
+		uri:      uri,
+		maps:     make(chan *Map, 1),
+		errs:     make(chan error, 1),
+	}
+}
+
+// Retrieve retrieves the Map for the first time, and starts watching for its changes.
+//
+// Should be called once, before reading Maps or Errors.
+func (w *Watcher) Retrieve(ctx context.Context) (*Map, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.retrieved != nil {
+		return nil, errors.New("config watcher already retrieved the map")
+	}
+	return w.retrieveLocked(ctx)
+}
+
+// Maps returns the channel on which the Maps retrieved again after a change are pushed.
+// Only the latest Map is kept if they are not read as soon as they are pushed.
+func (w *Watcher) Maps() <-chan *Map {
+	return w.maps
+}
+
+// Errors returns the channel on which the errors to retrieve the Map again, or to watch for its changes,
+// are pushed. Only the latest error is kept if they are not read as soon as they are pushed.
+func (w *Watcher) Errors() <-chan error {
+	return w.errs
+}
+
+// Close stops watching for changes, no Map or error is pushed after it returns.
+// It does not close the Maps and Errors channels.
+func (w *Watcher) Close(ctx context.Context) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.closed = true
+	if w.retrieved == nil {
+		return nil
+	}
+	err := w.retrieved.Close(ctx)
+	w.retrieved = nil
+	return err
+}
+
+// retrieveLocked retrieves the Map, and replaces the current Retrieved on success. Must be called with mu held.
+func (w *Watcher) retrieveLocked(ctx context.Context) (*Map, error) {
+	ret, err := w.provider.Retrieve(ctx, w.uri, w.onChange)
+	if err != nil {
+		return nil, err
+	}
+	cfgMap, err := ret.AsMap()
+	if err != nil {
+		return nil, multierr.Append(err, ret.Close(ctx))
+	}
+	var errs error
+	if w.retrieved != nil {
+		errs = w.retrieved.Close(ctx)
+	}
+	w.retrieved = &ret
+	return cfgMap, errs
+}
+
+func (w *Watcher) onChange(event *ChangeEvent) {
+	// The MapProvider may call onChange while Retrieve or Close hold the lock, so retrieve asynchronously.
+	go func() {
+		w.mu.Lock()
+		defer w.mu.Unlock()
+		if w.closed {
+			return
+		}
+		if event.Error != nil {
+			pushError(w.errs, event.Error)
+			return
+		}
+		cfgMap, err := w.retrieveLocked(context.Background())
+		if err != nil {
+			pushError(w.errs, err)
+		}
+		if cfgMap != nil {
+			pushMap(w.maps, cfgMap)
+		}
+	}()
+}
+
+// pushMap sends cfgMap on the channel with a buffer of 1, replacing the Map not yet received if any.
+func pushMap(ch chan *Map, cfgMap *Map) {
+	select {
+	case <-ch:
+	default:
+	}
+	ch <- cfgMap
+}
+
+// pushError sends err on the channel with a buffer of 1, replacing the error not yet received if any.
+func pushError(ch chan error, err error) {
+	select {
+	case <-ch:
+	default:
+	}
+	ch <- err
+}
@@ -0,0 +1,165 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeMapProvider returns a Map with the current value for "key", and records the watchers and closes.
+type fakeMapProvider struct {
+	mu          sync.Mutex
+	value       string
+	retrieveErr error
+	watchers    []WatcherFunc
+	closed      int
+}
+
+func (fmp *fakeMapProvider) Retrieve(_ context.Context, _ string, watcher WatcherFunc) (Retrieved, error) {
+	fmp.mu.Lock()
+	defer fmp.mu.Unlock()
+	if fmp.retrieveErr != nil {
+		return Retrieved{}, fmp.retrieveErr
+	}
+	fmp.watchers = append(fmp.watchers, watcher)
+	return NewRetrievedFromMap(NewMapFromStringMap(map[string]interface{}{"key": fmp.value}), WithRetrievedClose(func(context.Context) error {
+		fmp.mu.Lock()
+		defer fmp.mu.Unlock()
+		fmp.closed++
+		return nil
+	})), nil
+}
+
+func (fmp *fakeMapProvider) Scheme() string {
+	return "fake"
+}
+
+func (fmp *fakeMapProvider) Shutdown(context.Context) error {
+	return nil
+}
+
+// change updates the value, and notifies the last watcher with the given error.
+func (fmp *fakeMapProvider) change(value string, retrieveErr error, eventErr error) {
+	fmp.mu.Lock()
+	fmp.value = value
+	fmp.retrieveErr = retrieveErr
+	watcher := fmp.watchers[len(fmp.watchers)-1]
+	fmp.mu.Unlock()
+	watcher(&ChangeEvent{Error: eventErr})
+}
+
+func (fmp *fakeMapProvider) closedCount() int {
+	fmp.mu.Lock()
+	defer fmp.mu.Unlock()
+	return fmp.closed
+}
+
+func TestWatcher(t *testing.T) {
+	provider := &fakeMapProvider{value: "first"}
+	w := NewWatcher(provider, "fake:uri")
+
+	cfgMap, err := w.Retrieve(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "first", cfgMap.Get("key"))
+	_, err = w.Retrieve(context.Background())
+	assert.Error(t, err)
+
+	provider.change("second", nil, nil)
+	cfgMap = receiveMap(t, w)
+	assert.Equal(t, "second", cfgMap.Get("key"))
+	// The watch of the previous Map is closed once the new one is retrieved.
+	assert.Equal(t, 1, provider.closedCount())
+	assert.Equal(t, []string{"key"}, NewMapFromStringMap(map[string]interface{}{"key": "first"}).Diff(cfgMap))
+
+	require.NoError(t, w.Close(context.Background()))
+	assert.Equal(t, 2, provider.closedCount())
+}
+
+func TestWatcherErrors(t *testing.T) {
+	provider := &fakeMapProvider{value: "first"}
+	w := NewWatcher(provider, "fake:uri")
+	_, err := w.Retrieve(context.Background())
+	require.NoError(t, err)
+
+	eventErr := errors.New("watch failed")
+	provider.change("first", nil, eventErr)
+	assert.Equal(t, eventErr, receiveError(t, w))
+
+	// The previous Map stays the current one, and its watch is kept open.
+	retrieveErr := errors.New("retrieve failed")
+	provider.change("invalid", retrieveErr, nil)
+	assert.Equal(t, retrieveErr, receiveError(t, w))
+	assert.Equal(t, 0, provider.closedCount())
+
+	provider.change("fixed", nil, nil)
+	assert.Equal(t, "fixed", receiveMap(t, w).Get("key"))
+
+	require.NoError(t, w.Close(context.Background()))
+	assert.Equal(t, 2, provider.closedCount())
+}
+
+func TestWatcherRetrieveError(t *testing.T) {
+	retrieveErr := errors.New("retrieve failed")
+	w := NewWatcher(&fakeMapProvider{retrieveErr: retrieveErr}, "fake:uri")
+	_, err := w.Retrieve(context.Background())
+	assert.Equal(t, retrieveErr, err)
+	assert.NoError(t, w.Close(context.Background()))
+}
+
+func TestWatcherClosed(t *testing.T) {
+	provider := &fakeMapProvider{value: "first"}
+	w := NewWatcher(provider, "fake:uri")
+	_, err := w.Retrieve(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, w.Close(context.Background()))
+
+	provider.change("second", nil, nil)
+	select {
+	case cfgMap := <-w.Maps():
+		t.Fatalf("unexpected map after close: %v", cfgMap.ToStringMap())
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func receiveMap(t *testing.T, w *Watcher) *Map {
+	select {
+	case cfgMap := <-w.Maps():
+		return cfgMap
+	case err := <-w.Errors():
+		t.Fatalf("unexpected error: %v", err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout waiting for a map")
+	}
+	return nil
+}
+
+func receiveError(t *testing.T, w *Watcher) error {
+	select {
+	case cfgMap := <-w.Maps():
+		t.Fatalf("unexpected map: %v", cfgMap.ToStringMap())
+	case err := <-w.Errors():
+		return err
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout waiting for an error")
+	}
+	return nil
+}
@@ -50,6 +50,16 @@ func (es ${structName}) RemoveIf(f func(${elementName}) bool) {
 	}
 	// TODO: Prevent memory leak by erasing truncated values.
 	*es.orig = (*es.orig)[:newLen]
+}
+
+// Range calls f sequentially for each element present in the slice, with its index.
+// If f returns false, range stops the iteration.
+func (es ${structName}) Range(f func(int, ${elementName}) bool) {
+	for i := 0; i < len(*es.orig); i++ {
+		if !f(i, es.At(i)) {
+			return
+		}
+	}
 }`
 
 const commonSliceTestTemplate = `
@@ -95,6 +105,34 @@ func Test${structName}_RemoveIf(t *testing.T) {
 		return pos%3 == 0
 	})
 	assert.Equal(t, 5, filtered.Len())
+}
+
+func Test${structName}_Range(t *testing.T) {
+	// Test Range on empty slice
+	emptySlice := New${structName}()
+	emptySlice.Range(func(i int, el ${elementName}) bool {
+		t.Fail()
+		return false
+	})
+
+	// Test Range
+	es := generateTest${structName}()
+	calls := 0
+	es.Range(func(i int, el ${elementName}) bool {
+		assert.Equal(t, calls, i)
+		assert.Equal(t, es.At(i), el)
+		calls++
+		return true
+	})
+	assert.Equal(t, es.Len(), calls)
+
+	// Test Range stops when f returns false
+	calls = 0
+	es.Range(func(i int, el ${elementName}) bool {
+		calls++
+		return i < 2
+	})
+	assert.Equal(t, 3, calls)
 }`
 
 const commonSliceGenerateTest = `func generateTest${structName}() ${structName} {
@@ -289,6 +289,8 @@ var histogramDataPoint = &messageValueStruct{
 		explicitBoundsField,
 		exemplarsField,
 		dataPointFlagsField,
+		optionalDoubleMinField,
+		optionalDoubleMaxField,
 	},
 }
 
@@ -309,7 +311,15 @@ var exponentialHistogramDataPoint = &messageValueStruct{
 		startTimeField,
 		timeField,
 		countField,
-		doubleSumField,
+		&optionalPrimitiveValue{
+			fieldName:        "Sum",
+			fieldType:        "Double",
+			originFieldName:  "Sum",
+			originTypePrefix: "otlpmetrics.ExponentialHistogramDataPoint_",
+			returnType:       "float64",
+			defaultVal:       "float64(0.0)",
+			testVal:          "float64(17.13)",
+		},
 		&primitiveTypedField{
 			fieldName:       "Scale",
 			originFieldName: "Scale",
@@ -536,3 +546,23 @@ var optionalDoubleSumField = &optionalPrimitiveValue{
 	defaultVal:       "float64(0.0)",
 	testVal:          "float64(17.13)",
 }
+
+var optionalDoubleMinField = &optionalPrimitiveValue{
+	fieldName:        "Min",
+	fieldType:        "Double",
+	originFieldName:  "Min",
+	originTypePrefix: "otlpmetrics.HistogramDataPoint_",
+	returnType:       "float64",
+	defaultVal:       "float64(0.0)",
+	testVal:          "float64(9.23)",
+}
+
+var optionalDoubleMaxField = &optionalPrimitiveValue{
+	fieldName:        "Max",
+	fieldType:        "Double",
+	originFieldName:  "Max",
+	originTypePrefix: "otlpmetrics.HistogramDataPoint_",
+	returnType:       "float64",
+	defaultVal:       "float64(0.0)",
+	testVal:          "float64(182.55)",
+}
@@ -25,6 +25,7 @@ import (
 	"math"
 	"sort"
 	"strconv"
+	"strings"
 
 	otlpcommon "go.opentelemetry.io/collector/pdata/internal/data/protogen/common/v1"
 )
@@ -854,6 +855,25 @@ func (m Map) Sort() Map {
 	return m
 }
 
+// AttributesKey returns a key identifying the attributes of m, which doesn't depend on their order.
+// Every attribute in the key is terminated by a "\x00", so that the key can be joined with other parts.
+// It is not part of the public API, and is shared by the helpers identifying series and data points.
+func AttributesKey(m Map) string {
+	sorted := NewMap()
+	m.CopyTo(sorted)
+	var b strings.Builder
+	sorted.Sort().Range(func(k string, v Value) bool {
+		b.WriteString(k)
+		b.WriteString("=")
+		b.WriteString(v.Type().String())
+		b.WriteString(":")
+		b.WriteString(v.AsString())
+		b.WriteString("\x00")
+		return true
+	})
+	return b.String()
+}
+
 // Len returns the length of this map.
 //
 // Because the Map is represented internally by a slice of pointers, and the data are comping from the wire,
@@ -914,6 +934,53 @@ func (m Map) AsRaw() map[string]interface{} {
 	return rawMap
 }
 
+// mapPathSeparator separates the keys of the nested maps in the paths used by GetByPath and PutByPath.
+const mapPathSeparator = "."
+
+// GetByPath returns the Value at the given path of keys separated by ".", traversing the nested maps,
+// e.g. "http.request.method" returns the value of the "method" key in the map value of the "request"
+// key in the map value of the "http" key. Like Get, the returned Value is a reference to the stored value.
+//
+// It returns false if a key doesn't exist or an intermediate value is not a map. Keys containing "."
+// can't be reached through a path, use Get for them.
+func (m Map) GetByPath(path string) (Value, bool) {
+	keys := strings.Split(path, mapPathSeparator)
+	for _, k := range keys[:len(keys)-1] {
+		v, ok := m.Get(k)
+		if !ok || v.Type() != ValueTypeMap {
+			return Value{nil}, false
+		}
+		m = v.MapVal()
+	}
+	return m.Get(keys[len(keys)-1])
+}
+
+// PutByPath performs the Upsert action for the Value at the given path of keys separated by ".",
+// see GetByPath, creating empty maps for the missing intermediate keys.
+//
+// It returns false, without modifying the map, if an intermediate value exists and is not a map.
+//
+// Calling this function with a zero-initialized Value struct will cause a panic.
+func (m Map) PutByPath(path string, v Value) bool {
+	keys := strings.Split(path, mapPathSeparator)
+	for _, k := range keys[:len(keys)-1] {
+		if iv, ok := m.Get(k); ok {
+			if iv.Type() != ValueTypeMap {
+				return false
+			}
+			m = iv.MapVal()
+			continue
+		}
+		*m.orig = append(*m.orig, otlpcommon.KeyValue{
+			Key:   k,
+			Value: otlpcommon.AnyValue{Value: &otlpcommon.AnyValue_KvlistValue{KvlistValue: &otlpcommon.KeyValueList{}}},
+		})
+		m = Value{&(*m.orig)[len(*m.orig)-1].Value}.MapVal()
+	}
+	m.Upsert(keys[len(keys)-1], v)
+	return true
+}
+
 // newSliceFromRaw creates a Slice with values from the given []interface{}.
 func newSliceFromRaw(rawSlice []interface{}) Slice {
 	if len(rawSlice) == 0 {
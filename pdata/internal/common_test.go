@@ -594,6 +594,20 @@ func TestMap_Range(t *testing.T) {
 	assert.EqualValues(t, 0, len(rawMap))
 }
 
+func TestAttributesKey(t *testing.T) {
+	m1 := NewMapFromRaw(map[string]interface{}{"a": "1", "b": int64(2)})
+	m2 := NewMap()
+	m2.InsertInt("b", 2)
+	m2.InsertString("a", "1")
+	assert.Equal(t, AttributesKey(m1), AttributesKey(m2))
+	// The order of m2 is left unchanged.
+	assert.Equal(t, []string{"b", "a"}, []string{(*m2.orig)[0].Key, (*m2.orig)[1].Key})
+
+	m3 := NewMapFromRaw(map[string]interface{}{"a": int64(1), "b": int64(2)})
+	assert.NotEqual(t, AttributesKey(m1), AttributesKey(m3))
+	assert.Equal(t, "", AttributesKey(NewMap()))
+}
+
 func TestMap_InitFromRaw(t *testing.T) {
 	am := NewMapFromRaw(map[string]interface{}(nil))
 	assert.EqualValues(t, NewMap(), am)
@@ -1374,3 +1388,66 @@ func BenchmarkAttributeValueSliceAccessor(b *testing.B) {
 		val.SliceVal()
 	}
 }
+
+func TestMap_GetByPath(t *testing.T) {
+	m := NewMapFromRaw(map[string]interface{}{
+		"http": map[string]interface{}{
+			"request": map[string]interface{}{
+				"method": "GET",
+			},
+			"status_code": 200,
+		},
+		"http.flat": "flat",
+	})
+
+	v, ok := m.GetByPath("http.request.method")
+	require.True(t, ok)
+	assert.Equal(t, "GET", v.StringVal())
+
+	// The returned value is a reference to the stored value.
+	v.SetStringVal("POST")
+	v, ok = m.GetByPath("http.request.method")
+	require.True(t, ok)
+	assert.Equal(t, "POST", v.StringVal())
+
+	v, ok = m.GetByPath("http.request")
+	require.True(t, ok)
+	assert.Equal(t, ValueTypeMap, v.Type())
+
+	v, ok = m.GetByPath("http")
+	require.True(t, ok)
+	assert.Equal(t, ValueTypeMap, v.Type())
+
+	_, ok = m.GetByPath("http.request.missing")
+	assert.False(t, ok)
+	_, ok = m.GetByPath("http.status_code.value")
+	assert.False(t, ok)
+	// Keys containing the separator are not reachable through a path.
+	_, ok = m.GetByPath("http.flat")
+	assert.False(t, ok)
+}
+
+func TestMap_PutByPath(t *testing.T) {
+	m := NewMap()
+	m.InsertString("scalar", "value")
+	m.InsertString("existing", "value")
+
+	assert.True(t, m.PutByPath("http.request.method", NewValueString("GET")))
+	assert.True(t, m.PutByPath("http.request.method", NewValueString("POST")))
+	assert.True(t, m.PutByPath("http.status_code", NewValueInt(200)))
+	assert.True(t, m.PutByPath("existing", NewValueString("updated")))
+
+	// Intermediate values that are not maps are not overwritten.
+	assert.False(t, m.PutByPath("scalar.nested", NewValueString("value")))
+
+	assert.Equal(t, map[string]interface{}{
+		"scalar":   "value",
+		"existing": "updated",
+		"http": map[string]interface{}{
+			"request": map[string]interface{}{
+				"method": "POST",
+			},
+			"status_code": int64(200),
+		},
+	}, m.AsRaw())
+}
@@ -80,6 +80,22 @@ func (m *ExportLogsServiceRequest) GetResourceLogs() []*v1.ResourceLogs {
 }
 
 type ExportLogsServiceResponse struct {
+	// The details of a partially successful export request.
+	//
+	// If the request is only partially accepted
+	// (i.e. when the server accepts only parts of the data and rejects the rest)
+	// the server MUST initialize the `partial_success` field and MUST
+	// set the `rejected_<signal>` with the number of items it rejected.
+	//
+	// Servers MAY also make use of the `partial_success` field to convey
+	// warnings/suggestions to senders even when the request was fully accepted.
+	// In such cases, the `rejected_<signal>` MUST have a value of `0` and
+	// the `error_message` MUST be non-empty.
+	//
+	// A `partial_success` message with an empty value (rejected_<signal> = 0 and
+	// `error_message` = "") is equivalent to it not being set/present. Senders
+	// SHOULD interpret it the same way as in the full success case.
+	PartialSuccess ExportLogsPartialSuccess `protobuf:"bytes,1,opt,name=partial_success,json=partialSuccess,proto3" json:"partial_success"`
 }
 
 func (m *ExportLogsServiceResponse) Reset()         { *m = ExportLogsServiceResponse{} }
@@ -115,9 +131,80 @@ func (m *ExportLogsServiceResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ExportLogsServiceResponse proto.InternalMessageInfo
 
+func (m *ExportLogsServiceResponse) GetPartialSuccess() ExportLogsPartialSuccess {
+	if m != nil {
+		return m.PartialSuccess
+	}
+	return ExportLogsPartialSuccess{}
+}
+
+type ExportLogsPartialSuccess struct {
+	// The number of rejected log records.
+	//
+	// A `rejected_<signal>` field holding a `0` value indicates that the
+	// request was fully accepted.
+	RejectedLogRecords int64 `protobuf:"varint,1,opt,name=rejected_log_records,json=rejectedLogRecords,proto3" json:"rejected_log_records,omitempty"`
+	// A developer-facing human-readable message in English. It should be used
+	// either to explain why the server rejected parts of the data during a partial
+	// success or to convey warnings/suggestions during a full success. The message
+	// should offer guidance on how users can address such issues.
+	//
+	// error_message is an optional field. An error_message with an empty value
+	// is equivalent to it not being set.
+	ErrorMessage string `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+}
+
+func (m *ExportLogsPartialSuccess) Reset()         { *m = ExportLogsPartialSuccess{} }
+func (m *ExportLogsPartialSuccess) String() string { return proto.CompactTextString(m) }
+func (*ExportLogsPartialSuccess) ProtoMessage()    {}
+func (*ExportLogsPartialSuccess) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e3bf87aaa43acd4, []int{2}
+}
+func (m *ExportLogsPartialSuccess) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExportLogsPartialSuccess) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExportLogsPartialSuccess.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExportLogsPartialSuccess) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportLogsPartialSuccess.Merge(m, src)
+}
+func (m *ExportLogsPartialSuccess) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExportLogsPartialSuccess) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportLogsPartialSuccess.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportLogsPartialSuccess proto.InternalMessageInfo
+
+func (m *ExportLogsPartialSuccess) GetRejectedLogRecords() int64 {
+	if m != nil {
+		return m.RejectedLogRecords
+	}
+	return 0
+}
+
+func (m *ExportLogsPartialSuccess) GetErrorMessage() string {
+	if m != nil {
+		return m.ErrorMessage
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*ExportLogsServiceRequest)(nil), "opentelemetry.proto.collector.logs.v1.ExportLogsServiceRequest")
 	proto.RegisterType((*ExportLogsServiceResponse)(nil), "opentelemetry.proto.collector.logs.v1.ExportLogsServiceResponse")
+	proto.RegisterType((*ExportLogsPartialSuccess)(nil), "opentelemetry.proto.collector.logs.v1.ExportLogsPartialSuccess")
 }
 
 func init() {
@@ -125,26 +212,34 @@ func init() {
 }
 
 var fileDescriptor_8e3bf87aaa43acd4 = []byte{
-	// 302 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xb2, 0xc8, 0x2f, 0x48, 0xcd,
-	0x2b, 0x49, 0xcd, 0x49, 0xcd, 0x4d, 0x2d, 0x29, 0xaa, 0xd4, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0xd7,
-	0x4f, 0xce, 0xcf, 0xc9, 0x49, 0x4d, 0x2e, 0xc9, 0x2f, 0xd2, 0xcf, 0xc9, 0x4f, 0x2f, 0xd6, 0x2f,
-	0x33, 0x04, 0xd3, 0xf1, 0xc5, 0xa9, 0x45, 0x65, 0x99, 0xc9, 0xa9, 0x7a, 0x60, 0x45, 0x42, 0xaa,
-	0x28, 0x3a, 0x21, 0x82, 0x7a, 0x70, 0x9d, 0x7a, 0x20, 0x1d, 0x7a, 0x65, 0x86, 0x52, 0x22, 0xe9,
-	0xf9, 0xe9, 0xf9, 0x10, 0x63, 0x41, 0x2c, 0x88, 0x3a, 0x29, 0x35, 0x6c, 0xd6, 0x22, 0x5b, 0x06,
-	0x51, 0xa7, 0x94, 0xc5, 0x25, 0xe1, 0x5a, 0x51, 0x90, 0x5f, 0x54, 0xe2, 0x93, 0x9f, 0x5e, 0x1c,
-	0x0c, 0xb1, 0x3f, 0x28, 0xb5, 0xb0, 0x34, 0xb5, 0xb8, 0x44, 0xc8, 0x8f, 0x8b, 0xb7, 0x28, 0xb5,
-	0x38, 0xbf, 0xb4, 0x28, 0x39, 0x35, 0x1e, 0xa4, 0x45, 0x82, 0x51, 0x81, 0x59, 0x83, 0xdb, 0x48,
-	0x53, 0x0f, 0x9b, 0xc3, 0xa0, 0xce, 0xd1, 0x0b, 0x82, 0xea, 0x00, 0x99, 0x17, 0xc4, 0x53, 0x84,
-	0xc4, 0x53, 0x92, 0xe6, 0x92, 0xc4, 0x62, 0x57, 0x71, 0x41, 0x7e, 0x5e, 0x71, 0xaa, 0xd1, 0x5c,
-	0x46, 0x2e, 0x6e, 0x24, 0x71, 0xa1, 0x5e, 0x46, 0x2e, 0x36, 0x88, 0x6a, 0x21, 0x7b, 0x3d, 0xa2,
-	0x42, 0x42, 0x0f, 0x97, 0x47, 0xa4, 0x1c, 0xc8, 0x37, 0x00, 0xe2, 0x3a, 0x25, 0x06, 0xa7, 0x25,
-	0x8c, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7,
-	0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0xc0, 0xa5, 0x91, 0x99, 0x4f, 0x9c,
-	0x05, 0x4e, 0x02, 0x48, 0x66, 0x07, 0x80, 0xd4, 0x04, 0x30, 0x46, 0xf9, 0xa4, 0xa3, 0xeb, 0xce,
-	0x44, 0x4e, 0x20, 0x05, 0x29, 0x89, 0x25, 0x89, 0xfa, 0x99, 0x79, 0x25, 0xa9, 0x45, 0x79, 0x89,
-	0x39, 0xfa, 0x60, 0x1e, 0xd8, 0xf8, 0xf4, 0xd4, 0x3c, 0xcc, 0x74, 0x94, 0xc4, 0x06, 0x96, 0x33,
-	0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0x5e, 0x56, 0x81, 0x5e, 0x77, 0x02, 0x00, 0x00,
+	// 427 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xc1, 0x6e, 0x13, 0x31,
+	0x10, 0x86, 0xd7, 0x2d, 0xaa, 0x84, 0xd3, 0x02, 0xb2, 0x7a, 0x08, 0x39, 0x2c, 0x55, 0x50, 0xd1,
+	0x72, 0xf1, 0x92, 0x70, 0xe1, 0x06, 0x0a, 0xe2, 0x16, 0x20, 0xda, 0x22, 0x0e, 0x5c, 0x56, 0x8b,
+	0x33, 0xb2, 0xb6, 0xda, 0xee, 0xb8, 0x63, 0x27, 0x82, 0x67, 0x40, 0x48, 0xbc, 0x00, 0x2f, 0xc0,
+	0x93, 0xf4, 0xc0, 0xa1, 0x47, 0x4e, 0x08, 0x25, 0x2f, 0x82, 0xbc, 0x2e, 0x61, 0x03, 0x41, 0x2a,
+	0x3d, 0xed, 0x7a, 0x3c, 0xff, 0xf7, 0xff, 0x63, 0xcb, 0xfc, 0x11, 0x1a, 0xa8, 0x1d, 0x54, 0x70,
+	0x02, 0x8e, 0xde, 0xa7, 0x86, 0xd0, 0x61, 0xaa, 0xb0, 0xaa, 0x40, 0x39, 0xa4, 0xb4, 0x42, 0x6d,
+	0xd3, 0xf9, 0xa0, 0xf9, 0xe6, 0x16, 0x68, 0x5e, 0x2a, 0x90, 0x4d, 0x93, 0x38, 0x5c, 0x53, 0x86,
+	0xa2, 0x5c, 0x29, 0xa5, 0x57, 0xc8, 0xf9, 0xa0, 0xb7, 0xaf, 0x51, 0x63, 0xc0, 0xfa, 0xbf, 0xd0,
+	0xd7, 0xbb, 0xb7, 0xc9, 0xb6, 0x6d, 0x16, 0xfa, 0xfa, 0xc7, 0xbc, 0xfb, 0xec, 0x9d, 0x41, 0x72,
+	0x63, 0xd4, 0xf6, 0x28, 0xf8, 0x67, 0x70, 0x3a, 0x03, 0xeb, 0xc4, 0x0b, 0xbe, 0x47, 0x60, 0x71,
+	0x46, 0x0a, 0x72, 0x2f, 0xe9, 0xb2, 0x83, 0xed, 0xa4, 0x33, 0xbc, 0x2f, 0x37, 0x05, 0xbb, 0x88,
+	0x23, 0xb3, 0x0b, 0x85, 0xe7, 0x65, 0xbb, 0xd4, 0x5a, 0xf5, 0x3f, 0x30, 0x7e, 0x7b, 0x83, 0x99,
+	0x35, 0x58, 0x5b, 0x10, 0x35, 0xbf, 0x69, 0x0a, 0x72, 0x65, 0x51, 0xe5, 0x76, 0xa6, 0x14, 0x58,
+	0xef, 0xc7, 0x92, 0xce, 0xf0, 0xb1, 0xbc, 0xd4, 0x41, 0xc8, 0xdf, 0xe8, 0x49, 0xe0, 0x1c, 0x05,
+	0xcc, 0xe8, 0xda, 0xd9, 0xf7, 0x3b, 0x51, 0x76, 0xc3, 0xac, 0x55, 0xfb, 0xa7, 0xbc, 0xfb, 0x2f,
+	0x85, 0x78, 0xc0, 0xf7, 0x09, 0x8e, 0x41, 0x39, 0x98, 0xfa, 0xc9, 0x73, 0x02, 0x85, 0x34, 0x0d,
+	0x81, 0xb6, 0x33, 0xf1, 0x6b, 0x6f, 0x8c, 0x3a, 0x0b, 0x3b, 0xe2, 0x2e, 0xdf, 0x03, 0x22, 0xa4,
+	0xfc, 0x04, 0xac, 0x2d, 0x34, 0x74, 0xb7, 0x0e, 0x58, 0x72, 0x3d, 0xdb, 0x6d, 0x8a, 0xcf, 0x43,
+	0x6d, 0xf8, 0x99, 0xf1, 0x4e, 0x6b, 0x74, 0xf1, 0x91, 0xf1, 0x9d, 0x90, 0x41, 0xfc, 0xff, 0x90,
+	0xeb, 0x97, 0xd5, 0x7b, 0x72, 0x75, 0x40, 0xb8, 0x80, 0x7e, 0x34, 0xfa, 0xca, 0xce, 0x16, 0x31,
+	0x3b, 0x5f, 0xc4, 0xec, 0xc7, 0x22, 0x66, 0x9f, 0x96, 0x71, 0x74, 0xbe, 0x8c, 0xa3, 0x6f, 0xcb,
+	0x38, 0xe2, 0x49, 0x89, 0x97, 0x33, 0x18, 0xdd, 0x6a, 0xb1, 0x27, 0xbe, 0x67, 0xc2, 0xde, 0x8c,
+	0xf5, 0x9f, 0xea, 0xb2, 0xfd, 0x08, 0xcc, 0xb4, 0x70, 0x45, 0x5a, 0xd6, 0x0e, 0xa8, 0x2e, 0xaa,
+	0xb4, 0x59, 0x35, 0x78, 0x0d, 0xf5, 0xdf, 0x6f, 0xe5, 0xcb, 0xd6, 0xe1, 0x4b, 0x03, 0xf5, 0xab,
+	0x15, 0xab, 0x71, 0x91, 0x4f, 0x57, 0x49, 0x7c, 0x00, 0xf9, 0x7a, 0xf0, 0x76, 0xa7, 0x61, 0x3c,
+	0xfc, 0x39, 0x00, 0xf0, 0xaf, 0x6c, 0x7d, 0x83, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -288,6 +383,51 @@ func (m *ExportLogsServiceResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.PartialSuccess.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLogsService(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ExportLogsPartialSuccess) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExportLogsPartialSuccess) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExportLogsPartialSuccess) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ErrorMessage) > 0 {
+		i -= len(m.ErrorMessage)
+		copy(dAtA[i:], m.ErrorMessage)
+		i = encodeVarintLogsService(dAtA, i, uint64(len(m.ErrorMessage)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.RejectedLogRecords != 0 {
+		i = encodeVarintLogsService(dAtA, i, uint64(m.RejectedLogRecords))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -323,6 +463,24 @@ func (m *ExportLogsServiceResponse) Size() (n int) {
 	}
 	var l int
 	_ = l
+	l = m.PartialSuccess.Size()
+	n += 1 + l + sovLogsService(uint64(l))
+	return n
+}
+
+func (m *ExportLogsPartialSuccess) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RejectedLogRecords != 0 {
+		n += 1 + sovLogsService(uint64(m.RejectedLogRecords))
+	}
+	l = len(m.ErrorMessage)
+	if l > 0 {
+		n += 1 + l + sovLogsService(uint64(l))
+	}
 	return n
 }
 
@@ -445,6 +603,140 @@ func (m *ExportLogsServiceResponse) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: ExportLogsServiceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartialSuccess", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogsService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLogsService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogsService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PartialSuccess.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLogsService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLogsService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExportLogsPartialSuccess) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLogsService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExportLogsPartialSuccess: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExportLogsPartialSuccess: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectedLogRecords", wireType)
+			}
+			m.RejectedLogRecords = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogsService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RejectedLogRecords |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorMessage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogsService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLogsService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogsService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ErrorMessage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLogsService(dAtA[iNdEx:])
@@ -80,6 +80,22 @@ func (m *ExportMetricsServiceRequest) GetResourceMetrics() []*v1.ResourceMetrics
 }
 
 type ExportMetricsServiceResponse struct {
+	// The details of a partially successful export request.
+	//
+	// If the request is only partially accepted
+	// (i.e. when the server accepts only parts of the data and rejects the rest)
+	// the server MUST initialize the `partial_success` field and MUST
+	// set the `rejected_<signal>` with the number of items it rejected.
+	//
+	// Servers MAY also make use of the `partial_success` field to convey
+	// warnings/suggestions to senders even when the request was fully accepted.
+	// In such cases, the `rejected_<signal>` MUST have a value of `0` and
+	// the `error_message` MUST be non-empty.
+	//
+	// A `partial_success` message with an empty value (rejected_<signal> = 0 and
+	// `error_message` = "") is equivalent to it not being set/present. Senders
+	// SHOULD interpret it the same way as in the full success case.
+	PartialSuccess ExportMetricsPartialSuccess `protobuf:"bytes,1,opt,name=partial_success,json=partialSuccess,proto3" json:"partial_success"`
 }
 
 func (m *ExportMetricsServiceResponse) Reset()         { *m = ExportMetricsServiceResponse{} }
@@ -115,9 +131,80 @@ func (m *ExportMetricsServiceResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ExportMetricsServiceResponse proto.InternalMessageInfo
 
+func (m *ExportMetricsServiceResponse) GetPartialSuccess() ExportMetricsPartialSuccess {
+	if m != nil {
+		return m.PartialSuccess
+	}
+	return ExportMetricsPartialSuccess{}
+}
+
+type ExportMetricsPartialSuccess struct {
+	// The number of rejected data points.
+	//
+	// A `rejected_<signal>` field holding a `0` value indicates that the
+	// request was fully accepted.
+	RejectedDataPoints int64 `protobuf:"varint,1,opt,name=rejected_data_points,json=rejectedDataPoints,proto3" json:"rejected_data_points,omitempty"`
+	// A developer-facing human-readable message in English. It should be used
+	// either to explain why the server rejected parts of the data during a partial
+	// success or to convey warnings/suggestions during a full success. The message
+	// should offer guidance on how users can address such issues.
+	//
+	// error_message is an optional field. An error_message with an empty value
+	// is equivalent to it not being set.
+	ErrorMessage string `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+}
+
+func (m *ExportMetricsPartialSuccess) Reset()         { *m = ExportMetricsPartialSuccess{} }
+func (m *ExportMetricsPartialSuccess) String() string { return proto.CompactTextString(m) }
+func (*ExportMetricsPartialSuccess) ProtoMessage()    {}
+func (*ExportMetricsPartialSuccess) Descriptor() ([]byte, []int) {
+	return fileDescriptor_75fb6015e6e64798, []int{2}
+}
+func (m *ExportMetricsPartialSuccess) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExportMetricsPartialSuccess) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExportMetricsPartialSuccess.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExportMetricsPartialSuccess) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportMetricsPartialSuccess.Merge(m, src)
+}
+func (m *ExportMetricsPartialSuccess) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExportMetricsPartialSuccess) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportMetricsPartialSuccess.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportMetricsPartialSuccess proto.InternalMessageInfo
+
+func (m *ExportMetricsPartialSuccess) GetRejectedDataPoints() int64 {
+	if m != nil {
+		return m.RejectedDataPoints
+	}
+	return 0
+}
+
+func (m *ExportMetricsPartialSuccess) GetErrorMessage() string {
+	if m != nil {
+		return m.ErrorMessage
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*ExportMetricsServiceRequest)(nil), "opentelemetry.proto.collector.metrics.v1.ExportMetricsServiceRequest")
 	proto.RegisterType((*ExportMetricsServiceResponse)(nil), "opentelemetry.proto.collector.metrics.v1.ExportMetricsServiceResponse")
+	proto.RegisterType((*ExportMetricsPartialSuccess)(nil), "opentelemetry.proto.collector.metrics.v1.ExportMetricsPartialSuccess")
 }
 
 func init() {
@@ -125,26 +212,34 @@ func init() {
 }
 
 var fileDescriptor_75fb6015e6e64798 = []byte{
-	// 303 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xb2, 0xcb, 0x2f, 0x48, 0xcd,
-	0x2b, 0x49, 0xcd, 0x49, 0xcd, 0x4d, 0x2d, 0x29, 0xaa, 0xd4, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0xd7,
-	0x4f, 0xce, 0xcf, 0xc9, 0x49, 0x4d, 0x2e, 0xc9, 0x2f, 0xd2, 0x07, 0x89, 0x66, 0x26, 0x17, 0xeb,
-	0x97, 0x19, 0xc2, 0x98, 0xf1, 0xc5, 0xa9, 0x45, 0x65, 0x99, 0xc9, 0xa9, 0x7a, 0x60, 0xa5, 0x42,
-	0x1a, 0x28, 0xfa, 0x21, 0x82, 0x7a, 0x70, 0xfd, 0x7a, 0x50, 0x4d, 0x7a, 0x65, 0x86, 0x52, 0x22,
-	0xe9, 0xf9, 0xe9, 0xf9, 0x10, 0xf3, 0x41, 0x2c, 0x88, 0x52, 0x29, 0x1d, 0x6c, 0xf6, 0x63, 0xda,
-	0x0a, 0x51, 0xad, 0x54, 0xc9, 0x25, 0xed, 0x5a, 0x51, 0x90, 0x5f, 0x54, 0xe2, 0x0b, 0x11, 0x0e,
-	0x86, 0xb8, 0x25, 0x28, 0xb5, 0xb0, 0x34, 0xb5, 0xb8, 0x44, 0x28, 0x8a, 0x4b, 0xa0, 0x28, 0xb5,
-	0x38, 0xbf, 0xb4, 0x28, 0x39, 0x35, 0x1e, 0xaa, 0x51, 0x82, 0x51, 0x81, 0x59, 0x83, 0xdb, 0x48,
-	0x5f, 0x0f, 0x9b, 0x3b, 0x11, 0xae, 0xd3, 0x0b, 0x82, 0xea, 0x83, 0x1a, 0x1c, 0xc4, 0x5f, 0x84,
-	0x2a, 0xa0, 0x24, 0xc7, 0x25, 0x83, 0xdd, 0xea, 0xe2, 0x82, 0xfc, 0xbc, 0xe2, 0x54, 0xa3, 0x35,
-	0x8c, 0x5c, 0x7c, 0xa8, 0x52, 0x42, 0x33, 0x19, 0xb9, 0xd8, 0x20, 0x7a, 0x84, 0x5c, 0xf5, 0x88,
-	0x0d, 0x27, 0x3d, 0x3c, 0x1e, 0x94, 0x72, 0xa3, 0xd4, 0x18, 0x88, 0x63, 0x95, 0x18, 0x9c, 0xd6,
-	0x32, 0x9e, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e,
-	0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x03, 0x97, 0x76, 0x66, 0x3e, 0xd1,
-	0xd6, 0x38, 0x09, 0xa3, 0xda, 0x10, 0x00, 0x52, 0x19, 0xc0, 0x18, 0xe5, 0x9f, 0x8e, 0x6e, 0x46,
-	0x26, 0x72, 0xb2, 0x2a, 0x48, 0x49, 0x2c, 0x49, 0xd4, 0xcf, 0xcc, 0x2b, 0x49, 0x2d, 0xca, 0x4b,
-	0xcc, 0xd1, 0x07, 0xf3, 0xc0, 0x96, 0xa4, 0xa7, 0xe6, 0x61, 0x4d, 0x7d, 0x49, 0x6c, 0x60, 0x69,
-	0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0xa1, 0x13, 0x8e, 0x3e, 0xb0, 0x02, 0x00, 0x00,
+	// 425 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0xb1, 0x8e, 0xd3, 0x30,
+	0x1c, 0xc6, 0xe3, 0x3b, 0x74, 0x12, 0x3e, 0xb8, 0x43, 0xe6, 0x86, 0xaa, 0x45, 0xa1, 0x0a, 0x4b,
+	0x24, 0x90, 0x43, 0xcb, 0xce, 0x50, 0x28, 0x5b, 0xd5, 0x28, 0x45, 0x0c, 0x5d, 0x22, 0xe3, 0xfe,
+	0x15, 0x05, 0xa5, 0xb1, 0xb1, 0xdd, 0x8a, 0xbe, 0x05, 0x03, 0x0b, 0xaf, 0x80, 0x78, 0x90, 0x8e,
+	0x1d, 0x3b, 0x21, 0xd4, 0xbe, 0x08, 0x4a, 0x9c, 0x16, 0x02, 0x11, 0xaa, 0x60, 0x73, 0x3e, 0xff,
+	0xbf, 0xef, 0xf7, 0xc5, 0x96, 0xf1, 0x73, 0x21, 0x21, 0x37, 0x90, 0xc1, 0x1c, 0x8c, 0x5a, 0x05,
+	0x52, 0x09, 0x23, 0x02, 0x2e, 0xb2, 0x0c, 0xb8, 0x11, 0x2a, 0x28, 0xd4, 0x94, 0xeb, 0x60, 0xd9,
+	0x3b, 0x2c, 0x63, 0x0d, 0x6a, 0x99, 0x72, 0xa0, 0xe5, 0x28, 0xf1, 0x6b, 0x7e, 0x2b, 0xd2, 0xa3,
+	0x9f, 0x56, 0x26, 0xba, 0xec, 0xb5, 0x6f, 0x12, 0x91, 0x08, 0x9b, 0x5f, 0xac, 0xec, 0x68, 0xfb,
+	0x49, 0x13, 0xff, 0x4f, 0xaa, 0x9d, 0xf6, 0x56, 0xb8, 0x33, 0xfc, 0x20, 0x85, 0x32, 0x23, 0x2b,
+	0x4f, 0x6c, 0x97, 0x08, 0xde, 0x2f, 0x40, 0x1b, 0x32, 0xc5, 0xf7, 0x14, 0x68, 0xb1, 0x50, 0x1c,
+	0xe2, 0xca, 0xd8, 0x42, 0xdd, 0x73, 0xff, 0xb2, 0x1f, 0xd0, 0xa6, 0x9e, 0x3f, 0xdb, 0xd1, 0xa8,
+	0xf2, 0x55, 0xc1, 0xd1, 0xb5, 0xaa, 0x0b, 0xde, 0x27, 0x84, 0x1f, 0x34, 0xb3, 0xb5, 0x14, 0xb9,
+	0x06, 0x62, 0xf0, 0xb5, 0x64, 0xca, 0xa4, 0x2c, 0x8b, 0xf5, 0x82, 0x73, 0xd0, 0x05, 0x1b, 0xf9,
+	0x97, 0xfd, 0x21, 0x3d, 0xf5, 0x8c, 0x68, 0x0d, 0x10, 0xda, 0xb4, 0x89, 0x0d, 0x1b, 0xdc, 0x5a,
+	0x7f, 0x7b, 0xe8, 0x44, 0x57, 0xb2, 0xa6, 0x7a, 0x06, 0x77, 0xfe, 0x62, 0x22, 0x4f, 0xf1, 0x8d,
+	0x82, 0x77, 0xc0, 0x0d, 0xcc, 0xe2, 0x19, 0x33, 0x2c, 0x96, 0x22, 0xcd, 0x8d, 0x6d, 0x76, 0x1e,
+	0x91, 0xc3, 0xde, 0x4b, 0x66, 0x58, 0x58, 0xee, 0x90, 0x47, 0xf8, 0x2e, 0x28, 0x25, 0x54, 0x3c,
+	0x07, 0xad, 0x59, 0x02, 0xad, 0xb3, 0x2e, 0xf2, 0x6f, 0x47, 0x77, 0x4a, 0x71, 0x64, 0xb5, 0xfe,
+	0x57, 0x84, 0xaf, 0xea, 0xc7, 0x40, 0x3e, 0x23, 0x7c, 0x61, 0x9b, 0x90, 0x7f, 0xfd, 0xe1, 0xfa,
+	0x6d, 0xb6, 0x5f, 0xfd, 0x6f, 0x8c, 0xbd, 0x18, 0xcf, 0x19, 0x6c, 0xd1, 0x7a, 0xe7, 0xa2, 0xcd,
+	0xce, 0x45, 0xdf, 0x77, 0x2e, 0xfa, 0xb8, 0x77, 0x9d, 0xcd, 0xde, 0x75, 0xb6, 0x7b, 0xd7, 0xc1,
+	0x8f, 0x53, 0x71, 0x32, 0x66, 0x70, 0xbf, 0x4e, 0x08, 0x8b, 0xc9, 0x10, 0x4d, 0xc7, 0xc9, 0xef,
+	0x19, 0xe9, 0xaf, 0x6f, 0x48, 0x16, 0x07, 0x1f, 0xa4, 0xb9, 0x01, 0x95, 0xb3, 0x2c, 0x28, 0xbf,
+	0x4a, 0x48, 0x02, 0x79, 0xe3, 0x53, 0xfb, 0x72, 0xe6, 0x8f, 0x25, 0xe4, 0xaf, 0x8f, 0x71, 0x25,
+	0x88, 0xbe, 0x38, 0x56, 0xaa, 0x6a, 0xd0, 0x37, 0xbd, 0xb7, 0x17, 0x65, 0xd2, 0xb3, 0x1f, 0x03,
+	0x00, 0x47, 0xf2, 0x5f, 0x42, 0xc8, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -288,6 +383,51 @@ func (m *ExportMetricsServiceResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.PartialSuccess.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMetricsService(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ExportMetricsPartialSuccess) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExportMetricsPartialSuccess) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExportMetricsPartialSuccess) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ErrorMessage) > 0 {
+		i -= len(m.ErrorMessage)
+		copy(dAtA[i:], m.ErrorMessage)
+		i = encodeVarintMetricsService(dAtA, i, uint64(len(m.ErrorMessage)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.RejectedDataPoints != 0 {
+		i = encodeVarintMetricsService(dAtA, i, uint64(m.RejectedDataPoints))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -323,6 +463,24 @@ func (m *ExportMetricsServiceResponse) Size() (n int) {
 	}
 	var l int
 	_ = l
+	l = m.PartialSuccess.Size()
+	n += 1 + l + sovMetricsService(uint64(l))
+	return n
+}
+
+func (m *ExportMetricsPartialSuccess) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RejectedDataPoints != 0 {
+		n += 1 + sovMetricsService(uint64(m.RejectedDataPoints))
+	}
+	l = len(m.ErrorMessage)
+	if l > 0 {
+		n += 1 + l + sovMetricsService(uint64(l))
+	}
 	return n
 }
 
@@ -445,6 +603,140 @@ func (m *ExportMetricsServiceResponse) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: ExportMetricsServiceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartialSuccess", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetricsService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetricsService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetricsService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PartialSuccess.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetricsService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMetricsService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExportMetricsPartialSuccess) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetricsService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExportMetricsPartialSuccess: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExportMetricsPartialSuccess: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectedDataPoints", wireType)
+			}
+			m.RejectedDataPoints = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetricsService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RejectedDataPoints |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorMessage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetricsService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetricsService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetricsService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ErrorMessage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetricsService(dAtA[iNdEx:])
@@ -80,6 +80,22 @@ func (m *ExportTraceServiceRequest) GetResourceSpans() []*v1.ResourceSpans {
 }
 
 type ExportTraceServiceResponse struct {
+	// The details of a partially successful export request.
+	//
+	// If the request is only partially accepted
+	// (i.e. when the server accepts only parts of the data and rejects the rest)
+	// the server MUST initialize the `partial_success` field and MUST
+	// set the `rejected_<signal>` with the number of items it rejected.
+	//
+	// Servers MAY also make use of the `partial_success` field to convey
+	// warnings/suggestions to senders even when the request was fully accepted.
+	// In such cases, the `rejected_<signal>` MUST have a value of `0` and
+	// the `error_message` MUST be non-empty.
+	//
+	// A `partial_success` message with an empty value (rejected_<signal> = 0 and
+	// `error_message` = "") is equivalent to it not being set/present. Senders
+	// SHOULD interpret it the same way as in the full success case.
+	PartialSuccess ExportTracePartialSuccess `protobuf:"bytes,1,opt,name=partial_success,json=partialSuccess,proto3" json:"partial_success"`
 }
 
 func (m *ExportTraceServiceResponse) Reset()         { *m = ExportTraceServiceResponse{} }
@@ -115,9 +131,80 @@ func (m *ExportTraceServiceResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ExportTraceServiceResponse proto.InternalMessageInfo
 
+func (m *ExportTraceServiceResponse) GetPartialSuccess() ExportTracePartialSuccess {
+	if m != nil {
+		return m.PartialSuccess
+	}
+	return ExportTracePartialSuccess{}
+}
+
+type ExportTracePartialSuccess struct {
+	// The number of rejected spans.
+	//
+	// A `rejected_<signal>` field holding a `0` value indicates that the
+	// request was fully accepted.
+	RejectedSpans int64 `protobuf:"varint,1,opt,name=rejected_spans,json=rejectedSpans,proto3" json:"rejected_spans,omitempty"`
+	// A developer-facing human-readable message in English. It should be used
+	// either to explain why the server rejected parts of the data during a partial
+	// success or to convey warnings/suggestions during a full success. The message
+	// should offer guidance on how users can address such issues.
+	//
+	// error_message is an optional field. An error_message with an empty value
+	// is equivalent to it not being set.
+	ErrorMessage string `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+}
+
+func (m *ExportTracePartialSuccess) Reset()         { *m = ExportTracePartialSuccess{} }
+func (m *ExportTracePartialSuccess) String() string { return proto.CompactTextString(m) }
+func (*ExportTracePartialSuccess) ProtoMessage()    {}
+func (*ExportTracePartialSuccess) Descriptor() ([]byte, []int) {
+	return fileDescriptor_192a962890318cf4, []int{2}
+}
+func (m *ExportTracePartialSuccess) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExportTracePartialSuccess) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExportTracePartialSuccess.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExportTracePartialSuccess) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportTracePartialSuccess.Merge(m, src)
+}
+func (m *ExportTracePartialSuccess) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExportTracePartialSuccess) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportTracePartialSuccess.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportTracePartialSuccess proto.InternalMessageInfo
+
+func (m *ExportTracePartialSuccess) GetRejectedSpans() int64 {
+	if m != nil {
+		return m.RejectedSpans
+	}
+	return 0
+}
+
+func (m *ExportTracePartialSuccess) GetErrorMessage() string {
+	if m != nil {
+		return m.ErrorMessage
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*ExportTraceServiceRequest)(nil), "opentelemetry.proto.collector.trace.v1.ExportTraceServiceRequest")
 	proto.RegisterType((*ExportTraceServiceResponse)(nil), "opentelemetry.proto.collector.trace.v1.ExportTraceServiceResponse")
+	proto.RegisterType((*ExportTracePartialSuccess)(nil), "opentelemetry.proto.collector.trace.v1.ExportTracePartialSuccess")
 }
 
 func init() {
@@ -125,26 +212,33 @@ func init() {
 }
 
 var fileDescriptor_192a962890318cf4 = []byte{
-	// 302 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x52, 0xbf, 0x4a, 0xc4, 0x30,
-	0x18, 0x6f, 0x10, 0x6e, 0x88, 0x7f, 0xc0, 0xe2, 0xa0, 0x45, 0x82, 0xdc, 0x20, 0x27, 0x42, 0xc2,
-	0x9d, 0x9b, 0x9b, 0x05, 0x47, 0xe1, 0xe8, 0x39, 0xb9, 0x48, 0xad, 0x1f, 0xa5, 0x50, 0xf3, 0xc5,
-	0x24, 0x57, 0xf4, 0x2d, 0xf4, 0x15, 0x5c, 0x7c, 0x15, 0xc7, 0x1b, 0x1d, 0xa5, 0x7d, 0x11, 0x69,
-	0xa2, 0xd2, 0x93, 0x0a, 0x07, 0x6e, 0xdf, 0x9f, 0xdf, 0xbf, 0x84, 0x8f, 0x9e, 0xa2, 0x02, 0x69,
-	0xa1, 0x84, 0x3b, 0xb0, 0xfa, 0x51, 0x28, 0x8d, 0x16, 0x45, 0x86, 0x65, 0x09, 0x99, 0x45, 0x2d,
-	0xac, 0x4e, 0x33, 0x10, 0xd5, 0xd8, 0x17, 0xd7, 0x06, 0x74, 0x55, 0x64, 0xc0, 0x1d, 0x2c, 0x3c,
-	0x5c, 0xe2, 0xfa, 0x21, 0xff, 0xe1, 0x72, 0x47, 0xe1, 0xd5, 0x38, 0xda, 0xc9, 0x31, 0x47, 0xaf,
-	0xdc, 0x56, 0x1e, 0x18, 0x8d, 0xfa, 0x9c, 0x97, 0xfd, 0x3c, 0x72, 0x88, 0x74, 0xef, 0xfc, 0x41,
-	0xa1, 0xb6, 0x97, 0xed, 0x70, 0xe6, 0x33, 0x24, 0x70, 0x3f, 0x07, 0x63, 0xc3, 0x84, 0x6e, 0x69,
-	0x30, 0x38, 0xd7, 0x6d, 0x3c, 0x95, 0x4a, 0xb3, 0x4b, 0x0e, 0xd6, 0x46, 0xeb, 0x93, 0x63, 0xde,
-	0x97, 0xee, 0x3b, 0x13, 0x4f, 0xbe, 0x38, 0xb3, 0x96, 0x92, 0x6c, 0xea, 0x6e, 0x3b, 0xdc, 0xa7,
-	0x51, 0x9f, 0xa1, 0x51, 0x28, 0x0d, 0x4c, 0x5e, 0x08, 0xdd, 0xe8, 0x2e, 0xc2, 0x67, 0x42, 0x07,
-	0x1e, 0x1f, 0x9e, 0xf1, 0xd5, 0xfe, 0x84, 0xff, 0xf9, 0xa0, 0x28, 0xfe, 0x8f, 0x84, 0x8f, 0x38,
-	0x0c, 0xe2, 0x57, 0xf2, 0x56, 0x33, 0xb2, 0xa8, 0x19, 0xf9, 0xa8, 0x19, 0x79, 0x6a, 0x58, 0xb0,
-	0x68, 0x58, 0xf0, 0xde, 0xb0, 0x80, 0x1e, 0x15, 0xb8, 0xa2, 0x45, 0xbc, 0xdd, 0x55, 0x9f, 0xb6,
-	0xa8, 0x29, 0xb9, 0xba, 0xc8, 0x7f, 0xf3, 0x8b, 0xee, 0xc9, 0xa8, 0xdb, 0xd4, 0xa6, 0xa2, 0x90,
-	0x16, 0xb4, 0x4c, 0x4b, 0xe1, 0x3a, 0x67, 0x90, 0x83, 0xec, 0xb9, 0xac, 0x9b, 0x81, 0x5b, 0x9e,
-	0x7c, 0x06, 0x00, 0x00, 0xff, 0xff, 0xb7, 0x91, 0xe9, 0x89, 0x8a, 0x02, 0x00, 0x00,
+	// 411 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0x4f, 0xcb, 0xd3, 0x30,
+	0x18, 0x6f, 0x36, 0x19, 0x98, 0xfd, 0x11, 0x8b, 0x87, 0xad, 0x87, 0x3a, 0x2a, 0x8e, 0x8a, 0x90,
+	0xb2, 0x79, 0xf3, 0x66, 0xc5, 0xe3, 0x70, 0x74, 0xc3, 0x83, 0x97, 0x11, 0xbb, 0x87, 0x52, 0xe9,
+	0x9a, 0x98, 0x64, 0x43, 0xbf, 0x84, 0xe8, 0x57, 0xf0, 0xe8, 0x27, 0xd9, 0x71, 0x47, 0x4f, 0x22,
+	0xdb, 0x17, 0x91, 0x26, 0xae, 0xb4, 0xd2, 0x17, 0xc6, 0xfb, 0xde, 0x92, 0x1f, 0xcf, 0xef, 0xcf,
+	0xf3, 0x0b, 0xc1, 0x2f, 0x19, 0x87, 0x5c, 0x41, 0x06, 0x5b, 0x50, 0xe2, 0x4b, 0xc0, 0x05, 0x53,
+	0x2c, 0x88, 0x59, 0x96, 0x41, 0xac, 0x98, 0x08, 0x94, 0xa0, 0x31, 0x04, 0xfb, 0xa9, 0x39, 0xac,
+	0x25, 0x88, 0x7d, 0x1a, 0x03, 0xd1, 0x63, 0xf6, 0xa4, 0xc6, 0x35, 0x20, 0x29, 0xb9, 0x44, 0x53,
+	0xc8, 0x7e, 0xea, 0x3c, 0x4a, 0x58, 0xc2, 0x8c, 0x72, 0x71, 0x32, 0x83, 0x8e, 0xdf, 0xe4, 0x5c,
+	0xf7, 0x33, 0x93, 0x1e, 0xc3, 0xa3, 0x37, 0x9f, 0x39, 0x13, 0x6a, 0x55, 0x80, 0x4b, 0x93, 0x21,
+	0x82, 0x4f, 0x3b, 0x90, 0xca, 0x8e, 0xf0, 0x40, 0x80, 0x64, 0x3b, 0x51, 0xc4, 0xe3, 0x34, 0x97,
+	0x43, 0x34, 0x6e, 0xfb, 0xdd, 0xd9, 0x73, 0xd2, 0x94, 0xee, 0x92, 0x89, 0x44, 0xff, 0x38, 0xcb,
+	0x82, 0x12, 0xf5, 0x45, 0xf5, 0xea, 0x7d, 0x45, 0xd8, 0x69, 0x72, 0x94, 0x9c, 0xe5, 0x12, 0x6c,
+	0x8e, 0x1f, 0x70, 0x2a, 0x54, 0x4a, 0xb3, 0xb5, 0xdc, 0xc5, 0x31, 0xc8, 0xc2, 0x13, 0xf9, 0xdd,
+	0xd9, 0x2b, 0x72, 0x5d, 0x23, 0xa4, 0x22, 0xbe, 0x30, 0x4a, 0x4b, 0x23, 0x14, 0xde, 0x3b, 0xfc,
+	0x7e, 0x6c, 0x45, 0x03, 0x5e, 0x43, 0xbd, 0x04, 0x8f, 0x6e, 0xa4, 0xd8, 0x4f, 0x8b, 0x06, 0x3e,
+	0x42, 0xac, 0x60, 0x53, 0x36, 0x80, 0xfc, 0x76, 0xd4, 0xbf, 0xa0, 0x7a, 0x29, 0xfb, 0x09, 0xee,
+	0x83, 0x10, 0x4c, 0xac, 0xb7, 0x20, 0x25, 0x4d, 0x60, 0xd8, 0x1a, 0x23, 0xff, 0x7e, 0xd4, 0xd3,
+	0xe0, 0xdc, 0x60, 0xb3, 0x1f, 0x08, 0xf7, 0xaa, 0x3b, 0xdb, 0xdf, 0x11, 0xee, 0x18, 0x6b, 0xfb,
+	0x36, 0xdb, 0xd5, 0x1f, 0xcb, 0x09, 0xef, 0x22, 0x61, 0xda, 0xf7, 0xac, 0xf0, 0x88, 0x0e, 0x27,
+	0x17, 0x1d, 0x4f, 0x2e, 0xfa, 0x73, 0x72, 0xd1, 0xb7, 0xb3, 0x6b, 0x1d, 0xcf, 0xae, 0xf5, 0xeb,
+	0xec, 0x5a, 0xf8, 0x59, 0xca, 0xae, 0xb4, 0x08, 0x1f, 0x56, 0xd5, 0x17, 0xc5, 0xd4, 0x02, 0xbd,
+	0x9f, 0x27, 0xff, 0xf3, 0xd3, 0xea, 0x77, 0xe0, 0x1b, 0xaa, 0x68, 0x90, 0xe6, 0x0a, 0x44, 0x4e,
+	0xb3, 0x40, 0xdf, 0xb4, 0x41, 0x02, 0x79, 0xc3, 0xaf, 0xf9, 0xd9, 0x9a, 0xbc, 0xe5, 0x90, 0xaf,
+	0x4a, 0x31, 0x6d, 0x43, 0x5e, 0x97, 0x61, 0x74, 0x04, 0xf2, 0x6e, 0xfa, 0xa1, 0xa3, 0x55, 0x5e,
+	0xfc, 0x1d, 0x00, 0x82, 0xce, 0x78, 0xc7, 0x8f, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -288,6 +382,51 @@ func (m *ExportTraceServiceResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.PartialSuccess.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTraceService(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ExportTracePartialSuccess) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExportTracePartialSuccess) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExportTracePartialSuccess) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ErrorMessage) > 0 {
+		i -= len(m.ErrorMessage)
+		copy(dAtA[i:], m.ErrorMessage)
+		i = encodeVarintTraceService(dAtA, i, uint64(len(m.ErrorMessage)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.RejectedSpans != 0 {
+		i = encodeVarintTraceService(dAtA, i, uint64(m.RejectedSpans))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -323,6 +462,24 @@ func (m *ExportTraceServiceResponse) Size() (n int) {
 	}
 	var l int
 	_ = l
+	l = m.PartialSuccess.Size()
+	n += 1 + l + sovTraceService(uint64(l))
+	return n
+}
+
+func (m *ExportTracePartialSuccess) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RejectedSpans != 0 {
+		n += 1 + sovTraceService(uint64(m.RejectedSpans))
+	}
+	l = len(m.ErrorMessage)
+	if l > 0 {
+		n += 1 + l + sovTraceService(uint64(l))
+	}
 	return n
 }
 
@@ -445,6 +602,140 @@ func (m *ExportTraceServiceResponse) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: ExportTraceServiceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartialSuccess", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTraceService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTraceService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTraceService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PartialSuccess.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTraceService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTraceService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExportTracePartialSuccess) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTraceService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExportTracePartialSuccess: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExportTracePartialSuccess: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectedSpans", wireType)
+			}
+			m.RejectedSpans = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTraceService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RejectedSpans |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorMessage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTraceService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTraceService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTraceService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ErrorMessage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTraceService(dAtA[iNdEx:])
@@ -335,79 +335,21 @@ func (m *KeyValue) GetValue() AnyValue {
 	return AnyValue{}
 }
 
-// InstrumentationLibrary is a message representing the instrumentation library information
-// such as the fully qualified name and version.
-// InstrumentationLibrary is wire-compatible with InstrumentationScope for binary
-// Protobuf format.
-// This message is deprecated and will be removed on June 15, 2022.
-//
-// Deprecated: Do not use.
-type InstrumentationLibrary struct {
-	// An empty instrumentation library name means the name is unknown.
-	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
-}
-
-func (m *InstrumentationLibrary) Reset()         { *m = InstrumentationLibrary{} }
-func (m *InstrumentationLibrary) String() string { return proto.CompactTextString(m) }
-func (*InstrumentationLibrary) ProtoMessage()    {}
-func (*InstrumentationLibrary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62ba46dcb97aa817, []int{4}
-}
-func (m *InstrumentationLibrary) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *InstrumentationLibrary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_InstrumentationLibrary.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
-	}
-}
-func (m *InstrumentationLibrary) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_InstrumentationLibrary.Merge(m, src)
-}
-func (m *InstrumentationLibrary) XXX_Size() int {
-	return m.Size()
-}
-func (m *InstrumentationLibrary) XXX_DiscardUnknown() {
-	xxx_messageInfo_InstrumentationLibrary.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_InstrumentationLibrary proto.InternalMessageInfo
-
-func (m *InstrumentationLibrary) GetName() string {
-	if m != nil {
-		return m.Name
-	}
-	return ""
-}
-
-func (m *InstrumentationLibrary) GetVersion() string {
-	if m != nil {
-		return m.Version
-	}
-	return ""
-}
-
 // InstrumentationScope is a message representing the instrumentation scope information
 // such as the fully qualified name and version.
 type InstrumentationScope struct {
 	// An empty instrumentation scope name means the name is unknown.
-	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	Name                   string     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Version                string     `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	Attributes             []KeyValue `protobuf:"bytes,3,rep,name=attributes,proto3" json:"attributes"`
+	DroppedAttributesCount uint32     `protobuf:"varint,4,opt,name=dropped_attributes_count,json=droppedAttributesCount,proto3" json:"dropped_attributes_count,omitempty"`
 }
 
 func (m *InstrumentationScope) Reset()         { *m = InstrumentationScope{} }
 func (m *InstrumentationScope) String() string { return proto.CompactTextString(m) }
 func (*InstrumentationScope) ProtoMessage()    {}
 func (*InstrumentationScope) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62ba46dcb97aa817, []int{5}
+	return fileDescriptor_62ba46dcb97aa817, []int{4}
 }
 func (m *InstrumentationScope) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -450,12 +392,25 @@ func (m *InstrumentationScope) GetVersion() string {
 	return ""
 }
 
+func (m *InstrumentationScope) GetAttributes() []KeyValue {
+	if m != nil {
+		return m.Attributes
+	}
+	return nil
+}
+
+func (m *InstrumentationScope) GetDroppedAttributesCount() uint32 {
+	if m != nil {
+		return m.DroppedAttributesCount
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*AnyValue)(nil), "opentelemetry.proto.common.v1.AnyValue")
 	proto.RegisterType((*ArrayValue)(nil), "opentelemetry.proto.common.v1.ArrayValue")
 	proto.RegisterType((*KeyValueList)(nil), "opentelemetry.proto.common.v1.KeyValueList")
 	proto.RegisterType((*KeyValue)(nil), "opentelemetry.proto.common.v1.KeyValue")
-	proto.RegisterType((*InstrumentationLibrary)(nil), "opentelemetry.proto.common.v1.InstrumentationLibrary")
 	proto.RegisterType((*InstrumentationScope)(nil), "opentelemetry.proto.common.v1.InstrumentationScope")
 }
 
@@ -464,38 +419,41 @@ func init() {
 }
 
 var fileDescriptor_62ba46dcb97aa817 = []byte{
-	// 484 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0xf5, 0x26, 0x69, 0x3e, 0xc6, 0x39, 0xa0, 0x55, 0x85, 0x22, 0xa4, 0xba, 0x26, 0x1c, 0x30,
-	0x20, 0xc5, 0x6a, 0xb9, 0x71, 0x6b, 0x5a, 0xa4, 0x00, 0x39, 0x44, 0xae, 0xe0, 0xc0, 0x05, 0xad,
-	0xd3, 0x95, 0xb5, 0xaa, 0xbd, 0x1b, 0xad, 0x37, 0x96, 0xfc, 0x2b, 0xe0, 0x67, 0xf5, 0xd8, 0x23,
-	0x27, 0x54, 0x25, 0x7f, 0x04, 0xed, 0x87, 0x5b, 0xc8, 0xa1, 0x55, 0x6e, 0x33, 0x6f, 0xde, 0x7b,
-	0x33, 0xb3, 0x1f, 0xf0, 0x56, 0xac, 0x28, 0x57, 0x34, 0xa7, 0x05, 0x55, 0xb2, 0x8e, 0x57, 0x52,
-	0x28, 0x11, 0x2f, 0x45, 0x51, 0x08, 0x1e, 0x57, 0x27, 0x2e, 0x9a, 0x18, 0x18, 0x1f, 0xfd, 0xc7,
-	0xb5, 0xe0, 0xc4, 0x31, 0xaa, 0x93, 0x17, 0x87, 0x99, 0xc8, 0x84, 0x35, 0xd0, 0x91, 0xad, 0x8f,
-	0xef, 0x5a, 0xd0, 0x3f, 0xe3, 0xf5, 0x37, 0x92, 0xaf, 0x29, 0x7e, 0x05, 0xc3, 0x52, 0x49, 0xc6,
-	0xb3, 0x1f, 0x95, 0xce, 0x47, 0x28, 0x44, 0xd1, 0x60, 0xe6, 0x25, 0xbe, 0x45, 0x2d, 0xe9, 0x18,
-	0x20, 0x15, 0x22, 0x77, 0x94, 0x56, 0x88, 0xa2, 0xfe, 0xcc, 0x4b, 0x06, 0x1a, 0xb3, 0x84, 0x23,
-	0x18, 0x30, 0xae, 0x5c, 0xbd, 0x1d, 0xa2, 0xa8, 0x3d, 0xf3, 0x92, 0x3e, 0xe3, 0xea, 0xbe, 0xc9,
-	0x95, 0x58, 0xa7, 0x39, 0x75, 0x8c, 0x4e, 0x88, 0x22, 0xa4, 0x9b, 0x58, 0xd4, 0x92, 0xe6, 0xe0,
-	0x13, 0x29, 0x49, 0xed, 0x38, 0x07, 0x21, 0x8a, 0xfc, 0xd3, 0x37, 0x93, 0x47, 0x37, 0x9c, 0x9c,
-	0x69, 0x85, 0xd1, 0xcf, 0xbc, 0x04, 0xc8, 0x7d, 0x86, 0x17, 0x30, 0xbc, 0xae, 0x72, 0x56, 0x36,
-	0x43, 0x75, 0x8d, 0xdd, 0xbb, 0x27, 0xec, 0xbe, 0x50, 0x2b, 0x9f, 0xb3, 0x52, 0xe9, 0xf9, 0xac,
-	0x85, 0x75, 0x7c, 0x09, 0x7e, 0x5a, 0x2b, 0x5a, 0x3a, 0xc3, 0x5e, 0x88, 0xa2, 0xa1, 0x6e, 0x6a,
-	0x40, 0x43, 0x99, 0xf6, 0xe0, 0xc0, 0x14, 0xc7, 0x97, 0x00, 0x0f, 0x93, 0xe1, 0x8f, 0xd0, 0x35,
-	0x70, 0x39, 0x42, 0x61, 0x3b, 0xf2, 0x4f, 0x5f, 0x3f, 0xb5, 0x94, 0xbb, 0x9c, 0x69, 0xe7, 0xe6,
-	0xcf, 0xb1, 0x97, 0x38, 0xf1, 0xf8, 0x2b, 0x0c, 0xff, 0x9d, 0x6f, 0x6f, 0xdb, 0x46, 0xbc, 0x63,
-	0x4b, 0xa0, 0xdf, 0x54, 0xf0, 0x33, 0x68, 0x5f, 0xd3, 0xda, 0x3e, 0x82, 0x44, 0x87, 0xf8, 0xdc,
-	0xad, 0x64, 0x6e, 0x7d, 0xef, 0xd1, 0xdd, 0x71, 0x7c, 0x86, 0xe7, 0x9f, 0x78, 0xa9, 0xe4, 0xba,
-	0xa0, 0x5c, 0x11, 0xc5, 0x04, 0x9f, 0xb3, 0x54, 0x12, 0x59, 0x63, 0x0c, 0x1d, 0x4e, 0x0a, 0xf7,
-	0xec, 0x12, 0x13, 0xe3, 0x11, 0xf4, 0x2a, 0x2a, 0x4b, 0x26, 0xb8, 0x69, 0x3a, 0x48, 0x9a, 0xf4,
-	0x43, 0x6b, 0x84, 0xc6, 0x17, 0x70, 0xb8, 0xe3, 0x75, 0xb9, 0x14, 0x2b, 0xba, 0x9f, 0xd3, 0xf4,
-	0x27, 0xba, 0xd9, 0x04, 0xe8, 0x76, 0x13, 0xa0, 0xbb, 0x4d, 0x80, 0x7e, 0x6d, 0x03, 0xef, 0x76,
-	0x1b, 0x78, 0xbf, 0xb7, 0x81, 0x07, 0x21, 0x13, 0x8f, 0x2f, 0x39, 0xf5, 0xcf, 0x4d, 0xb8, 0xd0,
-	0xf0, 0x02, 0x7d, 0xbf, 0xc8, 0x76, 0x05, 0x4c, 0x7f, 0xd8, 0x3c, 0xa7, 0x4b, 0x25, 0x64, 0xbc,
-	0xba, 0x22, 0x8a, 0xc4, 0x8c, 0x2b, 0x2a, 0x39, 0xc9, 0x63, 0x93, 0x19, 0xc7, 0x8c, 0xf2, 0x87,
-	0x7f, 0x9d, 0x76, 0x0d, 0xf6, 0xfe, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x52, 0xfc, 0xa6, 0x58,
-	0xff, 0x03, 0x00, 0x00,
+	// 532 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcd, 0x6a, 0x1b, 0x3d,
+	0x14, 0x1d, 0xc5, 0xf1, 0xdf, 0x1d, 0x7f, 0xf0, 0x21, 0x42, 0x31, 0x05, 0x4f, 0xa6, 0xee, 0xa2,
+	0xd3, 0x16, 0x66, 0x70, 0xba, 0xe9, 0xd6, 0x76, 0x0b, 0x2e, 0x49, 0xa9, 0x99, 0xb4, 0x59, 0x74,
+	0x63, 0x64, 0x5b, 0x18, 0x91, 0xb1, 0x34, 0x68, 0x64, 0x83, 0xdf, 0xa2, 0xcf, 0xd1, 0x4d, 0x5f,
+	0x23, 0x9b, 0x42, 0x96, 0x5d, 0x95, 0x60, 0xbf, 0x48, 0xd1, 0x8f, 0xed, 0xb4, 0x94, 0x84, 0x74,
+	0x77, 0xef, 0x39, 0xe7, 0x9e, 0x7b, 0x64, 0xc9, 0x03, 0x2f, 0x44, 0x4e, 0xb9, 0xa2, 0x19, 0x9d,
+	0x53, 0x25, 0x57, 0x49, 0x2e, 0x85, 0x12, 0xc9, 0x44, 0xcc, 0xe7, 0x82, 0x27, 0xcb, 0x8e, 0xab,
+	0x62, 0x03, 0xe3, 0xd6, 0x6f, 0x5a, 0x0b, 0xc6, 0x4e, 0xb1, 0xec, 0x3c, 0x3e, 0x9a, 0x89, 0x99,
+	0xb0, 0x06, 0xba, 0xb2, 0x7c, 0xfb, 0xe6, 0x00, 0x6a, 0x5d, 0xbe, 0xba, 0x20, 0xd9, 0x82, 0xe2,
+	0xa7, 0xd0, 0x28, 0x94, 0x64, 0x7c, 0x36, 0x5a, 0xea, 0xbe, 0x89, 0x42, 0x14, 0xd5, 0x07, 0x5e,
+	0xea, 0x5b, 0xd4, 0x8a, 0x8e, 0x01, 0xc6, 0x42, 0x64, 0x4e, 0x72, 0x10, 0xa2, 0xa8, 0x36, 0xf0,
+	0xd2, 0xba, 0xc6, 0xac, 0xa0, 0x05, 0x75, 0xc6, 0x95, 0xe3, 0x4b, 0x21, 0x8a, 0x4a, 0x03, 0x2f,
+	0xad, 0x31, 0xae, 0x76, 0x4b, 0xa6, 0x62, 0x31, 0xce, 0xa8, 0x53, 0x1c, 0x86, 0x28, 0x42, 0x7a,
+	0x89, 0x45, 0xad, 0xe8, 0x0c, 0x7c, 0x22, 0x25, 0x59, 0x39, 0x4d, 0x39, 0x44, 0x91, 0x7f, 0xf2,
+	0x3c, 0xbe, 0xf3, 0x84, 0x71, 0x57, 0x4f, 0x98, 0xf9, 0x81, 0x97, 0x02, 0xd9, 0x75, 0x78, 0x08,
+	0x8d, 0xcb, 0x65, 0xc6, 0x8a, 0x6d, 0xa8, 0x8a, 0xb1, 0x7b, 0x79, 0x8f, 0xdd, 0x29, 0xb5, 0xe3,
+	0x67, 0xac, 0x50, 0x3a, 0x9f, 0xb5, 0xb0, 0x8e, 0x4f, 0xc0, 0x1f, 0xaf, 0x14, 0x2d, 0x9c, 0x61,
+	0x35, 0x44, 0x51, 0x43, 0x2f, 0x35, 0xa0, 0x91, 0xf4, 0xaa, 0x50, 0x36, 0x64, 0xfb, 0x1c, 0x60,
+	0x9f, 0x0c, 0xbf, 0x85, 0x8a, 0x81, 0x8b, 0x26, 0x0a, 0x4b, 0x91, 0x7f, 0xf2, 0xec, 0xbe, 0x43,
+	0xb9, 0xcb, 0xe9, 0x1d, 0x5e, 0xfd, 0x3c, 0xf6, 0x52, 0x37, 0xdc, 0xfe, 0x04, 0x8d, 0xdb, 0xf9,
+	0x1e, 0x6c, 0x7b, 0x4a, 0xff, 0x6a, 0x4b, 0xa0, 0xb6, 0x65, 0xf0, 0xff, 0x50, 0xba, 0xa4, 0x2b,
+	0xfb, 0x08, 0x52, 0x5d, 0xe2, 0x3e, 0x94, 0xf7, 0xb7, 0xfe, 0xe0, 0xe8, 0xee, 0xe7, 0xf8, 0x8e,
+	0xe0, 0xe8, 0x1d, 0x2f, 0x94, 0x5c, 0xcc, 0x29, 0x57, 0x44, 0x31, 0xc1, 0xcf, 0x27, 0x22, 0xa7,
+	0x18, 0xc3, 0x21, 0x27, 0x73, 0xf7, 0xea, 0x52, 0x53, 0xe3, 0x26, 0x54, 0x97, 0x54, 0x16, 0x4c,
+	0x70, 0xb3, 0xb3, 0x9e, 0x6e, 0x5b, 0xfc, 0x1e, 0x80, 0x28, 0x25, 0xd9, 0x78, 0xa1, 0x68, 0xd1,
+	0x2c, 0xfd, 0xcb, 0xa1, 0x6f, 0x19, 0xe0, 0xd7, 0xd0, 0x9c, 0x4a, 0x91, 0xe7, 0x74, 0x3a, 0xda,
+	0xa3, 0xa3, 0x89, 0x58, 0x70, 0x65, 0x5e, 0xe8, 0x7f, 0xe9, 0x23, 0xc7, 0x77, 0x77, 0x74, 0x5f,
+	0xb3, 0xbd, 0x6f, 0xe8, 0x6a, 0x1d, 0xa0, 0xeb, 0x75, 0x80, 0x6e, 0xd6, 0x01, 0xfa, 0xb2, 0x09,
+	0xbc, 0xeb, 0x4d, 0xe0, 0xfd, 0xd8, 0x04, 0x1e, 0x84, 0x4c, 0xdc, 0x9d, 0xa8, 0xe7, 0xf7, 0x4d,
+	0x39, 0xd4, 0xf0, 0x10, 0x7d, 0x7e, 0x33, 0xfb, 0x73, 0x80, 0xe9, 0xbf, 0x7b, 0x96, 0xd1, 0x89,
+	0x12, 0x32, 0xc9, 0xa7, 0x44, 0x91, 0x84, 0x71, 0x45, 0x25, 0x27, 0x59, 0x62, 0x3a, 0xe3, 0x38,
+	0xa3, 0x7c, 0xff, 0x55, 0xf8, 0x7a, 0xd0, 0xfa, 0x90, 0x53, 0xfe, 0x71, 0xe7, 0x61, 0xdc, 0x63,
+	0xbb, 0x29, 0xbe, 0xe8, 0x8c, 0x2b, 0x66, 0xe6, 0xd5, 0xaf, 0x01, 0x00, 0x04, 0x1b, 0xbb, 0x73,
+	0x5d, 0x04, 0x00, 0x00,
 }
 
 func (m *AnyValue) Marshal() (dAtA []byte, err error) {
@@ -758,43 +716,6 @@ func (m *KeyValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *InstrumentationLibrary) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *InstrumentationLibrary) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *InstrumentationLibrary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Version) > 0 {
-		i -= len(m.Version)
-		copy(dAtA[i:], m.Version)
-		i = encodeVarintCommon(dAtA, i, uint64(len(m.Version)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintCommon(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
 func (m *InstrumentationScope) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -815,6 +736,25 @@ func (m *InstrumentationScope) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DroppedAttributesCount != 0 {
+		i = encodeVarintCommon(dAtA, i, uint64(m.DroppedAttributesCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Attributes) > 0 {
+		for iNdEx := len(m.Attributes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attributes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCommon(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Version) > 0 {
 		i -= len(m.Version)
 		copy(dAtA[i:], m.Version)
@@ -973,7 +913,7 @@ func (m *KeyValue) Size() (n int) {
 	return n
 }
 
-func (m *InstrumentationLibrary) Size() (n int) {
+func (m *InstrumentationScope) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -987,22 +927,14 @@ func (m *InstrumentationLibrary) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovCommon(uint64(l))
 	}
-	return n
-}
-
-func (m *InstrumentationScope) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovCommon(uint64(l))
+	if len(m.Attributes) > 0 {
+		for _, e := range m.Attributes {
+			l = e.Size()
+			n += 1 + l + sovCommon(uint64(l))
+		}
 	}
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovCommon(uint64(l))
+	if m.DroppedAttributesCount != 0 {
+		n += 1 + sovCommon(uint64(m.DroppedAttributesCount))
 	}
 	return n
 }
@@ -1533,7 +1465,7 @@ func (m *KeyValue) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InstrumentationLibrary) Unmarshal(dAtA []byte) error {
+func (m *InstrumentationScope) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1556,10 +1488,10 @@ func (m *InstrumentationLibrary) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InstrumentationLibrary: wiretype end group for non-group")
+			return fmt.Errorf("proto: InstrumentationScope: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InstrumentationLibrary: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InstrumentationScope: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1626,61 +1558,11 @@ func (m *InstrumentationLibrary) Unmarshal(dAtA []byte) error {
 			}
 			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCommon(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthCommon
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *InstrumentationScope) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCommon
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: InstrumentationScope: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InstrumentationScope: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCommon
@@ -1690,29 +1572,31 @@ func (m *InstrumentationScope) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCommon
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCommon
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Attributes = append(m.Attributes, KeyValue{})
+			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DroppedAttributesCount", wireType)
 			}
-			var stringLen uint64
+			m.DroppedAttributesCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCommon
@@ -1722,24 +1606,11 @@ func (m *InstrumentationScope) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.DroppedAttributesCount |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthCommon
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCommon
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Version = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCommon(dAtA[iNdEx:])
@@ -217,34 +217,6 @@ type ResourceLogs struct {
 	Resource v1.Resource `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource"`
 	// A list of ScopeLogs that originate from a resource.
 	ScopeLogs []*ScopeLogs `protobuf:"bytes,2,rep,name=scope_logs,json=scopeLogs,proto3" json:"scope_logs,omitempty"`
-	// A list of InstrumentationLibraryLogs that originate from a resource.
-	// This field is deprecated and will be removed after grace period expires on June 15, 2022.
-	//
-	// During the grace period the following rules SHOULD be followed:
-	//
-	// For Binary Protobufs
-	// ====================
-	// Binary Protobuf senders SHOULD NOT set instrumentation_library_logs. Instead
-	// scope_logs SHOULD be set.
-	//
-	// Binary Protobuf receivers SHOULD check if instrumentation_library_logs is set
-	// and scope_logs is not set then the value in instrumentation_library_logs
-	// SHOULD be used instead by converting InstrumentationLibraryLogs into ScopeLogs.
-	// If scope_logs is set then instrumentation_library_logs SHOULD be ignored.
-	//
-	// For JSON
-	// ========
-	// JSON senders that set instrumentation_library_logs field MAY also set
-	// scope_logs to carry the same logs, essentially double-publishing the same data.
-	// Such double-publishing MAY be controlled by a user-settable option.
-	// If double-publishing is not used then the senders SHOULD set scope_logs and
-	// SHOULD NOT set instrumentation_library_logs.
-	//
-	// JSON receivers SHOULD check if instrumentation_library_logs is set and
-	// scope_logs is not set then the value in instrumentation_library_logs
-	// SHOULD be used instead by converting InstrumentationLibraryLogs into ScopeLogs.
-	// If scope_logs is set then instrumentation_library_logs field SHOULD be ignored.
-	InstrumentationLibraryLogs []*InstrumentationLibraryLogs `protobuf:"bytes,1000,rep,name=instrumentation_library_logs,json=instrumentationLibraryLogs,proto3" json:"instrumentation_library_logs,omitempty"` // Deprecated: Do not use.
 	// This schema_url applies to the data in the "resource" field. It does not apply
 	// to the data in the "scope_logs" field which have their own schema_url field.
 	SchemaUrl string `protobuf:"bytes,3,opt,name=schema_url,json=schemaUrl,proto3" json:"schema_url,omitempty"`
@@ -297,14 +269,6 @@ func (m *ResourceLogs) GetScopeLogs() []*ScopeLogs {
 	return nil
 }
 
-// Deprecated: Do not use.
-func (m *ResourceLogs) GetInstrumentationLibraryLogs() []*InstrumentationLibraryLogs {
-	if m != nil {
-		return m.InstrumentationLibraryLogs
-	}
-	return nil
-}
-
 func (m *ResourceLogs) GetSchemaUrl() string {
 	if m != nil {
 		return m.SchemaUrl
@@ -378,77 +342,6 @@ func (m *ScopeLogs) GetSchemaUrl() string {
 	return ""
 }
 
-// A collection of Logs produced by an InstrumentationLibrary.
-// InstrumentationLibraryLogs is wire-compatible with ScopeLogs for binary
-// Protobuf format.
-// This message is deprecated and will be removed on June 15, 2022.
-//
-// Deprecated: Do not use.
-type InstrumentationLibraryLogs struct {
-	// The instrumentation library information for the logs in this message.
-	// Semantically when InstrumentationLibrary isn't set, it is equivalent with
-	// an empty instrumentation library name (unknown).
-	InstrumentationLibrary v11.InstrumentationLibrary `protobuf:"bytes,1,opt,name=instrumentation_library,json=instrumentationLibrary,proto3" json:"instrumentation_library"`
-	// A list of logs that originate from an instrumentation library.
-	LogRecords []*LogRecord `protobuf:"bytes,2,rep,name=log_records,json=logRecords,proto3" json:"log_records,omitempty"`
-	// This schema_url applies to all logs in the "logs" field.
-	SchemaUrl string `protobuf:"bytes,3,opt,name=schema_url,json=schemaUrl,proto3" json:"schema_url,omitempty"`
-}
-
-func (m *InstrumentationLibraryLogs) Reset()         { *m = InstrumentationLibraryLogs{} }
-func (m *InstrumentationLibraryLogs) String() string { return proto.CompactTextString(m) }
-func (*InstrumentationLibraryLogs) ProtoMessage()    {}
-func (*InstrumentationLibraryLogs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1c030a3ec7e961e, []int{3}
-}
-func (m *InstrumentationLibraryLogs) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *InstrumentationLibraryLogs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_InstrumentationLibraryLogs.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
-	}
-}
-func (m *InstrumentationLibraryLogs) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_InstrumentationLibraryLogs.Merge(m, src)
-}
-func (m *InstrumentationLibraryLogs) XXX_Size() int {
-	return m.Size()
-}
-func (m *InstrumentationLibraryLogs) XXX_DiscardUnknown() {
-	xxx_messageInfo_InstrumentationLibraryLogs.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_InstrumentationLibraryLogs proto.InternalMessageInfo
-
-func (m *InstrumentationLibraryLogs) GetInstrumentationLibrary() v11.InstrumentationLibrary {
-	if m != nil {
-		return m.InstrumentationLibrary
-	}
-	return v11.InstrumentationLibrary{}
-}
-
-func (m *InstrumentationLibraryLogs) GetLogRecords() []*LogRecord {
-	if m != nil {
-		return m.LogRecords
-	}
-	return nil
-}
-
-func (m *InstrumentationLibraryLogs) GetSchemaUrl() string {
-	if m != nil {
-		return m.SchemaUrl
-	}
-	return ""
-}
-
 // A log record according to OpenTelemetry Log Data Model:
 // https://github.com/open-telemetry/oteps/blob/main/text/logs/0097-log-data-model.md
 type LogRecord struct {
@@ -509,7 +402,7 @@ func (m *LogRecord) Reset()         { *m = LogRecord{} }
 func (m *LogRecord) String() string { return proto.CompactTextString(m) }
 func (*LogRecord) ProtoMessage()    {}
 func (*LogRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1c030a3ec7e961e, []int{4}
+	return fileDescriptor_d1c030a3ec7e961e, []int{3}
 }
 func (m *LogRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -600,7 +493,6 @@ func init() {
 	proto.RegisterType((*LogsData)(nil), "opentelemetry.proto.logs.v1.LogsData")
 	proto.RegisterType((*ResourceLogs)(nil), "opentelemetry.proto.logs.v1.ResourceLogs")
 	proto.RegisterType((*ScopeLogs)(nil), "opentelemetry.proto.logs.v1.ScopeLogs")
-	proto.RegisterType((*InstrumentationLibraryLogs)(nil), "opentelemetry.proto.logs.v1.InstrumentationLibraryLogs")
 	proto.RegisterType((*LogRecord)(nil), "opentelemetry.proto.logs.v1.LogRecord")
 }
 
@@ -609,68 +501,65 @@ func init() {
 }
 
 var fileDescriptor_d1c030a3ec7e961e = []byte{
-	// 971 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x96, 0x41, 0x73, 0x22, 0x45,
-	0x14, 0xc7, 0x33, 0x24, 0x04, 0xe8, 0x10, 0xb6, 0x6d, 0xb3, 0xc9, 0x48, 0x94, 0x50, 0x51, 0x57,
-	0x8c, 0x55, 0x50, 0x0b, 0x6c, 0xb9, 0x7a, 0x72, 0x08, 0x43, 0x0a, 0x43, 0x20, 0xd5, 0xc0, 0xea,
-	0x6e, 0x59, 0x35, 0x35, 0x40, 0x8b, 0x53, 0x35, 0x4c, 0x53, 0x3d, 0x4d, 0x2a, 0x5c, 0x3c, 0x79,
-	0xf1, 0xe6, 0x57, 0xb2, 0xf4, 0xb0, 0xc7, 0x3d, 0x5a, 0x1e, 0xb6, 0xac, 0xe4, 0xe2, 0xa7, 0x50,
-	0xab, 0x7b, 0x06, 0x04, 0x9c, 0x21, 0xe6, 0xb2, 0x27, 0xba, 0xdf, 0xef, 0xbd, 0x7f, 0xff, 0x5f,
-	0x77, 0x4f, 0x17, 0xe0, 0x11, 0x1d, 0x13, 0x87, 0x13, 0x9b, 0x8c, 0x08, 0x67, 0xd3, 0xc2, 0x98,
-	0x51, 0x4e, 0x0b, 0x36, 0x1d, 0xba, 0x85, 0xab, 0xc7, 0xf2, 0x37, 0x2f, 0x43, 0xe8, 0x70, 0x29,
-	0xcf, 0x0b, 0xe6, 0x25, 0xbf, 0x7a, 0x9c, 0xde, 0x1b, 0xd2, 0x21, 0xf5, 0x4a, 0xc5, 0xc8, 0xa3,
-	0xe9, 0x93, 0x20, 0xe9, 0x3e, 0x1d, 0x8d, 0xa8, 0x23, 0xc4, 0xbd, 0x91, 0x9f, 0x9b, 0x0f, 0xca,
-	0x65, 0xc4, 0xa5, 0x13, 0xd6, 0x27, 0x22, 0x7b, 0x36, 0xf6, 0xf2, 0x8f, 0x5f, 0x80, 0x78, 0x83,
-	0x0e, 0xdd, 0xaa, 0xc9, 0x4d, 0xd4, 0x04, 0xbb, 0x33, 0x6a, 0x08, 0x47, 0xaa, 0x92, 0xdd, 0xcc,
-	0xed, 0x14, 0x3f, 0xce, 0xaf, 0xb1, 0x9c, 0xc7, 0x7e, 0x85, 0x50, 0xc1, 0x49, 0xb6, 0x30, 0x3b,
-	0xfe, 0x39, 0x02, 0x92, 0x8b, 0x18, 0x9d, 0x83, 0xf8, 0x2c, 0x41, 0x55, 0xb2, 0x4a, 0xa8, 0xf6,
-	0xdc, 0xe3, 0x82, 0x7e, 0x65, 0xeb, 0xe5, 0xeb, 0xa3, 0x0d, 0x3c, 0x17, 0x40, 0x3a, 0x00, 0x6e,
-	0x9f, 0x8e, 0x7d, 0xab, 0x11, 0x69, 0xf5, 0xd1, 0x5a, 0xab, 0x6d, 0x91, 0x2e, 0x7d, 0x26, 0xdc,
-	0xd9, 0x10, 0x7d, 0x0f, 0xde, 0xb5, 0x1c, 0x97, 0xb3, 0xc9, 0x88, 0x38, 0xdc, 0xe4, 0x16, 0x75,
-	0x0c, 0xdb, 0xea, 0x31, 0x93, 0x4d, 0x3d, 0xe1, 0x3f, 0x63, 0x52, 0xf9, 0xd3, 0xb5, 0xca, 0xf5,
-	0x65, 0x85, 0x86, 0x27, 0x20, 0xf4, 0x2b, 0x11, 0x55, 0xc1, 0x69, 0x2b, 0x94, 0xa3, 0xf7, 0x44,
-	0x1b, 0xdf, 0x91, 0x91, 0x69, 0x4c, 0x98, 0xad, 0x6e, 0x66, 0x95, 0x5c, 0x42, 0xd8, 0x13, 0x91,
-	0x2e, 0xb3, 0x8f, 0x7f, 0x55, 0x40, 0x62, 0xee, 0x1b, 0xb5, 0x40, 0x54, 0x3a, 0xf7, 0x77, 0xaf,
-	0x14, 0x68, 0xca, 0xbf, 0x0f, 0xff, 0xb5, 0x25, 0x75, 0xfc, 0x7d, 0xf4, 0x74, 0xd0, 0x19, 0xd8,
-	0xb1, 0xe9, 0xd0, 0x60, 0xa4, 0x4f, 0xd9, 0xe0, 0xff, 0xed, 0x62, 0x83, 0x0e, 0xb1, 0x4c, 0xc7,
-	0xc0, 0x9e, 0x0d, 0xef, 0x6c, 0xe3, 0x2f, 0x05, 0xa4, 0xc3, 0x37, 0x09, 0x71, 0x70, 0x10, 0x72,
-	0x08, 0x7e, 0xa7, 0x4f, 0xee, 0xd7, 0xa9, 0xaf, 0xed, 0xf7, 0xba, 0x1f, 0xbc, 0xfd, 0x6f, 0xaa,
-	0xf9, 0xcf, 0x23, 0xaa, 0x72, 0xfc, 0x43, 0x14, 0x24, 0xe6, 0xc5, 0xe8, 0x03, 0x90, 0xe2, 0xd6,
-	0x88, 0x18, 0x13, 0xc7, 0xba, 0x36, 0x1c, 0xd3, 0xa1, 0xb2, 0xcd, 0x6d, 0x9c, 0x14, 0xd1, 0xae,
-	0x63, 0x5d, 0x37, 0x4d, 0x87, 0xa2, 0x27, 0xe0, 0x80, 0xf6, 0x5c, 0xc2, 0xae, 0xc8, 0xc0, 0x58,
-	0x49, 0xdf, 0x91, 0xe9, 0x7b, 0x33, 0xdc, 0x59, 0x2c, 0xeb, 0x80, 0x07, 0x2e, 0xb9, 0x22, 0xcc,
-	0xe2, 0x53, 0xc3, 0x99, 0x8c, 0x7a, 0x84, 0xa9, 0x91, 0xac, 0x92, 0x4b, 0x15, 0x3f, 0x59, 0xff,
-	0x75, 0xf8, 0x35, 0x4d, 0x59, 0x82, 0x53, 0xee, 0xd2, 0x1c, 0xbd, 0x0f, 0x76, 0xe7, 0xaa, 0x9c,
-	0x5c, 0x73, 0xbf, 0xcd, 0xe4, 0x2c, 0xd8, 0x21, 0xd7, 0x1c, 0x69, 0x60, 0xab, 0x47, 0x07, 0x53,
-	0x35, 0x2a, 0x0f, 0xed, 0xa3, 0x3b, 0x0e, 0x4d, 0x73, 0xa6, 0xcf, 0x4c, 0x7b, 0x32, 0xbb, 0x92,
-	0xb2, 0x14, 0x5d, 0x00, 0x60, 0x72, 0xce, 0xac, 0xde, 0x84, 0x13, 0x57, 0xdd, 0x96, 0x67, 0x72,
-	0x97, 0xd0, 0x39, 0x59, 0x12, 0x5a, 0x10, 0x40, 0x4f, 0x81, 0x3a, 0x60, 0x74, 0x3c, 0x26, 0x03,
-	0xe3, 0xdf, 0xa8, 0xd1, 0xa7, 0x13, 0x87, 0xab, 0xb1, 0xac, 0x92, 0xdb, 0xc5, 0xfb, 0x3e, 0xd7,
-	0xe6, 0xf8, 0x54, 0x50, 0xb4, 0x07, 0xa2, 0xdf, 0xda, 0xe6, 0xd0, 0x55, 0xe3, 0x59, 0x25, 0x17,
-	0xc3, 0xde, 0x04, 0x7d, 0x03, 0xe2, 0x9c, 0x99, 0x7d, 0x62, 0x58, 0x03, 0x35, 0x91, 0x55, 0x72,
-	0xc9, 0x8a, 0x26, 0xd6, 0xfc, 0xfd, 0xf5, 0xd1, 0x67, 0x43, 0xba, 0x62, 0xd3, 0x12, 0xaf, 0xb4,
-	0x6d, 0x93, 0x3e, 0xa7, 0xac, 0x30, 0x1e, 0x98, 0xdc, 0x2c, 0x58, 0x0e, 0x27, 0xcc, 0x31, 0xed,
-	0x82, 0x98, 0xe5, 0x3b, 0x42, 0xa9, 0x5e, 0xc5, 0x31, 0x29, 0x59, 0x1f, 0xa0, 0xe7, 0x20, 0xe6,
-	0x8e, 0x4d, 0x47, 0x88, 0x03, 0x29, 0xfe, 0x85, 0x2f, 0xfe, 0xf4, 0xfe, 0xe2, 0xed, 0xb1, 0xe9,
-	0xd4, 0xab, 0x78, 0x5b, 0x08, 0xd6, 0x07, 0x5f, 0x6e, 0xc5, 0xb7, 0x60, 0xf4, 0xe4, 0x97, 0x28,
-	0x48, 0x2d, 0x1f, 0x34, 0x3a, 0x02, 0x87, 0x6d, 0xfd, 0x99, 0x8e, 0xeb, 0x9d, 0xe7, 0x46, 0xb3,
-	0x7b, 0x51, 0xd1, 0xb1, 0xd1, 0x6d, 0xb6, 0x2f, 0xf5, 0xd3, 0x7a, 0xad, 0xae, 0x57, 0xe1, 0x06,
-	0x7a, 0x07, 0x3c, 0x5c, 0x4d, 0xe8, 0x60, 0xed, 0x54, 0x87, 0x0a, 0x4a, 0x83, 0xfd, 0x40, 0x54,
-	0x84, 0x91, 0x50, 0x56, 0x82, 0x9b, 0xa1, 0xac, 0x0c, 0xb7, 0x82, 0x96, 0xab, 0xea, 0x95, 0xee,
-	0x19, 0x8c, 0x06, 0x95, 0x49, 0x54, 0x84, 0xdb, 0xa1, 0xac, 0x04, 0x63, 0xa1, 0xac, 0x0c, 0xe3,
-	0x48, 0x05, 0x7b, 0xab, 0xac, 0xde, 0xac, 0xb5, 0x60, 0x22, 0xc8, 0x88, 0x20, 0x45, 0x08, 0xc2,
-	0x50, 0x09, 0xee, 0x84, 0xa1, 0x32, 0x4c, 0x06, 0x2d, 0xf5, 0x95, 0x86, 0x9b, 0x70, 0x37, 0xa8,
-	0x48, 0x90, 0x22, 0x4c, 0x85, 0xa1, 0x12, 0x7c, 0x10, 0x86, 0xca, 0x10, 0x06, 0x21, 0x1d, 0xe3,
-	0x16, 0x86, 0x6f, 0x05, 0x6d, 0x86, 0x44, 0x45, 0x88, 0x42, 0x59, 0x09, 0xbe, 0x1d, 0xca, 0xca,
-	0x70, 0x2f, 0x68, 0xb9, 0x9a, 0xd6, 0xd1, 0x1a, 0xf0, 0x61, 0x50, 0x99, 0x44, 0x45, 0xb8, 0x1f,
-	0xca, 0x4a, 0xf0, 0x20, 0x94, 0x95, 0xa1, 0x7a, 0xf2, 0x35, 0x48, 0xcd, 0xdf, 0xd2, 0x9a, 0xfc,
-	0x2c, 0x8f, 0xc0, 0x61, 0xa3, 0x75, 0x66, 0x60, 0xfd, 0xb4, 0x85, 0xab, 0x46, 0xad, 0xa1, 0x9d,
-	0xad, 0x5c, 0xe2, 0x0f, 0x41, 0x76, 0x35, 0x41, 0xde, 0x38, 0x39, 0x6c, 0x1b, 0x17, 0x5a, 0xfb,
-	0x1c, 0xfe, 0xad, 0x54, 0x7e, 0x54, 0x5e, 0xde, 0x64, 0x94, 0x57, 0x37, 0x19, 0xe5, 0x8f, 0x9b,
-	0x8c, 0xf2, 0xd3, 0x6d, 0x66, 0xe3, 0xd5, 0x6d, 0x66, 0xe3, 0xb7, 0xdb, 0xcc, 0x06, 0xc8, 0x58,
-	0x74, 0xdd, 0xfb, 0x59, 0x11, 0xcf, 0xbb, 0x7b, 0x29, 0x42, 0x97, 0xca, 0x8b, 0xca, 0xbd, 0xbf,
-	0x57, 0xef, 0xaf, 0xda, 0x90, 0x38, 0xb3, 0x3f, 0x8d, 0xbd, 0x6d, 0x19, 0x29, 0xfd, 0x13, 0x00,
-	0x00, 0xff, 0xff, 0x43, 0x73, 0x1b, 0xe8, 0x5a, 0x0a, 0x00, 0x00,
+	// 927 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x96, 0xdf, 0x6e, 0xe2, 0xc6,
+	0x17, 0xc7, 0x71, 0xc2, 0xdf, 0x09, 0x61, 0xe7, 0x37, 0x3f, 0x36, 0xeb, 0x26, 0x2a, 0x41, 0x69,
+	0xbb, 0xa5, 0xa9, 0x04, 0x0a, 0x50, 0x69, 0x7b, 0x57, 0x13, 0x4c, 0xc4, 0x86, 0x40, 0x34, 0x40,
+	0xda, 0x5d, 0x55, 0xb2, 0x0c, 0x4c, 0xa9, 0x25, 0x33, 0x83, 0xc6, 0x03, 0x4a, 0xee, 0xfb, 0x00,
+	0x7d, 0x8b, 0x4a, 0x7d, 0x8d, 0xf6, 0x62, 0x7b, 0xb7, 0x97, 0x55, 0x2f, 0x56, 0x55, 0x72, 0xd3,
+	0x3e, 0x45, 0xab, 0x19, 0x0c, 0x25, 0xc8, 0xce, 0xee, 0x5e, 0x65, 0xe6, 0x7c, 0xbe, 0xe7, 0x3b,
+	0xe7, 0xe4, 0xd8, 0x83, 0xc1, 0x53, 0x36, 0x25, 0x54, 0x10, 0x97, 0x4c, 0x88, 0xe0, 0x37, 0xa5,
+	0x29, 0x67, 0x82, 0x95, 0x5c, 0x36, 0xf6, 0x4a, 0xf3, 0x13, 0xf5, 0xb7, 0xa8, 0x42, 0xe8, 0xe0,
+	0x9e, 0x6e, 0x11, 0x2c, 0x2a, 0x3e, 0x3f, 0xd9, 0xcf, 0x8e, 0xd9, 0x98, 0x2d, 0x52, 0xe5, 0x6a,
+	0x41, 0xf7, 0x8f, 0x83, 0xac, 0x87, 0x6c, 0x32, 0x61, 0x54, 0x9a, 0x2f, 0x56, 0xbe, 0xb6, 0x18,
+	0xa4, 0xe5, 0xc4, 0x63, 0x33, 0x3e, 0x24, 0x52, 0xbd, 0x5c, 0x2f, 0xf4, 0x47, 0x2f, 0x41, 0xb2,
+	0xc5, 0xc6, 0x5e, 0xdd, 0x16, 0x36, 0x6a, 0x83, 0xdd, 0x25, 0xb5, 0x64, 0x45, 0xba, 0x96, 0xdf,
+	0x2e, 0xec, 0x94, 0x3f, 0x2b, 0x3e, 0x50, 0x72, 0x11, 0xfb, 0x19, 0xd2, 0x05, 0xa7, 0xf9, 0xda,
+	0xee, 0xe8, 0x37, 0x0d, 0xa4, 0xd7, 0x31, 0x3a, 0x07, 0xc9, 0xa5, 0x40, 0xd7, 0xf2, 0x5a, 0xa8,
+	0xf7, 0xaa, 0xc6, 0x35, 0xff, 0x5a, 0xf4, 0xd5, 0x9b, 0xc3, 0x08, 0x5e, 0x19, 0x20, 0x13, 0x00,
+	0x6f, 0xc8, 0xa6, 0x7e, 0xa9, 0x5b, 0xaa, 0xd4, 0xa7, 0x0f, 0x96, 0xda, 0x95, 0x72, 0x55, 0x67,
+	0xca, 0x5b, 0x2e, 0xd1, 0x87, 0xd2, 0xe6, 0x7b, 0x32, 0xb1, 0xad, 0x19, 0x77, 0xf5, 0xed, 0xbc,
+	0x56, 0x48, 0xe1, 0xd4, 0x22, 0xd2, 0xe7, 0xee, 0xf3, 0x78, 0xf2, 0xaf, 0x04, 0xfc, 0x3b, 0x71,
+	0xf4, 0xab, 0x06, 0x52, 0xab, 0x7c, 0xd4, 0x01, 0x31, 0xe5, 0xe0, 0x77, 0x51, 0x09, 0x3c, 0xd6,
+	0x9f, 0xcb, 0xfc, 0xa4, 0xd8, 0xa4, 0x9e, 0xe0, 0xb3, 0x09, 0xa1, 0xc2, 0x16, 0x0e, 0xa3, 0xca,
+	0xc7, 0xef, 0x67, 0xe1, 0x83, 0xce, 0xc0, 0x8e, 0xcb, 0xc6, 0x16, 0x27, 0x43, 0xc6, 0x47, 0xef,
+	0xd6, 0x4d, 0x8b, 0x8d, 0xb1, 0x92, 0x63, 0xe0, 0x2e, 0x97, 0x6f, 0x6b, 0xe7, 0xe8, 0x87, 0x18,
+	0x48, 0xad, 0x12, 0xd1, 0xc7, 0x20, 0x23, 0x9c, 0x09, 0xb1, 0x66, 0xd4, 0xb9, 0xb6, 0xa8, 0x4d,
+	0x99, 0xea, 0x27, 0x8e, 0xd3, 0x32, 0xda, 0xa7, 0xce, 0x75, 0xdb, 0xa6, 0x0c, 0x7d, 0x01, 0x9e,
+	0xb0, 0x81, 0x47, 0xf8, 0x9c, 0x8c, 0xac, 0x0d, 0xf9, 0x8e, 0x92, 0x67, 0x97, 0xb8, 0xb7, 0x9e,
+	0xd6, 0x03, 0x8f, 0x3c, 0x32, 0x27, 0xdc, 0x11, 0x37, 0x16, 0x9d, 0x4d, 0x06, 0x84, 0xeb, 0x5b,
+	0x79, 0xad, 0x90, 0x29, 0x7f, 0xfe, 0xf0, 0x90, 0xfc, 0x9c, 0xb6, 0x4a, 0xc1, 0x19, 0xef, 0xde,
+	0x1e, 0x7d, 0x04, 0x76, 0x57, 0xae, 0x82, 0x5c, 0x0b, 0xbf, 0xc5, 0xf4, 0x32, 0xd8, 0x23, 0xd7,
+	0x02, 0x19, 0x20, 0x3a, 0x60, 0xa3, 0x1b, 0x3d, 0xa6, 0xa6, 0xf3, 0xe9, 0x5b, 0xa6, 0x63, 0xd0,
+	0x9b, 0x2b, 0xdb, 0x9d, 0x2d, 0x27, 0xa2, 0x52, 0xd1, 0x05, 0x00, 0xb6, 0x10, 0xdc, 0x19, 0xcc,
+	0x04, 0xf1, 0xf4, 0x78, 0x7e, 0xfb, 0x1d, 0x8c, 0xce, 0xc9, 0x3d, 0xa3, 0x35, 0x03, 0xf4, 0x0c,
+	0xe8, 0x23, 0xce, 0xa6, 0x53, 0x32, 0xb2, 0xfe, 0x8b, 0x5a, 0x43, 0x36, 0xa3, 0x42, 0x4f, 0xe4,
+	0xb5, 0xc2, 0x2e, 0xde, 0xf3, 0xb9, 0xb1, 0xc2, 0xa7, 0x92, 0xa2, 0x2c, 0x88, 0x7d, 0xe7, 0xda,
+	0x63, 0x4f, 0x4f, 0xe6, 0xb5, 0x42, 0x02, 0x2f, 0x36, 0xe8, 0x5b, 0x90, 0x14, 0xdc, 0x1e, 0x12,
+	0xcb, 0x19, 0xe9, 0xa9, 0xbc, 0x56, 0x48, 0xd7, 0x0c, 0x79, 0xe6, 0x1f, 0x6f, 0x0e, 0xbf, 0x1c,
+	0xb3, 0x8d, 0x32, 0x1d, 0x79, 0x59, 0xb8, 0x2e, 0x19, 0x0a, 0xc6, 0x4b, 0xd3, 0x91, 0x2d, 0xec,
+	0x92, 0x43, 0x05, 0xe1, 0xd4, 0x76, 0x4b, 0x72, 0x57, 0xec, 0x49, 0xa7, 0x66, 0x1d, 0x27, 0x94,
+	0x65, 0x73, 0x84, 0x5e, 0x80, 0x84, 0x37, 0xb5, 0xa9, 0x34, 0x07, 0xca, 0xfc, 0x2b, 0xdf, 0xfc,
+	0xd9, 0xfb, 0x9b, 0x77, 0xa7, 0x36, 0x6d, 0xd6, 0x71, 0x5c, 0x1a, 0x36, 0x47, 0xcf, 0xa3, 0xc9,
+	0x28, 0x8c, 0x1d, 0xff, 0x12, 0x03, 0x99, 0xfb, 0x83, 0x46, 0x87, 0xe0, 0xa0, 0x6b, 0x5e, 0x99,
+	0xb8, 0xd9, 0x7b, 0x61, 0xb5, 0xfb, 0x17, 0x35, 0x13, 0x5b, 0xfd, 0x76, 0xf7, 0xd2, 0x3c, 0x6d,
+	0x36, 0x9a, 0x66, 0x1d, 0x46, 0xd0, 0x07, 0xe0, 0xf1, 0xa6, 0xa0, 0x87, 0x8d, 0x53, 0x13, 0x6a,
+	0x68, 0x1f, 0xec, 0x05, 0xa2, 0x32, 0xdc, 0x0a, 0x65, 0x15, 0xb8, 0x1d, 0xca, 0xaa, 0x30, 0x1a,
+	0x74, 0x5c, 0xdd, 0xac, 0xf5, 0xcf, 0x60, 0x2c, 0x28, 0x4d, 0xa1, 0x32, 0x8c, 0x87, 0xb2, 0x0a,
+	0x4c, 0x84, 0xb2, 0x2a, 0x4c, 0x22, 0x1d, 0x64, 0x37, 0x59, 0xb3, 0xdd, 0xe8, 0xc0, 0x54, 0x50,
+	0x21, 0x92, 0x94, 0x21, 0x08, 0x43, 0x15, 0xb8, 0x13, 0x86, 0xaa, 0x30, 0x1d, 0x74, 0xd4, 0xd7,
+	0x06, 0x6e, 0xc3, 0xdd, 0xa0, 0x24, 0x49, 0xca, 0x30, 0x13, 0x86, 0x2a, 0xf0, 0x51, 0x18, 0xaa,
+	0x42, 0x18, 0x84, 0x4c, 0x8c, 0x3b, 0x18, 0xfe, 0x2f, 0xe8, 0x9f, 0xa1, 0x50, 0x19, 0xa2, 0x50,
+	0x56, 0x81, 0xff, 0x0f, 0x65, 0x55, 0x98, 0x0d, 0x3a, 0xae, 0x61, 0xf4, 0x8c, 0x16, 0x7c, 0x1c,
+	0x94, 0xa6, 0x50, 0x19, 0xee, 0x85, 0xb2, 0x0a, 0x7c, 0x12, 0xca, 0xaa, 0x50, 0x3f, 0xfe, 0x06,
+	0x64, 0x56, 0x77, 0x69, 0x43, 0xbd, 0x96, 0x87, 0xe0, 0xa0, 0xd5, 0x39, 0xb3, 0xb0, 0x79, 0xda,
+	0xc1, 0x75, 0xab, 0xd1, 0x32, 0xce, 0x36, 0x1e, 0xe2, 0x4f, 0x40, 0x7e, 0x53, 0xa0, 0x9e, 0x38,
+	0xb5, 0xec, 0x5a, 0x17, 0x46, 0xf7, 0x1c, 0xfe, 0xa3, 0xd5, 0x7e, 0xd2, 0x5e, 0xdd, 0xe6, 0xb4,
+	0xd7, 0xb7, 0x39, 0xed, 0xcf, 0xdb, 0x9c, 0xf6, 0xe3, 0x5d, 0x2e, 0xf2, 0xfa, 0x2e, 0x17, 0xf9,
+	0xfd, 0x2e, 0x17, 0x01, 0x39, 0x87, 0x3d, 0x74, 0x7f, 0xd6, 0xe4, 0xf5, 0xee, 0x5d, 0xca, 0xd0,
+	0xa5, 0xf6, 0xb2, 0xf6, 0xde, 0xef, 0xeb, 0xe2, 0x8b, 0x61, 0x4c, 0xe8, 0xf2, 0xdb, 0xe5, 0xe7,
+	0xad, 0x83, 0xce, 0x94, 0xd0, 0xde, 0xca, 0x41, 0x79, 0xcb, 0x5f, 0x1f, 0xaf, 0x78, 0x75, 0x32,
+	0x88, 0x2b, 0x7d, 0xe5, 0xdf, 0x01, 0x00, 0xbd, 0x92, 0x1b, 0x9e, 0xff, 0x08, 0x00, 0x00,
 }
 
 func (m *LogsData) Marshal() (dAtA []byte, err error) {
@@ -730,22 +619,6 @@ func (m *ResourceLogs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if len(m.InstrumentationLibraryLogs) > 0 {
-		for iNdEx := len(m.InstrumentationLibraryLogs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.InstrumentationLibraryLogs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintLogs(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x3e
-			i--
-			dAtA[i] = 0xc2
-		}
-	}
 	if len(m.SchemaUrl) > 0 {
 		i -= len(m.SchemaUrl)
 		copy(dAtA[i:], m.SchemaUrl)
@@ -834,60 +707,6 @@ func (m *ScopeLogs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *InstrumentationLibraryLogs) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *InstrumentationLibraryLogs) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *InstrumentationLibraryLogs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SchemaUrl) > 0 {
-		i -= len(m.SchemaUrl)
-		copy(dAtA[i:], m.SchemaUrl)
-		i = encodeVarintLogs(dAtA, i, uint64(len(m.SchemaUrl)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.LogRecords) > 0 {
-		for iNdEx := len(m.LogRecords) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.LogRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintLogs(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	{
-		size, err := m.InstrumentationLibrary.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintLogs(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
 func (m *LogRecord) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1034,12 +853,6 @@ func (m *ResourceLogs) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovLogs(uint64(l))
 	}
-	if len(m.InstrumentationLibraryLogs) > 0 {
-		for _, e := range m.InstrumentationLibraryLogs {
-			l = e.Size()
-			n += 2 + l + sovLogs(uint64(l))
-		}
-	}
 	return n
 }
 
@@ -1064,27 +877,6 @@ func (m *ScopeLogs) Size() (n int) {
 	return n
 }
 
-func (m *InstrumentationLibraryLogs) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.InstrumentationLibrary.Size()
-	n += 1 + l + sovLogs(uint64(l))
-	if len(m.LogRecords) > 0 {
-		for _, e := range m.LogRecords {
-			l = e.Size()
-			n += 1 + l + sovLogs(uint64(l))
-		}
-	}
-	l = len(m.SchemaUrl)
-	if l > 0 {
-		n += 1 + l + sovLogs(uint64(l))
-	}
-	return n
-}
-
 func (m *LogRecord) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1343,40 +1135,6 @@ func (m *ResourceLogs) Unmarshal(dAtA []byte) error {
 			}
 			m.SchemaUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 1000:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InstrumentationLibraryLogs", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLogs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthLogs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthLogs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.InstrumentationLibraryLogs = append(m.InstrumentationLibraryLogs, &InstrumentationLibraryLogs{})
-			if err := m.InstrumentationLibraryLogs[len(m.InstrumentationLibraryLogs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLogs(dAtA[iNdEx:])
@@ -1547,155 +1305,6 @@ func (m *ScopeLogs) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InstrumentationLibraryLogs) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowLogs
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: InstrumentationLibraryLogs: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InstrumentationLibraryLogs: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InstrumentationLibrary", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLogs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthLogs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthLogs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.InstrumentationLibrary.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogRecords", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLogs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthLogs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthLogs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.LogRecords = append(m.LogRecords, &LogRecord{})
-			if err := m.LogRecords[len(m.LogRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SchemaUrl", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLogs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLogs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLogs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SchemaUrl = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipLogs(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthLogs
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
 func (m *LogRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -221,34 +221,6 @@ type ResourceMetrics struct {
 	Resource v1.Resource `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource"`
 	// A list of metrics that originate from a resource.
 	ScopeMetrics []*ScopeMetrics `protobuf:"bytes,2,rep,name=scope_metrics,json=scopeMetrics,proto3" json:"scope_metrics,omitempty"`
-	// A list of InstrumentationLibraryMetrics that originate from a resource.
-	// This field is deprecated and will be removed after grace period expires on June 15, 2022.
-	//
-	// During the grace period the following rules SHOULD be followed:
-	//
-	// For Binary Protobufs
-	// ====================
-	// Binary Protobuf senders SHOULD NOT set instrumentation_library_metrics. Instead
-	// scope_metrics SHOULD be set.
-	//
-	// Binary Protobuf receivers SHOULD check if instrumentation_library_metrics is set
-	// and scope_metrics is not set then the value in instrumentation_library_metrics
-	// SHOULD be used instead by converting InstrumentationLibraryMetrics into ScopeMetrics.
-	// If scope_metrics is set then instrumentation_library_metrics SHOULD be ignored.
-	//
-	// For JSON
-	// ========
-	// JSON senders that set instrumentation_library_metrics field MAY also set
-	// scope_metrics to carry the same metrics, essentially double-publishing the same data.
-	// Such double-publishing MAY be controlled by a user-settable option.
-	// If double-publishing is not used then the senders SHOULD set scope_metrics and
-	// SHOULD NOT set instrumentation_library_metrics.
-	//
-	// JSON receivers SHOULD check if instrumentation_library_metrics is set and
-	// scope_metrics is not set then the value in instrumentation_library_metrics
-	// SHOULD be used instead by converting InstrumentationLibraryMetrics into ScopeMetrics.
-	// If scope_metrics is set then instrumentation_library_metrics field SHOULD be ignored.
-	InstrumentationLibraryMetrics []*InstrumentationLibraryMetrics `protobuf:"bytes,1000,rep,name=instrumentation_library_metrics,json=instrumentationLibraryMetrics,proto3" json:"instrumentation_library_metrics,omitempty"` // Deprecated: Do not use.
 	// This schema_url applies to the data in the "resource" field. It does not apply
 	// to the data in the "scope_metrics" field which have their own schema_url field.
 	SchemaUrl string `protobuf:"bytes,3,opt,name=schema_url,json=schemaUrl,proto3" json:"schema_url,omitempty"`
@@ -301,14 +273,6 @@ func (m *ResourceMetrics) GetScopeMetrics() []*ScopeMetrics {
 	return nil
 }
 
-// Deprecated: Do not use.
-func (m *ResourceMetrics) GetInstrumentationLibraryMetrics() []*InstrumentationLibraryMetrics {
-	if m != nil {
-		return m.InstrumentationLibraryMetrics
-	}
-	return nil
-}
-
 func (m *ResourceMetrics) GetSchemaUrl() string {
 	if m != nil {
 		return m.SchemaUrl
@@ -382,81 +346,10 @@ func (m *ScopeMetrics) GetSchemaUrl() string {
 	return ""
 }
 
-// A collection of Metrics produced by an InstrumentationLibrary.
-// InstrumentationLibraryMetrics is wire-compatible with ScopeMetrics for binary
-// Protobuf format.
-// This message is deprecated and will be removed on June 15, 2022.
-//
-// Deprecated: Do not use.
-type InstrumentationLibraryMetrics struct {
-	// The instrumentation library information for the metrics in this message.
-	// Semantically when InstrumentationLibrary isn't set, it is equivalent with
-	// an empty instrumentation library name (unknown).
-	InstrumentationLibrary v11.InstrumentationLibrary `protobuf:"bytes,1,opt,name=instrumentation_library,json=instrumentationLibrary,proto3" json:"instrumentation_library"`
-	// A list of metrics that originate from an instrumentation library.
-	Metrics []*Metric `protobuf:"bytes,2,rep,name=metrics,proto3" json:"metrics,omitempty"`
-	// This schema_url applies to all metrics in the "metrics" field.
-	SchemaUrl string `protobuf:"bytes,3,opt,name=schema_url,json=schemaUrl,proto3" json:"schema_url,omitempty"`
-}
-
-func (m *InstrumentationLibraryMetrics) Reset()         { *m = InstrumentationLibraryMetrics{} }
-func (m *InstrumentationLibraryMetrics) String() string { return proto.CompactTextString(m) }
-func (*InstrumentationLibraryMetrics) ProtoMessage()    {}
-func (*InstrumentationLibraryMetrics) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3c3112f9fa006917, []int{3}
-}
-func (m *InstrumentationLibraryMetrics) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *InstrumentationLibraryMetrics) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_InstrumentationLibraryMetrics.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
-	}
-}
-func (m *InstrumentationLibraryMetrics) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_InstrumentationLibraryMetrics.Merge(m, src)
-}
-func (m *InstrumentationLibraryMetrics) XXX_Size() int {
-	return m.Size()
-}
-func (m *InstrumentationLibraryMetrics) XXX_DiscardUnknown() {
-	xxx_messageInfo_InstrumentationLibraryMetrics.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_InstrumentationLibraryMetrics proto.InternalMessageInfo
-
-func (m *InstrumentationLibraryMetrics) GetInstrumentationLibrary() v11.InstrumentationLibrary {
-	if m != nil {
-		return m.InstrumentationLibrary
-	}
-	return v11.InstrumentationLibrary{}
-}
-
-func (m *InstrumentationLibraryMetrics) GetMetrics() []*Metric {
-	if m != nil {
-		return m.Metrics
-	}
-	return nil
-}
-
-func (m *InstrumentationLibraryMetrics) GetSchemaUrl() string {
-	if m != nil {
-		return m.SchemaUrl
-	}
-	return ""
-}
-
 // Defines a Metric which has one or more timeseries.  The following is a
 // brief summary of the Metric data model.  For more details, see:
 //
-//   https://github.com/open-telemetry/opentelemetry-specification/blob/main/specification/metrics/datamodel.md
+//   https://github.com/open-telemetry/opentelemetry-specification/blob/main/specification/metrics/data-model.md
 //
 //
 // The data model and relation between entities is shown in the
@@ -563,7 +456,7 @@ func (m *Metric) Reset()         { *m = Metric{} }
 func (m *Metric) String() string { return proto.CompactTextString(m) }
 func (*Metric) ProtoMessage()    {}
 func (*Metric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3c3112f9fa006917, []int{4}
+	return fileDescriptor_3c3112f9fa006917, []int{3}
 }
 func (m *Metric) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -711,7 +604,7 @@ func (m *Gauge) Reset()         { *m = Gauge{} }
 func (m *Gauge) String() string { return proto.CompactTextString(m) }
 func (*Gauge) ProtoMessage()    {}
 func (*Gauge) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3c3112f9fa006917, []int{5}
+	return fileDescriptor_3c3112f9fa006917, []int{4}
 }
 func (m *Gauge) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -762,7 +655,7 @@ func (m *Sum) Reset()         { *m = Sum{} }
 func (m *Sum) String() string { return proto.CompactTextString(m) }
 func (*Sum) ProtoMessage()    {}
 func (*Sum) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3c3112f9fa006917, []int{6}
+	return fileDescriptor_3c3112f9fa006917, []int{5}
 }
 func (m *Sum) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -825,7 +718,7 @@ func (m *Histogram) Reset()         { *m = Histogram{} }
 func (m *Histogram) String() string { return proto.CompactTextString(m) }
 func (*Histogram) ProtoMessage()    {}
 func (*Histogram) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3c3112f9fa006917, []int{7}
+	return fileDescriptor_3c3112f9fa006917, []int{6}
 }
 func (m *Histogram) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -881,7 +774,7 @@ func (m *ExponentialHistogram) Reset()         { *m = ExponentialHistogram{} }
 func (m *ExponentialHistogram) String() string { return proto.CompactTextString(m) }
 func (*ExponentialHistogram) ProtoMessage()    {}
 func (*ExponentialHistogram) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3c3112f9fa006917, []int{8}
+	return fileDescriptor_3c3112f9fa006917, []int{7}
 }
 func (m *ExponentialHistogram) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -938,7 +831,7 @@ func (m *Summary) Reset()         { *m = Summary{} }
 func (m *Summary) String() string { return proto.CompactTextString(m) }
 func (*Summary) ProtoMessage()    {}
 func (*Summary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3c3112f9fa006917, []int{9}
+	return fileDescriptor_3c3112f9fa006917, []int{8}
 }
 func (m *Summary) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1012,7 +905,7 @@ func (m *NumberDataPoint) Reset()         { *m = NumberDataPoint{} }
 func (m *NumberDataPoint) String() string { return proto.CompactTextString(m) }
 func (*NumberDataPoint) ProtoMessage()    {}
 func (*NumberDataPoint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3c3112f9fa006917, []int{10}
+	return fileDescriptor_3c3112f9fa006917, []int{9}
 }
 func (m *NumberDataPoint) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1192,13 +1085,23 @@ type HistogramDataPoint struct {
 	// Flags that apply to this specific data point.  See DataPointFlags
 	// for the available flags and their meaning.
 	Flags uint32 `protobuf:"varint,10,opt,name=flags,proto3" json:"flags,omitempty"`
+	// min is the minimum value over (start_time, end_time].
+	//
+	// Types that are valid to be assigned to Min_:
+	//	*HistogramDataPoint_Min
+	Min_ isHistogramDataPoint_Min_ `protobuf_oneof:"min_"`
+	// max is the maximum value over (start_time, end_time].
+	//
+	// Types that are valid to be assigned to Max_:
+	//	*HistogramDataPoint_Max
+	Max_ isHistogramDataPoint_Max_ `protobuf_oneof:"max_"`
 }
 
 func (m *HistogramDataPoint) Reset()         { *m = HistogramDataPoint{} }
 func (m *HistogramDataPoint) String() string { return proto.CompactTextString(m) }
 func (*HistogramDataPoint) ProtoMessage()    {}
 func (*HistogramDataPoint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3c3112f9fa006917, []int{11}
+	return fileDescriptor_3c3112f9fa006917, []int{10}
 }
 func (m *HistogramDataPoint) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1232,12 +1135,30 @@ type isHistogramDataPoint_Sum_ interface {
 	MarshalTo([]byte) (int, error)
 	Size() int
 }
+type isHistogramDataPoint_Min_ interface {
+	isHistogramDataPoint_Min_()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+type isHistogramDataPoint_Max_ interface {
+	isHistogramDataPoint_Max_()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
 
 type HistogramDataPoint_Sum struct {
 	Sum float64 `protobuf:"fixed64,5,opt,name=sum,proto3,oneof" json:"sum,omitempty"`
 }
+type HistogramDataPoint_Min struct {
+	Min float64 `protobuf:"fixed64,11,opt,name=min,proto3,oneof" json:"min,omitempty"`
+}
+type HistogramDataPoint_Max struct {
+	Max float64 `protobuf:"fixed64,12,opt,name=max,proto3,oneof" json:"max,omitempty"`
+}
 
 func (*HistogramDataPoint_Sum) isHistogramDataPoint_Sum_() {}
+func (*HistogramDataPoint_Min) isHistogramDataPoint_Min_() {}
+func (*HistogramDataPoint_Max) isHistogramDataPoint_Max_() {}
 
 func (m *HistogramDataPoint) GetSum_() isHistogramDataPoint_Sum_ {
 	if m != nil {
@@ -1245,6 +1166,18 @@ func (m *HistogramDataPoint) GetSum_() isHistogramDataPoint_Sum_ {
 	}
 	return nil
 }
+func (m *HistogramDataPoint) GetMin_() isHistogramDataPoint_Min_ {
+	if m != nil {
+		return m.Min_
+	}
+	return nil
+}
+func (m *HistogramDataPoint) GetMax_() isHistogramDataPoint_Max_ {
+	if m != nil {
+		return m.Max_
+	}
+	return nil
+}
 
 func (m *HistogramDataPoint) GetAttributes() []v11.KeyValue {
 	if m != nil {
@@ -1309,10 +1242,26 @@ func (m *HistogramDataPoint) GetFlags() uint32 {
 	return 0
 }
 
+func (m *HistogramDataPoint) GetMin() float64 {
+	if x, ok := m.GetMin_().(*HistogramDataPoint_Min); ok {
+		return x.Min
+	}
+	return 0
+}
+
+func (m *HistogramDataPoint) GetMax() float64 {
+	if x, ok := m.GetMax_().(*HistogramDataPoint_Max); ok {
+		return x.Max
+	}
+	return 0
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*HistogramDataPoint) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*HistogramDataPoint_Sum)(nil),
+		(*HistogramDataPoint_Min)(nil),
+		(*HistogramDataPoint_Max)(nil),
 	}
 }
 
@@ -1350,7 +1299,10 @@ type ExponentialHistogramDataPoint struct {
 	// Negative events *can* be recorded, but sum should not be filled out when
 	// doing so.  This is specifically to enforce compatibility w/ OpenMetrics,
 	// see: https://github.com/OpenObservability/OpenMetrics/blob/main/specification/OpenMetrics.md#histogram
-	Sum float64 `protobuf:"fixed64,5,opt,name=sum,proto3" json:"sum,omitempty"`
+	//
+	// Types that are valid to be assigned to Sum_:
+	//	*ExponentialHistogramDataPoint_Sum
+	Sum_ isExponentialHistogramDataPoint_Sum_ `protobuf_oneof:"sum_"`
 	// scale describes the resolution of the histogram.  Boundaries are
 	// located at powers of the base, where:
 	//
@@ -1386,13 +1338,23 @@ type ExponentialHistogramDataPoint struct {
 	// (Optional) List of exemplars collected from
 	// measurements that were used to form the data point
 	Exemplars []Exemplar `protobuf:"bytes,11,rep,name=exemplars,proto3" json:"exemplars"`
+	// min is the minimum value over (start_time, end_time].
+	//
+	// Types that are valid to be assigned to Min_:
+	//	*ExponentialHistogramDataPoint_Min
+	Min_ isExponentialHistogramDataPoint_Min_ `protobuf_oneof:"min_"`
+	// max is the maximum value over (start_time, end_time].
+	//
+	// Types that are valid to be assigned to Max_:
+	//	*ExponentialHistogramDataPoint_Max
+	Max_ isExponentialHistogramDataPoint_Max_ `protobuf_oneof:"max_"`
 }
 
 func (m *ExponentialHistogramDataPoint) Reset()         { *m = ExponentialHistogramDataPoint{} }
 func (m *ExponentialHistogramDataPoint) String() string { return proto.CompactTextString(m) }
 func (*ExponentialHistogramDataPoint) ProtoMessage()    {}
 func (*ExponentialHistogramDataPoint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3c3112f9fa006917, []int{12}
+	return fileDescriptor_3c3112f9fa006917, []int{11}
 }
 func (m *ExponentialHistogramDataPoint) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1421,6 +1383,55 @@ func (m *ExponentialHistogramDataPoint) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ExponentialHistogramDataPoint proto.InternalMessageInfo
 
+type isExponentialHistogramDataPoint_Sum_ interface {
+	isExponentialHistogramDataPoint_Sum_()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+type isExponentialHistogramDataPoint_Min_ interface {
+	isExponentialHistogramDataPoint_Min_()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+type isExponentialHistogramDataPoint_Max_ interface {
+	isExponentialHistogramDataPoint_Max_()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type ExponentialHistogramDataPoint_Sum struct {
+	Sum float64 `protobuf:"fixed64,5,opt,name=sum,proto3,oneof" json:"sum,omitempty"`
+}
+type ExponentialHistogramDataPoint_Min struct {
+	Min float64 `protobuf:"fixed64,12,opt,name=min,proto3,oneof" json:"min,omitempty"`
+}
+type ExponentialHistogramDataPoint_Max struct {
+	Max float64 `protobuf:"fixed64,13,opt,name=max,proto3,oneof" json:"max,omitempty"`
+}
+
+func (*ExponentialHistogramDataPoint_Sum) isExponentialHistogramDataPoint_Sum_() {}
+func (*ExponentialHistogramDataPoint_Min) isExponentialHistogramDataPoint_Min_() {}
+func (*ExponentialHistogramDataPoint_Max) isExponentialHistogramDataPoint_Max_() {}
+
+func (m *ExponentialHistogramDataPoint) GetSum_() isExponentialHistogramDataPoint_Sum_ {
+	if m != nil {
+		return m.Sum_
+	}
+	return nil
+}
+func (m *ExponentialHistogramDataPoint) GetMin_() isExponentialHistogramDataPoint_Min_ {
+	if m != nil {
+		return m.Min_
+	}
+	return nil
+}
+func (m *ExponentialHistogramDataPoint) GetMax_() isExponentialHistogramDataPoint_Max_ {
+	if m != nil {
+		return m.Max_
+	}
+	return nil
+}
+
 func (m *ExponentialHistogramDataPoint) GetAttributes() []v11.KeyValue {
 	if m != nil {
 		return m.Attributes
@@ -1450,8 +1461,8 @@ func (m *ExponentialHistogramDataPoint) GetCount() uint64 {
 }
 
 func (m *ExponentialHistogramDataPoint) GetSum() float64 {
-	if m != nil {
-		return m.Sum
+	if x, ok := m.GetSum_().(*ExponentialHistogramDataPoint_Sum); ok {
+		return x.Sum
 	}
 	return 0
 }
@@ -1498,6 +1509,29 @@ func (m *ExponentialHistogramDataPoint) GetExemplars() []Exemplar {
 	return nil
 }
 
+func (m *ExponentialHistogramDataPoint) GetMin() float64 {
+	if x, ok := m.GetMin_().(*ExponentialHistogramDataPoint_Min); ok {
+		return x.Min
+	}
+	return 0
+}
+
+func (m *ExponentialHistogramDataPoint) GetMax() float64 {
+	if x, ok := m.GetMax_().(*ExponentialHistogramDataPoint_Max); ok {
+		return x.Max
+	}
+	return 0
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*ExponentialHistogramDataPoint) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*ExponentialHistogramDataPoint_Sum)(nil),
+		(*ExponentialHistogramDataPoint_Min)(nil),
+		(*ExponentialHistogramDataPoint_Max)(nil),
+	}
+}
+
 // Buckets are a set of bucket counts, encoded in a contiguous array
 // of counts.
 type ExponentialHistogramDataPoint_Buckets struct {
@@ -1521,7 +1555,7 @@ func (m *ExponentialHistogramDataPoint_Buckets) Reset()         { *m = Exponenti
 func (m *ExponentialHistogramDataPoint_Buckets) String() string { return proto.CompactTextString(m) }
 func (*ExponentialHistogramDataPoint_Buckets) ProtoMessage()    {}
 func (*ExponentialHistogramDataPoint_Buckets) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3c3112f9fa006917, []int{12, 0}
+	return fileDescriptor_3c3112f9fa006917, []int{11, 0}
 }
 func (m *ExponentialHistogramDataPoint_Buckets) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1606,7 +1640,7 @@ func (m *SummaryDataPoint) Reset()         { *m = SummaryDataPoint{} }
 func (m *SummaryDataPoint) String() string { return proto.CompactTextString(m) }
 func (*SummaryDataPoint) ProtoMessage()    {}
 func (*SummaryDataPoint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3c3112f9fa006917, []int{13}
+	return fileDescriptor_3c3112f9fa006917, []int{12}
 }
 func (m *SummaryDataPoint) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1706,7 +1740,7 @@ func (m *SummaryDataPoint_ValueAtQuantile) Reset()         { *m = SummaryDataPoi
 func (m *SummaryDataPoint_ValueAtQuantile) String() string { return proto.CompactTextString(m) }
 func (*SummaryDataPoint_ValueAtQuantile) ProtoMessage()    {}
 func (*SummaryDataPoint_ValueAtQuantile) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3c3112f9fa006917, []int{13, 0}
+	return fileDescriptor_3c3112f9fa006917, []int{12, 0}
 }
 func (m *SummaryDataPoint_ValueAtQuantile) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1785,7 +1819,7 @@ func (m *Exemplar) Reset()         { *m = Exemplar{} }
 func (m *Exemplar) String() string { return proto.CompactTextString(m) }
 func (*Exemplar) ProtoMessage()    {}
 func (*Exemplar) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3c3112f9fa006917, []int{14}
+	return fileDescriptor_3c3112f9fa006917, []int{13}
 }
 func (m *Exemplar) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1879,7 +1913,6 @@ func init() {
 	proto.RegisterType((*MetricsData)(nil), "opentelemetry.proto.metrics.v1.MetricsData")
 	proto.RegisterType((*ResourceMetrics)(nil), "opentelemetry.proto.metrics.v1.ResourceMetrics")
 	proto.RegisterType((*ScopeMetrics)(nil), "opentelemetry.proto.metrics.v1.ScopeMetrics")
-	proto.RegisterType((*InstrumentationLibraryMetrics)(nil), "opentelemetry.proto.metrics.v1.InstrumentationLibraryMetrics")
 	proto.RegisterType((*Metric)(nil), "opentelemetry.proto.metrics.v1.Metric")
 	proto.RegisterType((*Gauge)(nil), "opentelemetry.proto.metrics.v1.Gauge")
 	proto.RegisterType((*Sum)(nil), "opentelemetry.proto.metrics.v1.Sum")
@@ -1900,101 +1933,101 @@ func init() {
 }
 
 var fileDescriptor_3c3112f9fa006917 = []byte{
-	// 1490 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0x5b, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0xfa, 0xee, 0xe3, 0x5c, 0xdc, 0x21, 0xa4, 0x56, 0xa4, 0xa4, 0xae, 0x0b, 0x4d, 0xa8,
-	0x2a, 0x9b, 0xa6, 0xdc, 0x51, 0xa5, 0xda, 0xb1, 0x93, 0x38, 0xcd, 0xad, 0x13, 0xa7, 0x52, 0xab,
-	0xaa, 0xab, 0x89, 0x3d, 0x71, 0x47, 0xdd, 0xdd, 0x31, 0xbb, 0xb3, 0x51, 0xc2, 0x4f, 0x40, 0x3c,
-	0x54, 0xfc, 0x02, 0x1e, 0x78, 0xe4, 0x47, 0xf0, 0xd8, 0xc7, 0xf2, 0x86, 0x90, 0xa8, 0x20, 0x7d,
-	0x00, 0x89, 0x7f, 0xc0, 0x13, 0x9a, 0xd9, 0xdd, 0xf8, 0x12, 0x27, 0x4e, 0x68, 0x91, 0xda, 0xb7,
-	0x99, 0xb3, 0xdf, 0xf9, 0xce, 0x75, 0xce, 0x8c, 0x0d, 0xd7, 0x79, 0x9b, 0x5a, 0x82, 0x1a, 0xd4,
-	0xa4, 0xc2, 0x3e, 0x28, 0xb6, 0x6d, 0x2e, 0x78, 0x51, 0xae, 0x59, 0xc3, 0x29, 0xee, 0xdd, 0x08,
-	0x96, 0x05, 0xf5, 0x01, 0xcd, 0xf4, 0xa0, 0x3d, 0x61, 0x21, 0x80, 0xec, 0xdd, 0x98, 0x9a, 0x68,
-	0xf1, 0x16, 0xf7, 0x38, 0xe4, 0xca, 0x03, 0x4c, 0x5d, 0x1b, 0x64, 0xa3, 0xc1, 0x4d, 0x93, 0x5b,
-	0xd2, 0x84, 0xb7, 0xf2, 0xb1, 0x85, 0x41, 0x58, 0x9b, 0x3a, 0xdc, 0xb5, 0x1b, 0x54, 0xa2, 0x83,
-	0xb5, 0x87, 0xcf, 0x33, 0x48, 0xaf, 0x79, 0xf6, 0x2b, 0x44, 0x10, 0xf4, 0x00, 0x32, 0x01, 0x40,
-	0xf7, 0xfd, 0xca, 0x6a, 0xb9, 0xc8, 0x5c, 0x7a, 0xbe, 0x58, 0x38, 0xdd, 0xf7, 0x02, 0xf6, 0xf5,
-	0x7c, 0x3a, 0x3c, 0x6e, 0xf7, 0x0a, 0xf2, 0xbf, 0x85, 0x61, 0xbc, 0x0f, 0x84, 0xee, 0x40, 0x32,
-	0x80, 0x65, 0xb5, 0x9c, 0x36, 0x97, 0x9e, 0xff, 0x60, 0xa0, 0x9d, 0x23, 0xaf, 0xbb, 0x0c, 0x95,
-	0xa3, 0xcf, 0x5e, 0x5c, 0x0a, 0xe1, 0x23, 0x02, 0x74, 0x17, 0x46, 0x9d, 0x06, 0x6f, 0x77, 0x3c,
-	0x0f, 0x2b, 0xcf, 0xaf, 0x0f, 0xf3, 0x7c, 0x4b, 0x2a, 0x05, 0x6e, 0x8f, 0x38, 0x5d, 0x3b, 0xf4,
-	0x8d, 0x06, 0x97, 0x98, 0xe5, 0x08, 0xdb, 0x35, 0xa9, 0x25, 0x88, 0x60, 0xdc, 0xd2, 0x0d, 0xb6,
-	0x63, 0x13, 0xfb, 0xe0, 0xc8, 0xca, 0x5f, 0x09, 0x65, 0xe6, 0xd6, 0x30, 0x33, 0xb5, 0x5e, 0x9e,
-	0x55, 0x8f, 0xc6, 0xb7, 0x54, 0x0e, 0x67, 0x35, 0x3c, 0xcd, 0x4e, 0x83, 0xa0, 0x69, 0x00, 0xa7,
-	0xf1, 0x98, 0x9a, 0x44, 0x77, 0x6d, 0x23, 0x1b, 0xc9, 0x69, 0x73, 0x29, 0x9c, 0xf2, 0x24, 0xdb,
-	0xb6, 0x91, 0xff, 0x49, 0x83, 0x91, 0xee, 0x50, 0xd0, 0x06, 0xc4, 0x54, 0x30, 0x7e, 0x66, 0x6f,
-	0x0e, 0x74, 0xd0, 0xef, 0x9e, 0xe3, 0xfe, 0x29, 0x2a, 0x3f, 0xc7, 0x1e, 0x0f, 0xba, 0x0d, 0x89,
-	0xde, 0xd4, 0x5e, 0x1d, 0x16, 0xb3, 0xe7, 0x0a, 0x0e, 0xd4, 0x86, 0x85, 0xf0, 0x8f, 0x06, 0xd3,
-	0xa7, 0xa6, 0x09, 0x09, 0xb8, 0x78, 0x42, 0x3d, 0xfc, 0x28, 0x3f, 0x3e, 0x5f, 0x94, 0x3e, 0xbd,
-	0x1f, 0xe7, 0xe4, 0xe0, 0x02, 0xfc, 0xef, 0x81, 0x7f, 0x11, 0xce, 0x6a, 0xf9, 0x3f, 0x22, 0x10,
-	0xf7, 0xd4, 0x10, 0x82, 0xa8, 0x45, 0x4c, 0xaf, 0x70, 0x29, 0xac, 0xd6, 0x28, 0x07, 0xe9, 0x26,
-	0x75, 0x1a, 0x36, 0x6b, 0x4b, 0xcf, 0xb2, 0x61, 0xf5, 0xa9, 0x5b, 0x24, 0xb5, 0x5c, 0x8b, 0x09,
-	0x9f, 0x5d, 0xad, 0xd1, 0x2d, 0x88, 0xb5, 0x88, 0xdb, 0xa2, 0xd9, 0x98, 0xca, 0xce, 0xfb, 0xc3,
-	0xfc, 0x5e, 0x92, 0xe0, 0xe5, 0x10, 0xf6, 0xb4, 0xd0, 0xa7, 0x10, 0x71, 0x5c, 0x33, 0x9b, 0x50,
-	0xca, 0x57, 0x86, 0x1e, 0x24, 0xd7, 0x5c, 0x0e, 0x61, 0xa9, 0x81, 0x6a, 0x90, 0x7a, 0xcc, 0x1c,
-	0xc1, 0x5b, 0x36, 0x31, 0xb3, 0xa9, 0x53, 0x4e, 0x76, 0x97, 0xfa, 0x72, 0xa0, 0xb0, 0x1c, 0xc2,
-	0x1d, 0x6d, 0xf4, 0x04, 0xde, 0xa5, 0xfb, 0x6d, 0x6e, 0x51, 0x4b, 0x30, 0x62, 0xe8, 0x1d, 0x5a,
-	0x50, 0xb4, 0x1f, 0x0d, 0xa3, 0xad, 0x76, 0x94, 0xbb, 0x2d, 0x4c, 0xd0, 0x01, 0x72, 0xb4, 0x00,
-	0x09, 0xc7, 0x35, 0x4d, 0xd9, 0x4f, 0x69, 0x45, 0x3f, 0x7b, 0x86, 0xa0, 0x25, 0x7c, 0x39, 0x84,
-	0x03, 0xcd, 0x72, 0x1c, 0xa2, 0x4d, 0x22, 0xc8, 0x4a, 0x34, 0x19, 0xcd, 0xc4, 0x56, 0xa2, 0xc9,
-	0x78, 0x26, 0xb1, 0x12, 0x4d, 0x26, 0x33, 0xa9, 0xfc, 0x7d, 0x88, 0xa9, 0x0c, 0xa3, 0x4d, 0x48,
-	0x4b, 0x88, 0xde, 0xe6, 0xcc, 0x12, 0x67, 0x9e, 0xb1, 0xeb, 0xae, 0xb9, 0x43, 0x6d, 0x39, 0xa9,
-	0x37, 0xa5, 0x1e, 0x86, 0x66, 0xb0, 0x74, 0xf2, 0x7f, 0x6b, 0x10, 0xd9, 0x72, 0xcd, 0xd7, 0xcf,
-	0x8c, 0x38, 0x5c, 0x24, 0xad, 0x96, 0x4d, 0x5b, 0xde, 0x79, 0x13, 0xd4, 0x6c, 0x73, 0x9b, 0x18,
-	0x4c, 0x1c, 0xa8, 0x2e, 0x1c, 0x9b, 0xff, 0x64, 0x18, 0x7b, 0xa9, 0xa3, 0x5e, 0xef, 0x68, 0xe3,
-	0x49, 0x32, 0x50, 0x8e, 0x2e, 0xc3, 0x08, 0x73, 0x74, 0x93, 0x5b, 0x5c, 0x70, 0x8b, 0x35, 0x54,
-	0x43, 0x27, 0x71, 0x9a, 0x39, 0x6b, 0x81, 0x28, 0xff, 0xb3, 0x06, 0xa9, 0x4e, 0xd5, 0xb6, 0x06,
-	0xc5, 0x3c, 0x7f, 0xe6, 0x7e, 0x7b, 0x33, 0xc2, 0xce, 0xff, 0xa9, 0xc1, 0xc4, 0xa0, 0x66, 0x45,
-	0x8f, 0x06, 0x85, 0x77, 0xeb, 0xbf, 0xf4, 0xfd, 0x1b, 0x12, 0xe9, 0x43, 0x48, 0xf8, 0xc7, 0x06,
-	0xdd, 0x1d, 0x14, 0xdb, 0x87, 0x67, 0x3c, 0x74, 0x83, 0x4f, 0xc2, 0x61, 0x18, 0xc6, 0xfb, 0xfa,
-	0x19, 0xad, 0x01, 0x10, 0x21, 0x6c, 0xb6, 0xe3, 0x0a, 0xea, 0x64, 0xbd, 0x1b, 0x7b, 0x76, 0xc8,
-	0x55, 0x71, 0x87, 0x1e, 0xdc, 0x23, 0x86, 0x1b, 0x5c, 0x82, 0x5d, 0x04, 0xa8, 0x08, 0x13, 0x8e,
-	0x20, 0xb6, 0xd0, 0x05, 0x33, 0xa9, 0xee, 0x5a, 0x6c, 0x5f, 0xb7, 0x88, 0xc5, 0x55, 0xba, 0xe2,
-	0xf8, 0x82, 0xfa, 0x56, 0x67, 0x26, 0xdd, 0xb6, 0xd8, 0xfe, 0x3a, 0xb1, 0x38, 0x7a, 0x0f, 0xc6,
-	0xfa, 0xa0, 0x11, 0x05, 0x1d, 0x11, 0xdd, 0xa8, 0x69, 0x48, 0x11, 0x47, 0x6f, 0x72, 0x77, 0xc7,
-	0xa0, 0xd9, 0x68, 0x4e, 0x9b, 0xd3, 0x96, 0x43, 0x38, 0x49, 0x9c, 0x8a, 0x92, 0xa0, 0x8b, 0x10,
-	0x27, 0x8e, 0xce, 0x2c, 0x91, 0x8d, 0xe7, 0xb4, 0xb9, 0x8c, 0x1c, 0xd3, 0xc4, 0xa9, 0x59, 0x02,
-	0xad, 0x42, 0x8a, 0xee, 0x53, 0xb3, 0x6d, 0x10, 0xdb, 0xc9, 0xc6, 0x54, 0x70, 0x73, 0xc3, 0xdb,
-	0xc3, 0x53, 0xf0, 0xa3, 0xeb, 0x10, 0xa0, 0x09, 0x88, 0xed, 0x1a, 0xa4, 0xe5, 0x64, 0x93, 0x39,
-	0x6d, 0x6e, 0x14, 0x7b, 0x9b, 0x72, 0x02, 0x62, 0x7b, 0x32, 0x1b, 0x2b, 0xd1, 0xa4, 0x96, 0x09,
-	0xe7, 0x7f, 0x88, 0x00, 0x3a, 0xde, 0x56, 0x7d, 0x79, 0x4e, 0xbd, 0xa1, 0x79, 0x9e, 0x80, 0x58,
-	0x83, 0xbb, 0x96, 0x50, 0x39, 0x8e, 0x63, 0x6f, 0x83, 0x90, 0x77, 0xd9, 0xc5, 0xfc, 0xbc, 0xab,
-	0x7b, 0xec, 0x0a, 0x8c, 0xee, 0xb8, 0x8d, 0x27, 0x54, 0xe8, 0x0a, 0xe3, 0x64, 0xe3, 0xb9, 0x88,
-	0xa4, 0xf3, 0x84, 0x0b, 0x4a, 0x86, 0x66, 0x61, 0x9c, 0xee, 0xb7, 0x0d, 0xd6, 0x60, 0x42, 0xdf,
-	0xe1, 0xae, 0xd5, 0xf4, 0x3a, 0x4c, 0xc3, 0x63, 0x81, 0xb8, 0xac, 0xa4, 0xbd, 0x75, 0x4a, 0xbe,
-	0xb6, 0x3a, 0x41, 0x77, 0x9d, 0xe2, 0x10, 0x75, 0x5c, 0x53, 0xf7, 0xcb, 0xf4, 0x7d, 0x0c, 0xa6,
-	0x4f, 0x1d, 0x04, 0x7d, 0x15, 0xd3, 0xde, 0xaa, 0x8a, 0x65, 0xba, 0x2a, 0xe6, 0xd5, 0x6b, 0x42,
-	0xbe, 0x79, 0x89, 0x41, 0xd5, 0x09, 0xb9, 0x80, 0xbd, 0x8d, 0x7c, 0x7d, 0x7d, 0x4d, 0x6d, 0xee,
-	0xd5, 0x50, 0xbd, 0x66, 0xe2, 0x38, 0x25, 0x25, 0xaa, 0x80, 0xa8, 0x05, 0xc9, 0x36, 0x77, 0x98,
-	0x60, 0x7b, 0x54, 0xf5, 0x7c, 0x7a, 0xbe, 0xfa, 0x4a, 0xc3, 0xb5, 0x50, 0x56, 0xdd, 0xe1, 0x04,
-	0xbf, 0x50, 0x02, 0x72, 0x69, 0xc8, 0x52, 0xe3, 0x70, 0x8f, 0xfa, 0x8f, 0xa2, 0xd7, 0x6b, 0x28,
-	0x20, 0x1f, 0xdc, 0x1a, 0xbd, 0xed, 0x97, 0x7e, 0xc5, 0xf6, 0x9b, 0x5a, 0x84, 0x84, 0x6f, 0x1e,
-	0x4d, 0x42, 0x9c, 0xef, 0xee, 0x3a, 0x54, 0xa8, 0x17, 0xeb, 0x05, 0xec, 0xef, 0x8e, 0x9f, 0x1e,
-	0xf9, 0x7a, 0x8e, 0xf6, 0x9e, 0x9e, 0xfc, 0x8f, 0x11, 0xc8, 0xf4, 0xcf, 0xf3, 0xb7, 0x64, 0x5e,
-	0x9f, 0xb5, 0x2b, 0x19, 0x8c, 0x7f, 0xe5, 0x12, 0x4b, 0x30, 0x83, 0xea, 0x6a, 0x88, 0x7a, 0x73,
-	0x24, 0x3d, 0x7f, 0xfb, 0xbc, 0x17, 0x5d, 0x41, 0x45, 0x58, 0x12, 0x77, 0x7d, 0x3a, 0x3c, 0x16,
-	0x10, 0xab, 0x0f, 0x27, 0x0c, 0xef, 0xa9, 0x05, 0x18, 0xef, 0x53, 0x44, 0x53, 0x90, 0x0c, 0x54,
-	0x55, 0xd5, 0x34, 0x7c, 0xb4, 0x97, 0x24, 0xca, 0x4d, 0x95, 0x1f, 0x0d, 0xf7, 0x0c, 0xfe, 0xa7,
-	0x11, 0x48, 0x06, 0x4d, 0x81, 0x1e, 0xc1, 0x3b, 0xbb, 0xcc, 0x10, 0xd4, 0xa6, 0x4d, 0xfd, 0x55,
-	0xeb, 0x85, 0x02, 0xa6, 0x52, 0xa7, 0x6e, 0xc7, 0xcb, 0x10, 0x1e, 0x76, 0x6d, 0x46, 0xce, 0x7e,
-	0x6d, 0xde, 0x87, 0x84, 0xd3, 0x26, 0x96, 0xce, 0x9a, 0xaa, 0x80, 0x23, 0xe5, 0xdb, 0xd2, 0x91,
-	0x5f, 0x5f, 0x5c, 0xfa, 0xac, 0xc5, 0xfb, 0x7c, 0x67, 0xbc, 0xd8, 0xe0, 0x86, 0x41, 0x1b, 0x82,
-	0xdb, 0xc5, 0xb6, 0x7c, 0x6c, 0x14, 0x99, 0x25, 0xa8, 0x6d, 0x11, 0xa3, 0x28, 0x77, 0x85, 0xad,
-	0x36, 0xb1, 0x6a, 0x15, 0x1c, 0x97, 0x84, 0xb5, 0x26, 0x7a, 0x08, 0x49, 0x61, 0x93, 0x06, 0x95,
-	0xdc, 0x31, 0xc5, 0x5d, 0xf2, 0xb9, 0x3f, 0x3f, 0x3f, 0x77, 0x5d, 0x32, 0xd5, 0x2a, 0x38, 0xa1,
-	0x28, 0x6b, 0xcd, 0xbe, 0xbb, 0xf8, 0xda, 0xb7, 0x1a, 0x4c, 0x0e, 0x7e, 0x81, 0xa1, 0x59, 0xb8,
-	0x52, 0x5a, 0x5a, 0xc2, 0xd5, 0xa5, 0x52, 0xbd, 0xb6, 0xb1, 0xae, 0xd7, 0xab, 0x6b, 0x9b, 0x1b,
-	0xb8, 0xb4, 0x5a, 0xab, 0xdf, 0xd7, 0xb7, 0xd7, 0xb7, 0x36, 0xab, 0x0b, 0xb5, 0xc5, 0x5a, 0xb5,
-	0x92, 0x09, 0xa1, 0xcb, 0x30, 0x7d, 0x12, 0xb0, 0x52, 0x5d, 0xad, 0x97, 0x32, 0x1a, 0xba, 0x0a,
-	0xf9, 0x93, 0x20, 0x0b, 0xdb, 0x6b, 0xdb, 0xab, 0xa5, 0x7a, 0xed, 0x5e, 0x35, 0x13, 0xbe, 0xf6,
-	0x25, 0x8c, 0x1d, 0xf5, 0xeb, 0xa2, 0x1a, 0x3c, 0xa3, 0x90, 0x5a, 0x5c, 0x2d, 0x2d, 0xe9, 0xeb,
-	0x1b, 0xeb, 0xd5, 0x4c, 0x08, 0x4d, 0xc1, 0xa4, 0xbf, 0xd5, 0x71, 0x75, 0x61, 0x03, 0x57, 0xaa,
-	0x15, 0xfd, 0x5e, 0x69, 0x75, 0xbb, 0x9a, 0xd1, 0xca, 0xdf, 0x69, 0xcf, 0x0e, 0x67, 0xb4, 0xe7,
-	0x87, 0x33, 0xda, 0xef, 0x87, 0x33, 0xda, 0xd3, 0x97, 0x33, 0xa1, 0xe7, 0x2f, 0x67, 0x42, 0xbf,
-	0xbc, 0x9c, 0x09, 0xc1, 0x65, 0xc6, 0x87, 0x1c, 0x97, 0xf2, 0x88, 0xff, 0x3f, 0xc1, 0xa6, 0xfc,
-	0xb0, 0xa9, 0x3d, 0xa8, 0x9e, 0x3b, 0xd9, 0xde, 0x5f, 0x66, 0x2d, 0x6a, 0x75, 0xfd, 0x8b, 0xb7,
-	0x13, 0x57, 0xc2, 0x9b, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0xed, 0xa7, 0xdb, 0xbe, 0xee, 0x13,
-	0x00, 0x00,
+	// 1489 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0x5f, 0x6f, 0x1b, 0x37,
+	0x12, 0xd7, 0xea, 0xbf, 0x46, 0xb2, 0xad, 0xf0, 0x7c, 0xce, 0xc2, 0x80, 0x15, 0x45, 0xb9, 0x4b,
+	0x7c, 0x41, 0x20, 0x9d, 0x9d, 0xc3, 0xdd, 0x15, 0x45, 0x80, 0x48, 0x96, 0x6c, 0xcb, 0xf1, 0xbf,
+	0xd0, 0xb2, 0x81, 0x04, 0x41, 0x16, 0xb4, 0x44, 0x2b, 0x44, 0x76, 0xb9, 0xea, 0x2e, 0xd7, 0xb0,
+	0xfb, 0x19, 0xfa, 0x90, 0xcf, 0x51, 0xf4, 0xad, 0x5f, 0xa0, 0x8f, 0x79, 0x4c, 0xdf, 0x82, 0xa2,
+	0x08, 0x5a, 0xe7, 0xa1, 0x2d, 0xfa, 0x25, 0x0a, 0x72, 0x77, 0x2d, 0x59, 0x91, 0x23, 0xa7, 0x49,
+	0x81, 0xe4, 0x69, 0xc9, 0xd9, 0x99, 0x1f, 0x67, 0x38, 0xbf, 0xe1, 0x10, 0x84, 0x5b, 0x76, 0x8f,
+	0x72, 0x41, 0x4d, 0x6a, 0x51, 0xe1, 0x1c, 0x57, 0x7a, 0x8e, 0x2d, 0xec, 0x8a, 0x1c, 0xb3, 0xb6,
+	0x5b, 0x39, 0x5c, 0x08, 0x87, 0x65, 0xf5, 0x03, 0x15, 0xce, 0x68, 0xfb, 0xc2, 0x72, 0xa8, 0x72,
+	0xb8, 0x30, 0x3b, 0xdd, 0xb5, 0xbb, 0xb6, 0x8f, 0x21, 0x47, 0xbe, 0xc2, 0xec, 0xcd, 0x51, 0x6b,
+	0xb4, 0x6d, 0xcb, 0xb2, 0xb9, 0x5c, 0xc2, 0x1f, 0x05, 0xba, 0xe5, 0x51, 0xba, 0x0e, 0x75, 0x6d,
+	0xcf, 0x69, 0x53, 0xa9, 0x1d, 0x8e, 0x7d, 0xfd, 0x12, 0x83, 0xec, 0x86, 0xbf, 0x7e, 0x9d, 0x08,
+	0x82, 0x1e, 0x42, 0x3e, 0x54, 0x30, 0x02, 0xbf, 0x74, 0xad, 0x18, 0x9b, 0xcf, 0x2e, 0x56, 0xca,
+	0x6f, 0xf7, 0xbd, 0x8c, 0x03, 0xbb, 0x00, 0x0e, 0x4f, 0x39, 0x67, 0x05, 0xa5, 0x97, 0x1a, 0x4c,
+	0x0d, 0x29, 0xa1, 0x7b, 0x90, 0x0e, 0xd5, 0x74, 0xad, 0xa8, 0xcd, 0x67, 0x17, 0xff, 0x35, 0x72,
+	0x9d, 0x53, 0xaf, 0x07, 0x16, 0xaa, 0xc5, 0x9f, 0xbf, 0xba, 0x12, 0xc1, 0xa7, 0x00, 0xe8, 0x3e,
+	0x4c, 0xb8, 0x6d, 0xbb, 0xd7, 0xf7, 0x3c, 0xaa, 0x3c, 0xbf, 0x35, 0xce, 0xf3, 0x1d, 0x69, 0x14,
+	0xba, 0x9d, 0x73, 0x07, 0x66, 0x68, 0x0e, 0xc0, 0x6d, 0x3f, 0xa1, 0x16, 0x31, 0x3c, 0xc7, 0xd4,
+	0x63, 0x45, 0x6d, 0x3e, 0x83, 0x33, 0xbe, 0x64, 0xd7, 0x31, 0xd7, 0x92, 0xe9, 0x5f, 0x53, 0xf9,
+	0xdf, 0x52, 0xa5, 0xef, 0x34, 0xc8, 0x0d, 0xa2, 0xa0, 0x2d, 0x48, 0x28, 0x9c, 0x20, 0xa8, 0xdb,
+	0x23, 0x5d, 0x08, 0x12, 0x77, 0xb8, 0x50, 0x6e, 0x72, 0x57, 0x38, 0x9e, 0x45, 0xb9, 0x20, 0x82,
+	0xd9, 0x5c, 0x41, 0x05, 0xe1, 0xf9, 0x38, 0xe8, 0x2e, 0xa4, 0xce, 0x46, 0x75, 0x7d, 0x5c, 0x54,
+	0xbe, 0x2b, 0x38, 0x65, 0x5d, 0x28, 0x94, 0xd2, 0xcf, 0x31, 0x48, 0xfa, 0x26, 0x08, 0x41, 0x9c,
+	0x13, 0xcb, 0xf7, 0x3d, 0x83, 0xd5, 0x18, 0x15, 0x21, 0xdb, 0xa1, 0x6e, 0xdb, 0x61, 0x3d, 0xe9,
+	0xa0, 0x1e, 0x55, 0xbf, 0x06, 0x45, 0xd2, 0xca, 0xe3, 0x4c, 0x04, 0xc8, 0x6a, 0x8c, 0xee, 0x40,
+	0xa2, 0x4b, 0xbc, 0x2e, 0xd5, 0x13, 0x6a, 0x1b, 0xfe, 0x39, 0xce, 0xe7, 0x15, 0xa9, 0xbc, 0x1a,
+	0xc1, 0xbe, 0x15, 0xfa, 0x1f, 0xc4, 0x5c, 0xcf, 0xd2, 0x53, 0xca, 0xf8, 0xda, 0xd8, 0x34, 0x7a,
+	0xd6, 0x6a, 0x04, 0x4b, 0x0b, 0xd4, 0x84, 0xcc, 0x13, 0xe6, 0x0a, 0xbb, 0xeb, 0x10, 0x4b, 0xcf,
+	0xbc, 0x85, 0x57, 0x03, 0xe6, 0xab, 0xa1, 0xc1, 0x6a, 0x04, 0xf7, 0xad, 0xd1, 0x53, 0xf8, 0x3b,
+	0x3d, 0xea, 0xd9, 0x9c, 0x72, 0xc1, 0x88, 0x69, 0xf4, 0x61, 0x41, 0xc1, 0xfe, 0x67, 0x1c, 0x6c,
+	0xa3, 0x6f, 0x3c, 0xb8, 0xc2, 0x34, 0x1d, 0x21, 0x47, 0x4b, 0x90, 0x72, 0x3d, 0xcb, 0x22, 0xce,
+	0xb1, 0x9e, 0x55, 0xf0, 0x37, 0x2e, 0x10, 0xb4, 0x54, 0x5f, 0x8d, 0xe0, 0xd0, 0xb2, 0x96, 0x84,
+	0x78, 0x87, 0x08, 0xb2, 0x16, 0x4f, 0xc7, 0xf3, 0x89, 0xb5, 0x78, 0x3a, 0x99, 0x4f, 0xad, 0xc5,
+	0xd3, 0xe9, 0x7c, 0xa6, 0xf4, 0x00, 0x12, 0x6a, 0x87, 0xd1, 0x36, 0x64, 0xa5, 0x8a, 0xd1, 0xb3,
+	0x19, 0x17, 0x17, 0xae, 0xf0, 0x4d, 0xcf, 0xda, 0xa7, 0x8e, 0x3c, 0x27, 0xb6, 0xa5, 0x1d, 0x86,
+	0x4e, 0x38, 0x74, 0x4b, 0xbf, 0x6b, 0x10, 0xdb, 0xf1, 0xac, 0x0f, 0x8f, 0x8c, 0x6c, 0xb8, 0x4c,
+	0xba, 0x5d, 0x87, 0x76, 0x55, 0x69, 0x18, 0x82, 0x5a, 0x3d, 0xdb, 0x21, 0x26, 0x13, 0xc7, 0x8a,
+	0x85, 0x93, 0x8b, 0xff, 0x1d, 0x87, 0x5e, 0xed, 0x9b, 0xb7, 0xfa, 0xd6, 0x78, 0x86, 0x8c, 0x94,
+	0xa3, 0xab, 0x90, 0x63, 0xae, 0x61, 0xd9, 0xdc, 0x16, 0x36, 0x67, 0x6d, 0x45, 0xe8, 0x34, 0xce,
+	0x32, 0x77, 0x23, 0x14, 0x95, 0xbe, 0xd7, 0x20, 0xd3, 0xcf, 0xda, 0xce, 0xa8, 0x98, 0x17, 0x2f,
+	0xcc, 0xb7, 0x8f, 0x23, 0xec, 0xd2, 0x2f, 0x1a, 0x4c, 0x8f, 0x22, 0x2b, 0x7a, 0x3c, 0x2a, 0xbc,
+	0x3b, 0x7f, 0x86, 0xf7, 0x1f, 0x49, 0xa4, 0x8f, 0x20, 0x15, 0x94, 0x0d, 0xba, 0x3f, 0x2a, 0xb6,
+	0x7f, 0x5f, 0xb0, 0xe8, 0x46, 0x57, 0xc2, 0x49, 0x14, 0xa6, 0x86, 0xf8, 0x8c, 0x36, 0x00, 0x88,
+	0x10, 0x0e, 0xdb, 0xf7, 0x04, 0x75, 0xf5, 0x54, 0x31, 0x76, 0x6e, 0x69, 0xf7, 0x7b, 0xc2, 0x3d,
+	0x7a, 0xbc, 0x47, 0x4c, 0x2f, 0xec, 0x03, 0x03, 0x00, 0xa8, 0x02, 0xd3, 0xae, 0x20, 0x8e, 0x30,
+	0x04, 0xb3, 0xa8, 0xe1, 0x71, 0x76, 0x64, 0x70, 0xc2, 0x6d, 0xb5, 0x5d, 0x49, 0x7c, 0x49, 0xfd,
+	0x6b, 0x31, 0x8b, 0xee, 0x72, 0x76, 0xb4, 0x49, 0xb8, 0x8d, 0xfe, 0x01, 0x93, 0x43, 0xaa, 0x31,
+	0xa5, 0x9a, 0x13, 0x83, 0x5a, 0x73, 0x90, 0x21, 0xae, 0xd1, 0xb1, 0xbd, 0x7d, 0x93, 0xea, 0xf1,
+	0xa2, 0x36, 0xaf, 0xad, 0x46, 0x70, 0x9a, 0xb8, 0x75, 0x25, 0x41, 0x97, 0x21, 0x49, 0x5c, 0x83,
+	0x71, 0xa1, 0x27, 0x8b, 0xda, 0x7c, 0x5e, 0x1e, 0xd3, 0xc4, 0x6d, 0x72, 0x81, 0xd6, 0x21, 0x43,
+	0x8f, 0xa8, 0xd5, 0x33, 0x89, 0xe3, 0xea, 0x09, 0x15, 0xdc, 0xfc, 0x78, 0x7a, 0xf8, 0x06, 0x41,
+	0x74, 0x7d, 0x00, 0x34, 0x0d, 0x89, 0x03, 0x93, 0x74, 0x5d, 0x3d, 0x5d, 0xd4, 0xe6, 0x27, 0xb0,
+	0x3f, 0xa9, 0xa5, 0x20, 0x71, 0x28, 0x77, 0x63, 0x2d, 0x9e, 0xd6, 0xf2, 0xd1, 0xd2, 0x8f, 0x31,
+	0x40, 0x6f, 0xd2, 0x6a, 0x68, 0x9f, 0x33, 0x1f, 0xe9, 0x3e, 0x4f, 0x43, 0xa2, 0x6d, 0x7b, 0x5c,
+	0xa8, 0x3d, 0x4e, 0x62, 0x7f, 0x82, 0x90, 0xdf, 0xec, 0x12, 0xc1, 0xbe, 0xcb, 0x09, 0xba, 0x06,
+	0x13, 0xfb, 0x5e, 0xfb, 0x29, 0x15, 0x86, 0xd2, 0x71, 0xf5, 0x64, 0x31, 0x26, 0xe1, 0x7c, 0xe1,
+	0x92, 0x92, 0xa1, 0x1b, 0x30, 0x45, 0x8f, 0x7a, 0x26, 0x6b, 0x33, 0x61, 0xec, 0xdb, 0x1e, 0xef,
+	0xf8, 0x0c, 0xd3, 0xf0, 0x64, 0x28, 0xae, 0x29, 0xe9, 0xd9, 0x3c, 0xa5, 0x3f, 0x58, 0x9e, 0x60,
+	0x20, 0x4f, 0x32, 0x0a, 0x8b, 0x71, 0xd5, 0xbd, 0xb4, 0x55, 0x0d, 0xcb, 0x89, 0x92, 0x91, 0x23,
+	0x3d, 0xa7, 0x64, 0x51, 0x2c, 0x27, 0xb2, 0x49, 0xb9, 0x9e, 0x65, 0xc8, 0xaf, 0xc5, 0xb8, 0xff,
+	0x25, 0x47, 0x46, 0x90, 0xde, 0x93, 0x04, 0xcc, 0xbd, 0xf5, 0x00, 0x19, 0xca, 0xb4, 0xf6, 0xc9,
+	0x67, 0x7a, 0x5a, 0x5e, 0x18, 0x89, 0x49, 0x55, 0x6d, 0x5d, 0xc2, 0xfe, 0x44, 0xde, 0xd9, 0xbe,
+	0xa4, 0x8e, 0xed, 0x67, 0x5f, 0xdd, 0x83, 0x92, 0x38, 0x23, 0x25, 0x2a, 0xf5, 0xa8, 0x0b, 0xe9,
+	0x9e, 0xed, 0x32, 0xc1, 0x0e, 0xa9, 0xaa, 0x96, 0xec, 0x62, 0xe3, 0xbd, 0x8e, 0xe5, 0x72, 0x4d,
+	0xf1, 0xca, 0x0d, 0x6f, 0xd6, 0x21, 0xb8, 0x5c, 0x88, 0xab, 0x83, 0xf4, 0x90, 0xea, 0x99, 0xbf,
+	0x60, 0xa1, 0x10, 0xfc, 0x1c, 0x52, 0x9d, 0x21, 0x6e, 0xf6, 0x7d, 0x89, 0x1b, 0x50, 0x34, 0x37,
+	0x82, 0xa2, 0x13, 0x03, 0x14, 0x9d, 0x5d, 0x86, 0x54, 0xe0, 0x26, 0x9a, 0x81, 0xa4, 0x7d, 0x70,
+	0xe0, 0x52, 0xa1, 0xee, 0xc4, 0x97, 0x70, 0x30, 0x7b, 0xb3, 0x3e, 0xe5, 0xdd, 0x3c, 0x7e, 0xb6,
+	0x3e, 0xcf, 0xa3, 0x7a, 0xe9, 0x9b, 0x18, 0xe4, 0x87, 0x3b, 0xc9, 0x27, 0xd2, 0x29, 0x46, 0xf3,
+	0x3a, 0x3f, 0xc0, 0x6b, 0x9f, 0xd5, 0x0c, 0xa6, 0xbe, 0xf0, 0x08, 0x17, 0xcc, 0xa4, 0x86, 0x3a,
+	0xbe, 0xfd, 0x13, 0x2c, 0xbb, 0x78, 0xf7, 0x5d, 0x5b, 0x6c, 0x59, 0x45, 0x58, 0x15, 0xf7, 0x03,
+	0x38, 0x3c, 0x19, 0x02, 0xab, 0x1f, 0xe7, 0xb4, 0x8d, 0xd9, 0x25, 0x98, 0x1a, 0x32, 0x44, 0xb3,
+	0x90, 0x0e, 0x4d, 0x55, 0x36, 0x35, 0x7c, 0x3a, 0x97, 0x20, 0xca, 0x4d, 0xb5, 0x3f, 0x1a, 0x3e,
+	0xd3, 0x72, 0x9e, 0xc5, 0x20, 0x1d, 0x92, 0x0a, 0x3d, 0x86, 0xbf, 0x1d, 0x30, 0x53, 0x50, 0x87,
+	0x76, 0x8c, 0xf7, 0xcd, 0x17, 0x0a, 0x91, 0xaa, 0xfd, 0xbc, 0xbd, 0x99, 0x86, 0xe8, 0xb8, 0x86,
+	0x1d, 0xbb, 0x78, 0xc3, 0x7e, 0x00, 0x29, 0xb7, 0x47, 0xb8, 0xc1, 0x3a, 0x2a, 0x81, 0xb9, 0xda,
+	0x5d, 0xe9, 0xc8, 0x0f, 0xaf, 0xae, 0xfc, 0xbf, 0x6b, 0x0f, 0xf9, 0xce, 0xe4, 0x4b, 0x83, 0x69,
+	0xd2, 0xb6, 0xb0, 0x9d, 0x4a, 0x4f, 0x5e, 0x73, 0x2a, 0x8c, 0x0b, 0xea, 0x70, 0x62, 0x56, 0xe4,
+	0xac, 0xbc, 0xd3, 0x23, 0xbc, 0x59, 0xc7, 0x49, 0x09, 0xd8, 0xec, 0xa0, 0x47, 0x90, 0x16, 0x0e,
+	0x69, 0x53, 0x89, 0x9d, 0x50, 0xd8, 0xd5, 0x00, 0xfb, 0xb3, 0x77, 0xc7, 0x6e, 0x49, 0xa4, 0x66,
+	0x1d, 0xa7, 0x14, 0x64, 0xb3, 0x33, 0x74, 0x0b, 0xb8, 0xf9, 0x95, 0x06, 0x33, 0xa3, 0xef, 0x7e,
+	0xe8, 0x06, 0x5c, 0xab, 0xae, 0xac, 0xe0, 0xc6, 0x4a, 0xb5, 0xd5, 0xdc, 0xda, 0x34, 0x5a, 0x8d,
+	0x8d, 0xed, 0x2d, 0x5c, 0x5d, 0x6f, 0xb6, 0x1e, 0x18, 0xbb, 0x9b, 0x3b, 0xdb, 0x8d, 0xa5, 0xe6,
+	0x72, 0xb3, 0x51, 0xcf, 0x47, 0xd0, 0x55, 0x98, 0x3b, 0x4f, 0xb1, 0xde, 0x58, 0x6f, 0x55, 0xf3,
+	0x1a, 0xba, 0x0e, 0xa5, 0xf3, 0x54, 0x96, 0x76, 0x37, 0x76, 0xd7, 0xab, 0xad, 0xe6, 0x5e, 0x23,
+	0x1f, 0xbd, 0xf9, 0x39, 0x4c, 0x9e, 0xf2, 0x75, 0x59, 0x1d, 0x5c, 0x13, 0x90, 0x59, 0x5e, 0xaf,
+	0xae, 0x18, 0x9b, 0x5b, 0x9b, 0x8d, 0x7c, 0x04, 0xcd, 0xc2, 0x4c, 0x30, 0x35, 0x70, 0x63, 0x69,
+	0x0b, 0xd7, 0x1b, 0x75, 0x63, 0xaf, 0xba, 0xbe, 0xdb, 0xc8, 0x6b, 0xb5, 0x6f, 0xb5, 0xe7, 0x27,
+	0x05, 0xed, 0xc5, 0x49, 0x41, 0xfb, 0xe9, 0xa4, 0xa0, 0x3d, 0x7b, 0x5d, 0x88, 0xbc, 0x78, 0x5d,
+	0x88, 0xbc, 0x7c, 0x5d, 0x88, 0xc0, 0x55, 0x66, 0x8f, 0x29, 0x97, 0x5a, 0x2e, 0x78, 0x78, 0xd8,
+	0x96, 0x3f, 0xb6, 0xb5, 0x87, 0x8d, 0x77, 0xde, 0x6c, 0xff, 0xa9, 0xa8, 0x4b, 0xf9, 0xc0, 0xeb,
+	0xd5, 0xd7, 0xd1, 0xc2, 0x56, 0x8f, 0xf2, 0xd6, 0x29, 0x88, 0x82, 0x0f, 0x5e, 0x16, 0xdc, 0xf2,
+	0xde, 0xc2, 0x7e, 0x52, 0x59, 0xdd, 0xfe, 0x63, 0x00, 0xd6, 0x26, 0xf7, 0xb5, 0x07, 0x13, 0x00,
+	0x00,
 }
 
 func (m *MetricsData) Marshal() (dAtA []byte, err error) {
@@ -2054,22 +2087,6 @@ func (m *ResourceMetrics) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if len(m.InstrumentationLibraryMetrics) > 0 {
-		for iNdEx := len(m.InstrumentationLibraryMetrics) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.InstrumentationLibraryMetrics[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintMetrics(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x3e
-			i--
-			dAtA[i] = 0xc2
-		}
-	}
 	if len(m.SchemaUrl) > 0 {
 		i -= len(m.SchemaUrl)
 		copy(dAtA[i:], m.SchemaUrl)
@@ -2158,60 +2175,6 @@ func (m *ScopeMetrics) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *InstrumentationLibraryMetrics) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *InstrumentationLibraryMetrics) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *InstrumentationLibraryMetrics) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SchemaUrl) > 0 {
-		i -= len(m.SchemaUrl)
-		copy(dAtA[i:], m.SchemaUrl)
-		i = encodeVarintMetrics(dAtA, i, uint64(len(m.SchemaUrl)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Metrics) > 0 {
-		for iNdEx := len(m.Metrics) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Metrics[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintMetrics(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	{
-		size, err := m.InstrumentationLibrary.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintMetrics(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
 func (m *Metric) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2703,6 +2666,24 @@ func (m *HistogramDataPoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Max_ != nil {
+		{
+			size := m.Max_.Size()
+			i -= size
+			if _, err := m.Max_.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if m.Min_ != nil {
+		{
+			size := m.Min_.Size()
+			i -= size
+			if _, err := m.Min_.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
 	if m.Flags != 0 {
 		i = encodeVarintMetrics(dAtA, i, uint64(m.Flags))
 		i--
@@ -2738,9 +2719,9 @@ func (m *HistogramDataPoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	if len(m.ExplicitBounds) > 0 {
 		for iNdEx := len(m.ExplicitBounds) - 1; iNdEx >= 0; iNdEx-- {
-			f9 := math.Float64bits(float64(m.ExplicitBounds[iNdEx]))
+			f8 := math.Float64bits(float64(m.ExplicitBounds[iNdEx]))
 			i -= 8
-			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(f9))
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(f8))
 		}
 		i = encodeVarintMetrics(dAtA, i, uint64(len(m.ExplicitBounds)*8))
 		i--
@@ -2798,6 +2779,32 @@ func (m *HistogramDataPoint_Sum) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	dAtA[i] = 0x29
 	return len(dAtA) - i, nil
 }
+func (m *HistogramDataPoint_Min) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistogramDataPoint_Min) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= 8
+	encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Min))))
+	i--
+	dAtA[i] = 0x59
+	return len(dAtA) - i, nil
+}
+func (m *HistogramDataPoint_Max) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistogramDataPoint_Max) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= 8
+	encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Max))))
+	i--
+	dAtA[i] = 0x61
+	return len(dAtA) - i, nil
+}
 func (m *ExponentialHistogramDataPoint) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2818,6 +2825,24 @@ func (m *ExponentialHistogramDataPoint) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if m.Max_ != nil {
+		{
+			size := m.Max_.Size()
+			i -= size
+			if _, err := m.Max_.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if m.Min_ != nil {
+		{
+			size := m.Min_.Size()
+			i -= size
+			if _, err := m.Min_.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
 	if len(m.Exemplars) > 0 {
 		for iNdEx := len(m.Exemplars) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -2868,11 +2893,14 @@ func (m *ExponentialHistogramDataPoint) MarshalToSizedBuffer(dAtA []byte) (int,
 		i--
 		dAtA[i] = 0x30
 	}
-	if m.Sum != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Sum))))
-		i--
-		dAtA[i] = 0x29
+	if m.Sum_ != nil {
+		{
+			size := m.Sum_.Size()
+			i -= size
+			if _, err := m.Sum_.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
 	}
 	if m.Count != 0 {
 		i -= 8
@@ -2909,6 +2937,45 @@ func (m *ExponentialHistogramDataPoint) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *ExponentialHistogramDataPoint_Sum) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExponentialHistogramDataPoint_Sum) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= 8
+	encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Sum))))
+	i--
+	dAtA[i] = 0x29
+	return len(dAtA) - i, nil
+}
+func (m *ExponentialHistogramDataPoint_Min) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExponentialHistogramDataPoint_Min) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= 8
+	encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Min))))
+	i--
+	dAtA[i] = 0x61
+	return len(dAtA) - i, nil
+}
+func (m *ExponentialHistogramDataPoint_Max) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExponentialHistogramDataPoint_Max) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= 8
+	encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Max))))
+	i--
+	dAtA[i] = 0x69
+	return len(dAtA) - i, nil
+}
 func (m *ExponentialHistogramDataPoint_Buckets) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2930,20 +2997,20 @@ func (m *ExponentialHistogramDataPoint_Buckets) MarshalToSizedBuffer(dAtA []byte
 	var l int
 	_ = l
 	if len(m.BucketCounts) > 0 {
-		dAtA13 := make([]byte, len(m.BucketCounts)*10)
-		var j12 int
+		dAtA12 := make([]byte, len(m.BucketCounts)*10)
+		var j11 int
 		for _, num := range m.BucketCounts {
 			for num >= 1<<7 {
-				dAtA13[j12] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA12[j11] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j12++
+				j11++
 			}
-			dAtA13[j12] = uint8(num)
-			j12++
+			dAtA12[j11] = uint8(num)
+			j11++
 		}
-		i -= j12
-		copy(dAtA[i:], dAtA13[:j12])
-		i = encodeVarintMetrics(dAtA, i, uint64(j12))
+		i -= j11
+		copy(dAtA[i:], dAtA12[:j11])
+		i = encodeVarintMetrics(dAtA, i, uint64(j11))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -3212,12 +3279,6 @@ func (m *ResourceMetrics) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMetrics(uint64(l))
 	}
-	if len(m.InstrumentationLibraryMetrics) > 0 {
-		for _, e := range m.InstrumentationLibraryMetrics {
-			l = e.Size()
-			n += 2 + l + sovMetrics(uint64(l))
-		}
-	}
 	return n
 }
 
@@ -3242,27 +3303,6 @@ func (m *ScopeMetrics) Size() (n int) {
 	return n
 }
 
-func (m *InstrumentationLibraryMetrics) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.InstrumentationLibrary.Size()
-	n += 1 + l + sovMetrics(uint64(l))
-	if len(m.Metrics) > 0 {
-		for _, e := range m.Metrics {
-			l = e.Size()
-			n += 1 + l + sovMetrics(uint64(l))
-		}
-	}
-	l = len(m.SchemaUrl)
-	if l > 0 {
-		n += 1 + l + sovMetrics(uint64(l))
-	}
-	return n
-}
-
 func (m *Metric) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3524,6 +3564,12 @@ func (m *HistogramDataPoint) Size() (n int) {
 	if m.Flags != 0 {
 		n += 1 + sovMetrics(uint64(m.Flags))
 	}
+	if m.Min_ != nil {
+		n += m.Min_.Size()
+	}
+	if m.Max_ != nil {
+		n += m.Max_.Size()
+	}
 	return n
 }
 
@@ -3536,6 +3582,24 @@ func (m *HistogramDataPoint_Sum) Size() (n int) {
 	n += 9
 	return n
 }
+func (m *HistogramDataPoint_Min) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 9
+	return n
+}
+func (m *HistogramDataPoint_Max) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 9
+	return n
+}
 func (m *ExponentialHistogramDataPoint) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3557,8 +3621,8 @@ func (m *ExponentialHistogramDataPoint) Size() (n int) {
 	if m.Count != 0 {
 		n += 9
 	}
-	if m.Sum != 0 {
-		n += 9
+	if m.Sum_ != nil {
+		n += m.Sum_.Size()
 	}
 	if m.Scale != 0 {
 		n += 1 + sozMetrics(uint64(m.Scale))
@@ -3579,9 +3643,42 @@ func (m *ExponentialHistogramDataPoint) Size() (n int) {
 			n += 1 + l + sovMetrics(uint64(l))
 		}
 	}
+	if m.Min_ != nil {
+		n += m.Min_.Size()
+	}
+	if m.Max_ != nil {
+		n += m.Max_.Size()
+	}
 	return n
 }
 
+func (m *ExponentialHistogramDataPoint_Sum) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 9
+	return n
+}
+func (m *ExponentialHistogramDataPoint_Min) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 9
+	return n
+}
+func (m *ExponentialHistogramDataPoint_Max) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 9
+	return n
+}
 func (m *ExponentialHistogramDataPoint_Buckets) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3914,40 +4011,6 @@ func (m *ResourceMetrics) Unmarshal(dAtA []byte) error {
 			}
 			m.SchemaUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 1000:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InstrumentationLibraryMetrics", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMetrics
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthMetrics
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMetrics
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.InstrumentationLibraryMetrics = append(m.InstrumentationLibraryMetrics, &InstrumentationLibraryMetrics{})
-			if err := m.InstrumentationLibraryMetrics[len(m.InstrumentationLibraryMetrics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetrics(dAtA[iNdEx:])
@@ -4118,155 +4181,6 @@ func (m *ScopeMetrics) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InstrumentationLibraryMetrics) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMetrics
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: InstrumentationLibraryMetrics: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InstrumentationLibraryMetrics: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InstrumentationLibrary", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMetrics
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthMetrics
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMetrics
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.InstrumentationLibrary.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metrics", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMetrics
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthMetrics
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMetrics
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Metrics = append(m.Metrics, &Metric{})
-			if err := m.Metrics[len(m.Metrics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SchemaUrl", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMetrics
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMetrics
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMetrics
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SchemaUrl = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMetrics(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMetrics
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
 func (m *Metric) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -5527,6 +5441,28 @@ func (m *HistogramDataPoint) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 11:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Min", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Min_ = &HistogramDataPoint_Min{float64(math.Float64frombits(v))}
+		case 12:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Max_ = &HistogramDataPoint_Max{float64(math.Float64frombits(v))}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetrics(dAtA[iNdEx:])
@@ -5651,7 +5587,7 @@ func (m *ExponentialHistogramDataPoint) Unmarshal(dAtA []byte) error {
 			}
 			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
 			iNdEx += 8
-			m.Sum = float64(math.Float64frombits(v))
+			m.Sum_ = &ExponentialHistogramDataPoint_Sum{float64(math.Float64frombits(v))}
 		case 6:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Scale", wireType)
@@ -5802,6 +5738,28 @@ func (m *ExponentialHistogramDataPoint) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Min", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Min_ = &ExponentialHistogramDataPoint_Min{float64(math.Float64frombits(v))}
+		case 13:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Max_ = &ExponentialHistogramDataPoint_Max{float64(math.Float64frombits(v))}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetrics(dAtA[iNdEx:])
@@ -79,7 +79,7 @@ func (x Span_SpanKind) String() string {
 }
 
 func (Span_SpanKind) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_5c407ac9c675a601, []int{4, 0}
+	return fileDescriptor_5c407ac9c675a601, []int{3, 0}
 }
 
 // For the semantics of status codes see
@@ -113,7 +113,7 @@ func (x Status_StatusCode) String() string {
 }
 
 func (Status_StatusCode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_5c407ac9c675a601, []int{5, 0}
+	return fileDescriptor_5c407ac9c675a601, []int{4, 0}
 }
 
 // TracesData represents the traces data that can be stored in a persistent storage,
@@ -182,34 +182,6 @@ type ResourceSpans struct {
 	Resource v1.Resource `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource"`
 	// A list of ScopeSpans that originate from a resource.
 	ScopeSpans []*ScopeSpans `protobuf:"bytes,2,rep,name=scope_spans,json=scopeSpans,proto3" json:"scope_spans,omitempty"`
-	// A list of InstrumentationLibrarySpans that originate from a resource.
-	// This field is deprecated and will be removed after grace period expires on June 15, 2022.
-	//
-	// During the grace period the following rules SHOULD be followed:
-	//
-	// For Binary Protobufs
-	// ====================
-	// Binary Protobuf senders SHOULD NOT set instrumentation_library_spans. Instead
-	// scope_spans SHOULD be set.
-	//
-	// Binary Protobuf receivers SHOULD check if instrumentation_library_spans is set
-	// and scope_spans is not set then the value in instrumentation_library_spans
-	// SHOULD be used instead by converting InstrumentationLibrarySpans into ScopeSpans.
-	// If scope_spans is set then instrumentation_library_spans SHOULD be ignored.
-	//
-	// For JSON
-	// ========
-	// JSON senders that set instrumentation_library_spans field MAY also set
-	// scope_spans to carry the same spans, essentially double-publishing the same data.
-	// Such double-publishing MAY be controlled by a user-settable option.
-	// If double-publishing is not used then the senders SHOULD set scope_spans and
-	// SHOULD NOT set instrumentation_library_spans.
-	//
-	// JSON receivers SHOULD check if instrumentation_library_spans is set and
-	// scope_spans is not set then the value in instrumentation_library_spans
-	// SHOULD be used instead by converting InstrumentationLibrarySpans into ScopeSpans.
-	// If scope_spans is set then instrumentation_library_spans field SHOULD be ignored.
-	InstrumentationLibrarySpans []*InstrumentationLibrarySpans `protobuf:"bytes,1000,rep,name=instrumentation_library_spans,json=instrumentationLibrarySpans,proto3" json:"instrumentation_library_spans,omitempty"` // Deprecated: Do not use.
 	// This schema_url applies to the data in the "resource" field. It does not apply
 	// to the data in the "scope_spans" field which have their own schema_url field.
 	SchemaUrl string `protobuf:"bytes,3,opt,name=schema_url,json=schemaUrl,proto3" json:"schema_url,omitempty"`
@@ -262,14 +234,6 @@ func (m *ResourceSpans) GetScopeSpans() []*ScopeSpans {
 	return nil
 }
 
-// Deprecated: Do not use.
-func (m *ResourceSpans) GetInstrumentationLibrarySpans() []*InstrumentationLibrarySpans {
-	if m != nil {
-		return m.InstrumentationLibrarySpans
-	}
-	return nil
-}
-
 func (m *ResourceSpans) GetSchemaUrl() string {
 	if m != nil {
 		return m.SchemaUrl
@@ -343,84 +307,7 @@ func (m *ScopeSpans) GetSchemaUrl() string {
 	return ""
 }
 
-// A collection of Spans produced by an InstrumentationLibrary.
-// InstrumentationLibrarySpans is wire-compatible with ScopeSpans for binary
-// Protobuf format.
-// This message is deprecated and will be removed on June 15, 2022.
-//
-// Deprecated: Do not use.
-type InstrumentationLibrarySpans struct {
-	// The instrumentation library information for the spans in this message.
-	// Semantically when InstrumentationLibrary isn't set, it is equivalent with
-	// an empty instrumentation library name (unknown).
-	InstrumentationLibrary v11.InstrumentationLibrary `protobuf:"bytes,1,opt,name=instrumentation_library,json=instrumentationLibrary,proto3" json:"instrumentation_library"`
-	// A list of Spans that originate from an instrumentation library.
-	Spans []*Span `protobuf:"bytes,2,rep,name=spans,proto3" json:"spans,omitempty"`
-	// This schema_url applies to all spans and span events in the "spans" field.
-	SchemaUrl string `protobuf:"bytes,3,opt,name=schema_url,json=schemaUrl,proto3" json:"schema_url,omitempty"`
-}
-
-func (m *InstrumentationLibrarySpans) Reset()         { *m = InstrumentationLibrarySpans{} }
-func (m *InstrumentationLibrarySpans) String() string { return proto.CompactTextString(m) }
-func (*InstrumentationLibrarySpans) ProtoMessage()    {}
-func (*InstrumentationLibrarySpans) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c407ac9c675a601, []int{3}
-}
-func (m *InstrumentationLibrarySpans) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *InstrumentationLibrarySpans) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_InstrumentationLibrarySpans.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
-	}
-}
-func (m *InstrumentationLibrarySpans) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_InstrumentationLibrarySpans.Merge(m, src)
-}
-func (m *InstrumentationLibrarySpans) XXX_Size() int {
-	return m.Size()
-}
-func (m *InstrumentationLibrarySpans) XXX_DiscardUnknown() {
-	xxx_messageInfo_InstrumentationLibrarySpans.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_InstrumentationLibrarySpans proto.InternalMessageInfo
-
-func (m *InstrumentationLibrarySpans) GetInstrumentationLibrary() v11.InstrumentationLibrary {
-	if m != nil {
-		return m.InstrumentationLibrary
-	}
-	return v11.InstrumentationLibrary{}
-}
-
-func (m *InstrumentationLibrarySpans) GetSpans() []*Span {
-	if m != nil {
-		return m.Spans
-	}
-	return nil
-}
-
-func (m *InstrumentationLibrarySpans) GetSchemaUrl() string {
-	if m != nil {
-		return m.SchemaUrl
-	}
-	return ""
-}
-
-// Span represents a single operation within a trace. Spans can be
-// nested to form a trace tree. Spans may also be linked to other spans
-// from the same or different trace and form graphs. Often, a trace
-// contains a root span that describes the end-to-end latency, and one
-// or more subspans for its sub-operations. A trace can also contain
-// multiple root spans, or none at all. Spans do not need to be
-// contiguous - there may be gaps or overlaps between spans in a trace.
+// A Span represents a single operation performed by a single component of the system.
 //
 // The next available field id is 17.
 type Span struct {
@@ -488,7 +375,7 @@ type Span struct {
 	//     "abc.com/score": 10.239
 	//
 	// The OpenTelemetry API specification further restricts the allowed value types:
-	// https://github.com/open-telemetry/opentelemetry-specification/blob/main/specification/common/common.md#attributes
+	// https://github.com/open-telemetry/opentelemetry-specification/blob/main/specification/common/README.md#attribute
 	// Attribute keys MUST be unique (it is not allowed to have more than one
 	// attribute with the same key).
 	Attributes []v11.KeyValue `protobuf:"bytes,9,rep,name=attributes,proto3" json:"attributes"`
@@ -516,7 +403,7 @@ func (m *Span) Reset()         { *m = Span{} }
 func (m *Span) String() string { return proto.CompactTextString(m) }
 func (*Span) ProtoMessage()    {}
 func (*Span) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c407ac9c675a601, []int{4}
+	return fileDescriptor_5c407ac9c675a601, []int{3}
 }
 func (m *Span) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -650,7 +537,7 @@ func (m *Span_Event) Reset()         { *m = Span_Event{} }
 func (m *Span_Event) String() string { return proto.CompactTextString(m) }
 func (*Span_Event) ProtoMessage()    {}
 func (*Span_Event) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c407ac9c675a601, []int{4, 0}
+	return fileDescriptor_5c407ac9c675a601, []int{3, 0}
 }
 func (m *Span_Event) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -732,7 +619,7 @@ func (m *Span_Link) Reset()         { *m = Span_Link{} }
 func (m *Span_Link) String() string { return proto.CompactTextString(m) }
 func (*Span_Link) ProtoMessage()    {}
 func (*Span_Link) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c407ac9c675a601, []int{4, 1}
+	return fileDescriptor_5c407ac9c675a601, []int{3, 1}
 }
 func (m *Span_Link) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -795,7 +682,7 @@ func (m *Status) Reset()         { *m = Status{} }
 func (m *Status) String() string { return proto.CompactTextString(m) }
 func (*Status) ProtoMessage()    {}
 func (*Status) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c407ac9c675a601, []int{5}
+	return fileDescriptor_5c407ac9c675a601, []int{4}
 }
 func (m *Status) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -844,7 +731,6 @@ func init() {
 	proto.RegisterType((*TracesData)(nil), "opentelemetry.proto.trace.v1.TracesData")
 	proto.RegisterType((*ResourceSpans)(nil), "opentelemetry.proto.trace.v1.ResourceSpans")
 	proto.RegisterType((*ScopeSpans)(nil), "opentelemetry.proto.trace.v1.ScopeSpans")
-	proto.RegisterType((*InstrumentationLibrarySpans)(nil), "opentelemetry.proto.trace.v1.InstrumentationLibrarySpans")
 	proto.RegisterType((*Span)(nil), "opentelemetry.proto.trace.v1.Span")
 	proto.RegisterType((*Span_Event)(nil), "opentelemetry.proto.trace.v1.Span.Event")
 	proto.RegisterType((*Span_Link)(nil), "opentelemetry.proto.trace.v1.Span.Link")
@@ -856,72 +742,69 @@ func init() {
 }
 
 var fileDescriptor_5c407ac9c675a601 = []byte{
-	// 1040 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0x38, 0x6b, 0x27, 0x79, 0x49, 0xdc, 0xcd, 0x90, 0x86, 0x25, 0xa5, 0x8e, 0x65, 0x55,
-	0xc2, 0x50, 0xc9, 0xa6, 0xa9, 0x90, 0x52, 0x24, 0x44, 0x63, 0x7b, 0x91, 0x96, 0xa4, 0x76, 0x34,
-	0xb6, 0x2b, 0x81, 0x90, 0x96, 0x8d, 0x77, 0x08, 0xab, 0xd8, 0xb3, 0xd6, 0xee, 0x38, 0x6a, 0x6e,
-	0x88, 0x33, 0x07, 0xae, 0xfc, 0x23, 0xdc, 0xb8, 0x57, 0x70, 0xe9, 0x11, 0x71, 0xa8, 0x50, 0x72,
-	0x81, 0x1b, 0x7f, 0x02, 0x9a, 0x1f, 0x9b, 0x78, 0x43, 0x6a, 0x37, 0x12, 0xb9, 0xf4, 0x62, 0xcf,
-	0xbe, 0x1f, 0xdf, 0xfb, 0xde, 0x37, 0x6f, 0x46, 0x03, 0x95, 0x70, 0x44, 0x19, 0xa7, 0x03, 0x3a,
-	0xa4, 0x3c, 0x3a, 0xa9, 0x8d, 0xa2, 0x90, 0x87, 0x35, 0x1e, 0x79, 0x7d, 0x5a, 0x3b, 0x7e, 0xa0,
-	0x16, 0x55, 0x69, 0xc4, 0xef, 0xa6, 0x22, 0x95, 0xb1, 0xaa, 0x02, 0x8e, 0x1f, 0x6c, 0xac, 0x1d,
-	0x86, 0x87, 0xa1, 0xca, 0x16, 0x2b, 0xe5, 0xde, 0xf8, 0xe0, 0x2a, 0xf4, 0x7e, 0x38, 0x1c, 0x86,
-	0x4c, 0xc0, 0xab, 0x95, 0x8e, 0xad, 0x5e, 0x15, 0x1b, 0xd1, 0x38, 0x1c, 0x47, 0x8a, 0x4c, 0xb2,
-	0x56, 0xf1, 0xe5, 0xaf, 0x01, 0xba, 0xa2, 0x7a, 0xdc, 0xf4, 0xb8, 0x87, 0x09, 0x14, 0x12, 0xbf,
-	0x1b, 0x8f, 0x3c, 0x16, 0x5b, 0xa8, 0x34, 0x57, 0x59, 0xda, 0xba, 0x5f, 0x9d, 0x46, 0xbb, 0x4a,
-	0x74, 0x4e, 0x47, 0xa4, 0x90, 0x95, 0x68, 0xf2, 0xb3, 0xfc, 0x5b, 0x16, 0x56, 0x52, 0x01, 0x78,
-	0x17, 0x16, 0x92, 0x10, 0x0b, 0x95, 0x50, 0x65, 0x69, 0xeb, 0xfd, 0x2b, 0xf1, 0xcf, 0xa9, 0x4e,
-	0x94, 0xa8, 0x1b, 0xcf, 0x5f, 0x6e, 0x66, 0xc8, 0x39, 0x00, 0x76, 0x60, 0x29, 0xee, 0x87, 0xa3,
-	0x84, 0x6f, 0x56, 0xf2, 0xad, 0x4c, 0xe7, 0xdb, 0x11, 0x09, 0x8a, 0x2c, 0xc4, 0xe7, 0x6b, 0xfc,
-	0x3d, 0x82, 0xbb, 0x01, 0x8b, 0x79, 0x34, 0x1e, 0x52, 0xc6, 0x3d, 0x1e, 0x84, 0xcc, 0x1d, 0x04,
-	0x07, 0x91, 0x17, 0x9d, 0x68, 0xf4, 0xbf, 0xe6, 0x25, 0xfc, 0xa3, 0xe9, 0xf0, 0x4e, 0x1a, 0x63,
-	0x4f, 0x41, 0xc8, 0x1a, 0xf5, 0xac, 0x85, 0xc8, 0x9d, 0xe0, 0xd5, 0x01, 0xf8, 0x2e, 0x40, 0xdc,
-	0xff, 0x96, 0x0e, 0x3d, 0x77, 0x1c, 0x0d, 0xac, 0xb9, 0x12, 0xaa, 0x2c, 0x92, 0x45, 0x65, 0xe9,
-	0x45, 0x83, 0xf2, 0xcf, 0x08, 0xe0, 0x82, 0x3e, 0x6e, 0x43, 0x4e, 0x36, 0xa0, 0x75, 0x7c, 0x78,
-	0x25, 0x31, 0x3d, 0x20, 0xff, 0x65, 0x26, 0x81, 0xb4, 0xa2, 0x0a, 0x07, 0x6f, 0x43, 0x6e, 0x52,
-	0xc8, 0xf2, 0x0c, 0x21, 0x47, 0x1e, 0x23, 0x2a, 0x61, 0x16, 0xf1, 0x7f, 0x10, 0xdc, 0x99, 0x22,
-	0x0c, 0xe6, 0xf0, 0xf6, 0x2b, 0xb4, 0xd7, 0xbd, 0x7d, 0x74, 0xbd, 0xde, 0x34, 0xb8, 0xee, 0x6e,
-	0xfd, 0x6a, 0xc9, 0x6f, 0xac, 0xdd, 0x8f, 0xb3, 0x16, 0x2a, 0x7f, 0xb7, 0x02, 0x86, 0x48, 0xc1,
-	0x5f, 0xc1, 0x82, 0xc4, 0x70, 0x03, 0x5f, 0x36, 0xb3, 0x5c, 0xdf, 0x11, 0xac, 0xfe, 0x78, 0xb9,
-	0xf9, 0xe8, 0x30, 0xbc, 0x54, 0x32, 0x10, 0x47, 0x7b, 0x30, 0xa0, 0x7d, 0x1e, 0x46, 0xb5, 0x91,
-	0xef, 0x71, 0xaf, 0x16, 0x30, 0x4e, 0x23, 0xe6, 0x0d, 0x6a, 0xe2, 0xab, 0x2a, 0x8f, 0xab, 0xd3,
-	0x24, 0xf3, 0x12, 0xd2, 0xf1, 0xf1, 0x17, 0x30, 0x2f, 0x28, 0x09, 0xf0, 0xac, 0x04, 0x7f, 0xac,
-	0xc1, 0xb7, 0xaf, 0x0f, 0x2e, 0xe8, 0x3a, 0x4d, 0x92, 0x17, 0x80, 0x8e, 0x8f, 0x37, 0x61, 0x49,
-	0x11, 0x8f, 0xb9, 0xc7, 0xa9, 0xee, 0x12, 0xa4, 0xa9, 0x23, 0x2c, 0xf8, 0x1b, 0x28, 0x8c, 0xbc,
-	0x88, 0x32, 0xee, 0x26, 0x14, 0x8c, 0xff, 0x89, 0xc2, 0xb2, 0xc2, 0xed, 0x28, 0x22, 0x18, 0x0c,
-	0xe6, 0x0d, 0xa9, 0x95, 0x93, 0x0c, 0xe4, 0x1a, 0x7f, 0x0a, 0xc6, 0x51, 0xc0, 0x7c, 0x2b, 0x5f,
-	0x42, 0x95, 0xc2, 0xac, 0x2b, 0x4a, 0xe0, 0xc8, 0x9f, 0xdd, 0x80, 0xf9, 0x44, 0x26, 0xe2, 0x1a,
-	0xac, 0xc5, 0xdc, 0x8b, 0xb8, 0xcb, 0x83, 0x21, 0x75, 0xc7, 0x2c, 0x78, 0xe6, 0x32, 0x8f, 0x85,
-	0xd6, 0x7c, 0x09, 0x55, 0xf2, 0x64, 0x55, 0xfa, 0xba, 0xc1, 0x90, 0xf6, 0x58, 0xf0, 0xac, 0xe5,
-	0xb1, 0x10, 0xdf, 0x07, 0x4c, 0x99, 0x7f, 0x39, 0x7c, 0x41, 0x86, 0xdf, 0xa2, 0xcc, 0x4f, 0x05,
-	0x3f, 0x01, 0xf0, 0x38, 0x8f, 0x82, 0x83, 0x31, 0xa7, 0xb1, 0xb5, 0x28, 0xe7, 0xeb, 0xbd, 0x19,
-	0x33, 0xbc, 0x4b, 0x4f, 0x9e, 0x7a, 0x83, 0x71, 0x72, 0x26, 0x27, 0x00, 0xf0, 0x36, 0x58, 0x7e,
-	0x14, 0x8e, 0x46, 0xd4, 0x77, 0x2f, 0xac, 0x6e, 0x3f, 0x1c, 0x33, 0x6e, 0x41, 0x09, 0x55, 0x56,
-	0xc8, 0xba, 0xf6, 0xef, 0x9c, 0xbb, 0x1b, 0xc2, 0x8b, 0x1f, 0x43, 0x9e, 0x1e, 0x53, 0xc6, 0x63,
-	0x6b, 0xe9, 0xb5, 0x2e, 0x47, 0xa1, 0x94, 0x2d, 0x12, 0x88, 0xce, 0xc3, 0x1f, 0xc2, 0x5a, 0x52,
-	0x5b, 0x59, 0x74, 0xdd, 0x65, 0x59, 0x17, 0x6b, 0x9f, 0xcc, 0xd1, 0x35, 0x3f, 0x81, 0xdc, 0x20,
-	0x60, 0x47, 0xb1, 0xb5, 0x32, 0xa5, 0xef, 0x74, 0xc9, 0xbd, 0x80, 0x1d, 0x11, 0x95, 0x85, 0xab,
-	0xf0, 0x56, 0x52, 0x50, 0x1a, 0x74, 0xbd, 0x82, 0xac, 0xb7, 0xaa, 0x5d, 0x22, 0x41, 0x97, 0xab,
-	0x43, 0x5e, 0x4c, 0xe8, 0x38, 0xb6, 0x6e, 0xc9, 0xbb, 0xe2, 0xde, 0x8c, 0x7a, 0x32, 0x56, 0x8b,
-	0xac, 0x33, 0x37, 0x7e, 0x45, 0x90, 0x93, 0x2d, 0xe0, 0x7b, 0x50, 0xb8, 0xb4, 0xc5, 0x48, 0x6e,
-	0xf1, 0x32, 0x9f, 0xdc, 0xdf, 0x64, 0x24, 0xb3, 0x13, 0x23, 0x99, 0xde, 0xf3, 0xb9, 0x9b, 0xdc,
-	0x73, 0x63, 0xda, 0x9e, 0x6f, 0xfc, 0x9d, 0x05, 0x43, 0xe8, 0xf3, 0x06, 0x5f, 0x3d, 0x69, 0xad,
-	0x8d, 0x9b, 0xd4, 0x3a, 0x37, 0x4d, 0xeb, 0xf2, 0x4f, 0x08, 0x16, 0x92, 0x9b, 0x05, 0xbf, 0x03,
-	0xb7, 0x3b, 0xfb, 0x3b, 0x2d, 0x77, 0xd7, 0x69, 0x35, 0xdd, 0x5e, 0xab, 0xb3, 0x6f, 0x37, 0x9c,
-	0xcf, 0x1c, 0xbb, 0x69, 0x66, 0xf0, 0x3a, 0xe0, 0x0b, 0x97, 0xd3, 0xea, 0xda, 0xa4, 0xb5, 0xb3,
-	0x67, 0x22, 0xbc, 0x06, 0xe6, 0x85, 0xbd, 0x63, 0x93, 0xa7, 0x36, 0x31, 0xb3, 0x69, 0x6b, 0x63,
-	0xcf, 0xb1, 0x5b, 0x5d, 0x73, 0x2e, 0x8d, 0xb1, 0x4f, 0xda, 0xcd, 0x5e, 0xc3, 0x26, 0xa6, 0x91,
-	0xb6, 0x37, 0xda, 0xad, 0x4e, 0xef, 0x89, 0x4d, 0xcc, 0x5c, 0xf9, 0x17, 0x04, 0x79, 0x35, 0xed,
-	0xd8, 0x82, 0xf9, 0x21, 0x8d, 0x63, 0xef, 0x30, 0x19, 0xd9, 0xe4, 0x13, 0x37, 0xc0, 0xe8, 0x87,
-	0xbe, 0xd2, 0xb8, 0xb0, 0x55, 0x7b, 0x9d, 0xb3, 0xa3, 0xff, 0x1a, 0xa1, 0x4f, 0x89, 0x4c, 0x2e,
-	0xb7, 0x00, 0x2e, 0x6c, 0xf8, 0x36, 0xac, 0x76, 0xba, 0x3b, 0xdd, 0x5e, 0xc7, 0x6d, 0xb4, 0x9b,
-	0xb6, 0x10, 0xc2, 0xee, 0x9a, 0x19, 0x8c, 0xa1, 0x30, 0x69, 0x6e, 0xef, 0x9a, 0xe8, 0x72, 0xa8,
-	0x4d, 0x48, 0x9b, 0x98, 0xd9, 0xcf, 0x8d, 0x05, 0x64, 0x66, 0xeb, 0x3f, 0xa0, 0xe7, 0xa7, 0x45,
-	0xf4, 0xe2, 0xb4, 0x88, 0xfe, 0x3c, 0x2d, 0xa2, 0x1f, 0xcf, 0x8a, 0x99, 0x17, 0x67, 0xc5, 0xcc,
-	0xef, 0x67, 0xc5, 0x0c, 0x6c, 0x06, 0xe1, 0x54, 0xa6, 0x75, 0xf5, 0xb0, 0xdd, 0x17, 0xc6, 0x7d,
-	0xf4, 0x65, 0xe3, 0xda, 0x13, 0xa9, 0x1e, 0xcf, 0x87, 0x94, 0x9d, 0xbf, 0xe4, 0x0f, 0xf2, 0xd2,
-	0xf4, 0xf0, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x56, 0xa5, 0xef, 0x0c, 0xf0, 0x0b, 0x00, 0x00,
+	// 991 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x56, 0x4d, 0x6f, 0x1b, 0x45,
+	0x18, 0xf6, 0x38, 0xeb, 0x8f, 0xbc, 0x49, 0xdc, 0xcd, 0x90, 0x56, 0x4b, 0x54, 0x1c, 0xcb, 0xaa,
+	0x84, 0xa1, 0x92, 0x4d, 0xd2, 0x4b, 0x38, 0x20, 0x9a, 0xd8, 0x8b, 0xb4, 0x75, 0x6a, 0x5b, 0xb3,
+	0x76, 0x24, 0x10, 0xd2, 0xb2, 0xf5, 0x0e, 0x66, 0x15, 0x7b, 0x76, 0xb5, 0x3b, 0x8e, 0xda, 0x1b,
+	0x3f, 0x81, 0x2b, 0x7f, 0x01, 0xee, 0xdc, 0xb8, 0x57, 0x1c, 0x50, 0x8f, 0x88, 0x43, 0x85, 0x92,
+	0x0b, 0xfd, 0x17, 0x68, 0x66, 0x76, 0xfd, 0x11, 0x45, 0x4e, 0x2a, 0xd1, 0x4b, 0x2f, 0xf6, 0xec,
+	0xfb, 0xf1, 0x3c, 0xcf, 0xfb, 0x31, 0xab, 0x85, 0x5a, 0x10, 0x52, 0xc6, 0xe9, 0x98, 0x4e, 0x28,
+	0x8f, 0x5e, 0x34, 0xc2, 0x28, 0xe0, 0x41, 0x83, 0x47, 0xee, 0x90, 0x36, 0xce, 0xf7, 0xd5, 0xa1,
+	0x2e, 0x8d, 0xf8, 0xfe, 0x52, 0xa4, 0x32, 0xd6, 0x55, 0xc0, 0xf9, 0xfe, 0xee, 0xce, 0x28, 0x18,
+	0x05, 0x2a, 0x5b, 0x9c, 0x94, 0x7b, 0xf7, 0xd3, 0xeb, 0xd0, 0x87, 0xc1, 0x64, 0x12, 0x30, 0x01,
+	0xaf, 0x4e, 0x49, 0x6c, 0xfd, 0xba, 0xd8, 0x88, 0xc6, 0xc1, 0x34, 0x52, 0x62, 0xd2, 0xb3, 0x8a,
+	0xaf, 0x7e, 0x07, 0xd0, 0x17, 0xec, 0x71, 0xcb, 0xe5, 0x2e, 0x26, 0x50, 0x4a, 0xfd, 0x4e, 0x1c,
+	0xba, 0x2c, 0x36, 0x50, 0x65, 0xad, 0xb6, 0x71, 0xf0, 0xb0, 0xbe, 0x4a, 0x76, 0x9d, 0x24, 0x39,
+	0xb6, 0x48, 0x21, 0x5b, 0xd1, 0xe2, 0x63, 0xf5, 0x4f, 0x04, 0x5b, 0x4b, 0x01, 0xb8, 0x0d, 0xc5,
+	0x34, 0xc4, 0x40, 0x15, 0x54, 0xdb, 0x38, 0xf8, 0xe4, 0x5a, 0xfc, 0x99, 0xd4, 0x05, 0x8a, 0x63,
+	0xed, 0xe5, 0xeb, 0xbd, 0x0c, 0x99, 0x01, 0x60, 0x0b, 0x36, 0xe2, 0x61, 0x10, 0xa6, 0x7a, 0xb3,
+	0x52, 0x6f, 0x6d, 0xb5, 0x5e, 0x5b, 0x24, 0x28, 0xb1, 0x10, 0xcf, 0xce, 0xf8, 0x23, 0x80, 0x78,
+	0xf8, 0x03, 0x9d, 0xb8, 0xce, 0x34, 0x1a, 0x1b, 0x6b, 0x15, 0x54, 0x5b, 0x27, 0xeb, 0xca, 0x32,
+	0x88, 0xc6, 0x4f, 0xf2, 0xc5, 0x7f, 0x0b, 0xfa, 0x9b, 0x42, 0xf5, 0x37, 0x04, 0x30, 0x47, 0xc0,
+	0x5d, 0xc8, 0x49, 0x8c, 0xa4, 0x94, 0x47, 0xd7, 0x52, 0x27, 0x33, 0x3a, 0xdf, 0xaf, 0x5b, 0x2c,
+	0xe6, 0xd1, 0x74, 0x42, 0x19, 0x77, 0xb9, 0x1f, 0x30, 0x09, 0x94, 0x14, 0xa5, 0x70, 0xf0, 0x21,
+	0xe4, 0x16, 0x6b, 0xa9, 0xde, 0x50, 0x4b, 0xe8, 0x32, 0x92, 0x8b, 0x6f, 0x51, 0x40, 0xf5, 0xc7,
+	0x2d, 0xd0, 0x44, 0x38, 0xfe, 0x16, 0x8a, 0x32, 0xdf, 0xf1, 0x3d, 0xa9, 0x7a, 0xf3, 0xf8, 0x48,
+	0x08, 0xf8, 0xfb, 0xf5, 0xde, 0xe7, 0xa3, 0xe0, 0x0a, 0x9d, 0x2f, 0x56, 0x6d, 0x3c, 0xa6, 0x43,
+	0x1e, 0x44, 0x8d, 0xd0, 0x73, 0xb9, 0xdb, 0xf0, 0x19, 0xa7, 0x11, 0x73, 0xc7, 0x0d, 0xf1, 0x54,
+	0x97, 0xeb, 0x63, 0xb5, 0x48, 0x41, 0x42, 0x5a, 0x1e, 0xfe, 0x1a, 0x0a, 0x42, 0x8e, 0x00, 0xcf,
+	0x4a, 0xf0, 0xc7, 0x09, 0xf8, 0xe1, 0xdb, 0x83, 0x0b, 0xb9, 0x56, 0x8b, 0xe4, 0x05, 0xa0, 0xe5,
+	0xe1, 0x3d, 0xd8, 0x50, 0xc2, 0x63, 0xee, 0x72, 0x9a, 0x54, 0x08, 0xd2, 0x64, 0x0b, 0x0b, 0xfe,
+	0x1e, 0x4a, 0xa1, 0x1b, 0x51, 0xc6, 0x9d, 0x54, 0x82, 0xf6, 0x3f, 0x49, 0xd8, 0x54, 0xb8, 0xb6,
+	0x12, 0x82, 0x41, 0x63, 0xee, 0x84, 0x1a, 0x39, 0xa9, 0x40, 0x9e, 0xf1, 0x97, 0xa0, 0x9d, 0xf9,
+	0xcc, 0x33, 0xf2, 0x15, 0x54, 0x2b, 0xdd, 0x74, 0x65, 0x04, 0x8e, 0xfc, 0x69, 0xfb, 0xcc, 0x23,
+	0x32, 0x11, 0x37, 0x60, 0x27, 0xe6, 0x6e, 0xc4, 0x1d, 0xee, 0x4f, 0xa8, 0x33, 0x65, 0xfe, 0x73,
+	0x87, 0xb9, 0x2c, 0x30, 0x0a, 0x15, 0x54, 0xcb, 0x93, 0x6d, 0xe9, 0xeb, 0xfb, 0x13, 0x3a, 0x60,
+	0xfe, 0xf3, 0x8e, 0xcb, 0x02, 0xfc, 0x10, 0x30, 0x65, 0xde, 0xd5, 0xf0, 0xa2, 0x0c, 0xbf, 0x43,
+	0x99, 0xb7, 0x14, 0xfc, 0x14, 0xc0, 0xe5, 0x3c, 0xf2, 0x9f, 0x4d, 0x39, 0x8d, 0x8d, 0x75, 0xb9,
+	0x5b, 0x1f, 0xdf, 0xb0, 0xac, 0x6d, 0xfa, 0xe2, 0xd4, 0x1d, 0x4f, 0xd3, 0x05, 0x5d, 0x00, 0xc0,
+	0x87, 0x60, 0x78, 0x51, 0x10, 0x86, 0xd4, 0x73, 0xe6, 0x56, 0x67, 0x18, 0x4c, 0x19, 0x37, 0xa0,
+	0x82, 0x6a, 0x5b, 0xe4, 0x5e, 0xe2, 0x3f, 0x9a, 0xb9, 0x9b, 0xc2, 0x8b, 0x1f, 0x43, 0x9e, 0x9e,
+	0x53, 0xc6, 0x63, 0x63, 0xe3, 0x56, 0x97, 0x55, 0x74, 0xca, 0x14, 0x09, 0x24, 0xc9, 0xc3, 0x9f,
+	0xc1, 0x4e, 0xca, 0xad, 0x2c, 0x09, 0xef, 0xa6, 0xe4, 0xc5, 0x89, 0x4f, 0xe6, 0x24, 0x9c, 0x5f,
+	0x40, 0x6e, 0xec, 0xb3, 0xb3, 0xd8, 0xd8, 0x5a, 0x51, 0xf7, 0x32, 0xe5, 0x89, 0xcf, 0xce, 0x88,
+	0xca, 0xc2, 0x75, 0xf8, 0x20, 0x25, 0x94, 0x86, 0x84, 0xaf, 0x24, 0xf9, 0xb6, 0x13, 0x97, 0x48,
+	0x48, 0xe8, 0x8e, 0x21, 0x2f, 0x36, 0x74, 0x1a, 0x1b, 0x77, 0xe4, 0x4b, 0xe1, 0xc1, 0x0d, 0x7c,
+	0x32, 0x36, 0x69, 0x72, 0x92, 0xb9, 0xfb, 0x07, 0x82, 0x9c, 0x2c, 0x01, 0x3f, 0x80, 0xd2, 0x95,
+	0x11, 0x23, 0x39, 0xe2, 0x4d, 0xbe, 0x38, 0xdf, 0x74, 0x25, 0xb3, 0x0b, 0x2b, 0xb9, 0x3c, 0xf3,
+	0xb5, 0x77, 0x39, 0x73, 0x6d, 0xd5, 0xcc, 0x77, 0xdf, 0x64, 0x41, 0x13, 0xfd, 0x79, 0x8f, 0x5f,
+	0x3d, 0xcb, 0xbd, 0xd6, 0xde, 0x65, 0xaf, 0x73, 0xab, 0x7a, 0x5d, 0xfd, 0x19, 0x41, 0x31, 0x7d,
+	0xb3, 0xe0, 0x0f, 0xe1, 0xae, 0xdd, 0x3b, 0xea, 0x38, 0x6d, 0xab, 0xd3, 0x72, 0x06, 0x1d, 0xbb,
+	0x67, 0x36, 0xad, 0xaf, 0x2c, 0xb3, 0xa5, 0x67, 0xf0, 0x3d, 0xc0, 0x73, 0x97, 0xd5, 0xe9, 0x9b,
+	0xa4, 0x73, 0x74, 0xa2, 0x23, 0xbc, 0x03, 0xfa, 0xdc, 0x6e, 0x9b, 0xe4, 0xd4, 0x24, 0x7a, 0x76,
+	0xd9, 0xda, 0x3c, 0xb1, 0xcc, 0x4e, 0x5f, 0x5f, 0x5b, 0xc6, 0xe8, 0x91, 0x6e, 0x6b, 0xd0, 0x34,
+	0x89, 0xae, 0x2d, 0xdb, 0x9b, 0xdd, 0x8e, 0x3d, 0x78, 0x6a, 0x12, 0x3d, 0x57, 0xfd, 0x1d, 0x41,
+	0x5e, 0x6d, 0x3b, 0x36, 0xa0, 0x30, 0xa1, 0x71, 0xec, 0x8e, 0xd2, 0x95, 0x4d, 0x1f, 0x71, 0x13,
+	0xb4, 0x61, 0xe0, 0xa9, 0x1e, 0x97, 0x0e, 0x1a, 0xb7, 0xb9, 0x3b, 0xc9, 0x5f, 0x33, 0xf0, 0x28,
+	0x91, 0xc9, 0xd5, 0x0e, 0xc0, 0xdc, 0x86, 0xef, 0xc2, 0xb6, 0xdd, 0x3f, 0xea, 0x0f, 0x6c, 0xa7,
+	0xd9, 0x6d, 0x99, 0xa2, 0x11, 0x66, 0x5f, 0xcf, 0x60, 0x0c, 0xa5, 0x45, 0x73, 0xb7, 0xad, 0xa3,
+	0xab, 0xa1, 0x26, 0x21, 0x5d, 0xa2, 0x67, 0x9f, 0x68, 0x45, 0xa4, 0x67, 0x8f, 0x7f, 0x45, 0x2f,
+	0x2f, 0xca, 0xe8, 0xd5, 0x45, 0x19, 0xfd, 0x73, 0x51, 0x46, 0x3f, 0x5d, 0x96, 0x33, 0xaf, 0x2e,
+	0xcb, 0x99, 0xbf, 0x2e, 0xcb, 0x19, 0xd8, 0xf3, 0x83, 0x95, 0x4a, 0x8f, 0xd5, 0x87, 0x56, 0x4f,
+	0x18, 0x7b, 0xe8, 0x9b, 0xe6, 0x5b, 0x6f, 0xa4, 0xfa, 0x98, 0x1b, 0x51, 0x36, 0xfb, 0xb2, 0xfc,
+	0x25, 0x7b, 0xbf, 0x1b, 0x52, 0xd6, 0x9f, 0x41, 0x48, 0x70, 0x75, 0x2d, 0xea, 0xa7, 0xfb, 0xcf,
+	0xf2, 0x32, 0xe3, 0xd1, 0x7f, 0x03, 0x00, 0xcc, 0x86, 0x1d, 0x4a, 0x9f, 0x0a, 0x00, 0x00,
 }
 
 func (m *TracesData) Marshal() (dAtA []byte, err error) {
@@ -981,22 +864,6 @@ func (m *ResourceSpans) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if len(m.InstrumentationLibrarySpans) > 0 {
-		for iNdEx := len(m.InstrumentationLibrarySpans) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.InstrumentationLibrarySpans[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTrace(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x3e
-			i--
-			dAtA[i] = 0xc2
-		}
-	}
 	if len(m.SchemaUrl) > 0 {
 		i -= len(m.SchemaUrl)
 		copy(dAtA[i:], m.SchemaUrl)
@@ -1085,60 +952,6 @@ func (m *ScopeSpans) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *InstrumentationLibrarySpans) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *InstrumentationLibrarySpans) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *InstrumentationLibrarySpans) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SchemaUrl) > 0 {
-		i -= len(m.SchemaUrl)
-		copy(dAtA[i:], m.SchemaUrl)
-		i = encodeVarintTrace(dAtA, i, uint64(len(m.SchemaUrl)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Spans) > 0 {
-		for iNdEx := len(m.Spans) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Spans[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTrace(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	{
-		size, err := m.InstrumentationLibrary.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTrace(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
 func (m *Span) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1493,12 +1306,6 @@ func (m *ResourceSpans) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTrace(uint64(l))
 	}
-	if len(m.InstrumentationLibrarySpans) > 0 {
-		for _, e := range m.InstrumentationLibrarySpans {
-			l = e.Size()
-			n += 2 + l + sovTrace(uint64(l))
-		}
-	}
 	return n
 }
 
@@ -1523,27 +1330,6 @@ func (m *ScopeSpans) Size() (n int) {
 	return n
 }
 
-func (m *InstrumentationLibrarySpans) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.InstrumentationLibrary.Size()
-	n += 1 + l + sovTrace(uint64(l))
-	if len(m.Spans) > 0 {
-		for _, e := range m.Spans {
-			l = e.Size()
-			n += 1 + l + sovTrace(uint64(l))
-		}
-	}
-	l = len(m.SchemaUrl)
-	if l > 0 {
-		n += 1 + l + sovTrace(uint64(l))
-	}
-	return n
-}
-
 func (m *Span) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1890,40 +1676,6 @@ func (m *ResourceSpans) Unmarshal(dAtA []byte) error {
 			}
 			m.SchemaUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 1000:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InstrumentationLibrarySpans", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTrace
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTrace
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTrace
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.InstrumentationLibrarySpans = append(m.InstrumentationLibrarySpans, &InstrumentationLibrarySpans{})
-			if err := m.InstrumentationLibrarySpans[len(m.InstrumentationLibrarySpans)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTrace(dAtA[iNdEx:])
@@ -2094,155 +1846,6 @@ func (m *ScopeSpans) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InstrumentationLibrarySpans) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTrace
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: InstrumentationLibrarySpans: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InstrumentationLibrarySpans: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InstrumentationLibrary", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTrace
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTrace
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTrace
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.InstrumentationLibrary.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spans", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTrace
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTrace
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTrace
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Spans = append(m.Spans, &Span{})
-			if err := m.Spans[len(m.Spans)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SchemaUrl", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTrace
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTrace
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTrace
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SchemaUrl = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTrace(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTrace
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
 func (m *Span) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,189 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal // import "go.opentelemetry.io/collector/pdata/internal"
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+
+	otlpcommon "go.opentelemetry.io/collector/pdata/internal/data/protogen/common/v1"
+)
+
+var keyValueSliceType = reflect.TypeOf([]otlpcommon.KeyValue(nil))
+
+// diffValues appends to diffs a line for every difference between the two values of the same generated proto type,
+// see Metrics.Diff. The path is the one of the values, using the JSON names of the fields.
+func diffValues(path string, expected, actual reflect.Value, diffs *[]string) {
+	switch expected.Kind() {
+	case reflect.Ptr:
+		switch {
+		case expected.IsNil() && actual.IsNil():
+		case expected.IsNil():
+			*diffs = append(*diffs, fmt.Sprintf("%s: <nil> != set", path))
+		case actual.IsNil():
+			*diffs = append(*diffs, fmt.Sprintf("%s: set != <nil>", path))
+		default:
+			diffValues(path, expected.Elem(), actual.Elem(), diffs)
+		}
+	case reflect.Interface:
+		// Oneof fields, the path continues with the name of the field set in the wrapper struct.
+		expectedName, actualName := oneofName(expected), oneofName(actual)
+		if expectedName != actualName {
+			*diffs = append(*diffs, fmt.Sprintf("%s: %s != %s", path, expectedName, actualName))
+			return
+		}
+		if !expected.IsNil() {
+			diffValues(path, expected.Elem(), actual.Elem(), diffs)
+		}
+	case reflect.Struct:
+		diffStructs(path, expected, actual, diffs)
+	case reflect.Slice:
+		if expected.Type() == keyValueSliceType {
+			diffAttributes(path, expected.Interface().([]otlpcommon.KeyValue), actual.Interface().([]otlpcommon.KeyValue), diffs)
+			return
+		}
+		if expected.Type().Elem().Kind() == reflect.Uint8 {
+			diffLeaves(path, expected, actual, diffs)
+			return
+		}
+		if expected.Len() != actual.Len() {
+			*diffs = append(*diffs, fmt.Sprintf("%s: %d elements != %d elements", path, expected.Len(), actual.Len()))
+		}
+		for i := 0; i < expected.Len() && i < actual.Len(); i++ {
+			diffValues(elementPath(path, i, expected.Index(i)), expected.Index(i), actual.Index(i), diffs)
+		}
+	case reflect.Float64:
+		e, a := expected.Float(), actual.Float()
+		if e != a && !(math.IsNaN(e) && math.IsNaN(a)) {
+			*diffs = append(*diffs, fmt.Sprintf("%s: %v != %v", path, e, a))
+		}
+	default:
+		diffLeaves(path, expected, actual, diffs)
+	}
+}
+
+func diffStructs(path string, expected, actual reflect.Value, diffs *[]string) {
+	t := expected.Type()
+	for i := 0; i < t.NumField(); i++ {
+		if t.Field(i).PkgPath != "" {
+			// Custom types, e.g. the trace and span IDs, are compared as a whole.
+			diffLeaves(path, expected, actual, diffs)
+			return
+		}
+	}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if _, ok := field.Tag.Lookup("protobuf_oneof"); ok {
+			diffValues(path, expected.Field(i), actual.Field(i), diffs)
+			continue
+		}
+		name := protoFieldName(field)
+		if name == "" {
+			continue
+		}
+		if path != "" {
+			name = path + "." + name
+		}
+		diffValues(name, expected.Field(i), actual.Field(i), diffs)
+	}
+}
+
+// diffAttributes compares the attributes by key, regardless of their order.
+func diffAttributes(path string, expected, actual []otlpcommon.KeyValue, diffs *[]string) {
+	actualIdx := make(map[string]int, len(actual))
+	for i := range actual {
+		actualIdx[actual[i].Key] = i
+	}
+	expectedKeys := make(map[string]bool, len(expected))
+	for i := range expected {
+		expectedKeys[expected[i].Key] = true
+		keyPath := fmt.Sprintf("%s[%q]", path, expected[i].Key)
+		j, ok := actualIdx[expected[i].Key]
+		if !ok {
+			*diffs = append(*diffs, fmt.Sprintf("%s: %q != <missing>", keyPath, Value{&expected[i].Value}.AsString()))
+			continue
+		}
+		diffValues(keyPath, reflect.ValueOf(expected[i].Value), reflect.ValueOf(actual[j].Value), diffs)
+	}
+	for i := range actual {
+		if !expectedKeys[actual[i].Key] {
+			*diffs = append(*diffs, fmt.Sprintf("%s[%q]: <missing> != %q", path, actual[i].Key, Value{&actual[i].Value}.AsString()))
+		}
+	}
+}
+
+func diffLeaves(path string, expected, actual reflect.Value, diffs *[]string) {
+	e, a := expected.Interface(), actual.Interface()
+	if !reflect.DeepEqual(e, a) {
+		*diffs = append(*diffs, fmt.Sprintf("%s: %s != %s", path, formatLeaf(e), formatLeaf(a)))
+	}
+}
+
+func formatLeaf(v interface{}) string {
+	switch val := v.(type) {
+	case string:
+		return fmt.Sprintf("%q", val)
+	case interface{ HexString() string }:
+		return fmt.Sprintf("%q", val.HexString())
+	}
+	return fmt.Sprintf("%v", v)
+}
+
+// elementPath returns the path of the element i of a slice, followed by the name of the element if it has one,
+// e.g. the name of a metric, so the differences can be found without counting the elements.
+func elementPath(path string, i int, elem reflect.Value) string {
+	path = fmt.Sprintf("%s[%d]", path, i)
+	if elem.Kind() == reflect.Ptr {
+		if elem.IsNil() {
+			return path
+		}
+		elem = elem.Elem()
+	}
+	if elem.Kind() != reflect.Struct {
+		return path
+	}
+	if name := elem.FieldByName("Name"); name.IsValid() && name.Kind() == reflect.String {
+		return fmt.Sprintf("%s(%q)", path, name.String())
+	}
+	return path
+}
+
+// oneofName returns the name of the field set in a oneof field, or "<nil>" if none is set.
+func oneofName(v reflect.Value) string {
+	if v.IsNil() {
+		return "<nil>"
+	}
+	return protoFieldName(v.Elem().Elem().Type().Field(0))
+}
+
+// protoFieldName returns the JSON name of a generated proto field, or an empty string if it is not a proto field.
+func protoFieldName(field reflect.StructField) string {
+	tag, ok := field.Tag.Lookup("protobuf")
+	if !ok {
+		return ""
+	}
+	name := ""
+	for _, opt := range strings.Split(tag, ",") {
+		switch {
+		case strings.HasPrefix(opt, "json="):
+			return strings.TrimPrefix(opt, "json=")
+		case strings.HasPrefix(opt, "name="):
+			name = strings.TrimPrefix(opt, "name=")
+		}
+	}
+	return name
+}
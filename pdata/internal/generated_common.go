@@ -194,3 +194,13 @@ func (es Slice) RemoveIf(f func(Value) bool) {
 	// TODO: Prevent memory leak by erasing truncated values.
 	*es.orig = (*es.orig)[:newLen]
 }
+
+// Range calls f sequentially for each element present in the slice, with its index.
+// If f returns false, range stops the iteration.
+func (es Slice) Range(f func(int, Value) bool) {
+	for i := 0; i < len(*es.orig); i++ {
+		if !f(i, es.At(i)) {
+			return
+		}
+	}
+}
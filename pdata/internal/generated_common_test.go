@@ -160,6 +160,34 @@ func TestSlice_RemoveIf(t *testing.T) {
 	assert.Equal(t, 5, filtered.Len())
 }
 
+func TestSlice_Range(t *testing.T) {
+	// Test Range on empty slice
+	emptySlice := NewSlice()
+	emptySlice.Range(func(i int, el Value) bool {
+		t.Fail()
+		return false
+	})
+
+	// Test Range
+	es := generateTestSlice()
+	calls := 0
+	es.Range(func(i int, el Value) bool {
+		assert.Equal(t, calls, i)
+		assert.Equal(t, es.At(i), el)
+		calls++
+		return true
+	})
+	assert.Equal(t, es.Len(), calls)
+
+	// Test Range stops when f returns false
+	calls = 0
+	es.Range(func(i int, el Value) bool {
+		calls++
+		return i < 2
+	})
+	assert.Equal(t, 3, calls)
+}
+
 func generateTestInstrumentationScope() InstrumentationScope {
 	tv := NewInstrumentationScope()
 	fillTestInstrumentationScope(tv)
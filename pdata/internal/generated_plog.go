@@ -160,6 +160,16 @@ func (es ResourceLogsSlice) RemoveIf(f func(ResourceLogs) bool) {
 	*es.orig = (*es.orig)[:newLen]
 }
 
+// Range calls f sequentially for each element present in the slice, with its index.
+// If f returns false, range stops the iteration.
+func (es ResourceLogsSlice) Range(f func(int, ResourceLogs) bool) {
+	for i := 0; i < len(*es.orig); i++ {
+		if !f(i, es.At(i)) {
+			return
+		}
+	}
+}
+
 // ResourceLogs is a collection of logs from a Resource.
 //
 // This is a reference type, if passed by value and callee modifies it the
@@ -354,6 +364,16 @@ func (es ScopeLogsSlice) RemoveIf(f func(ScopeLogs) bool) {
 	*es.orig = (*es.orig)[:newLen]
 }
 
+// Range calls f sequentially for each element present in the slice, with its index.
+// If f returns false, range stops the iteration.
+func (es ScopeLogsSlice) Range(f func(int, ScopeLogs) bool) {
+	for i := 0; i < len(*es.orig); i++ {
+		if !f(i, es.At(i)) {
+			return
+		}
+	}
+}
+
 // ScopeLogs is a collection of logs from a LibraryInstrumentation.
 //
 // This is a reference type, if passed by value and callee modifies it the
@@ -548,6 +568,16 @@ func (es LogRecordSlice) RemoveIf(f func(LogRecord) bool) {
 	*es.orig = (*es.orig)[:newLen]
 }
 
+// Range calls f sequentially for each element present in the slice, with its index.
+// If f returns false, range stops the iteration.
+func (es LogRecordSlice) Range(f func(int, LogRecord) bool) {
+	for i := 0; i < len(*es.orig); i++ {
+		if !f(i, es.At(i)) {
+			return
+		}
+	}
+}
+
 // LogRecord are experimental implementation of OpenTelemetry Log Data Model.
 
 //
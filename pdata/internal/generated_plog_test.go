@@ -135,6 +135,34 @@ func TestResourceLogsSlice_RemoveIf(t *testing.T) {
 	assert.Equal(t, 5, filtered.Len())
 }
 
+func TestResourceLogsSlice_Range(t *testing.T) {
+	// Test Range on empty slice
+	emptySlice := NewResourceLogsSlice()
+	emptySlice.Range(func(i int, el ResourceLogs) bool {
+		t.Fail()
+		return false
+	})
+
+	// Test Range
+	es := generateTestResourceLogsSlice()
+	calls := 0
+	es.Range(func(i int, el ResourceLogs) bool {
+		assert.Equal(t, calls, i)
+		assert.Equal(t, es.At(i), el)
+		calls++
+		return true
+	})
+	assert.Equal(t, es.Len(), calls)
+
+	// Test Range stops when f returns false
+	calls = 0
+	es.Range(func(i int, el ResourceLogs) bool {
+		calls++
+		return i < 2
+	})
+	assert.Equal(t, 3, calls)
+}
+
 func TestResourceLogs_MoveTo(t *testing.T) {
 	ms := generateTestResourceLogs()
 	dest := NewResourceLogs()
@@ -285,6 +313,34 @@ func TestScopeLogsSlice_RemoveIf(t *testing.T) {
 	assert.Equal(t, 5, filtered.Len())
 }
 
+func TestScopeLogsSlice_Range(t *testing.T) {
+	// Test Range on empty slice
+	emptySlice := NewScopeLogsSlice()
+	emptySlice.Range(func(i int, el ScopeLogs) bool {
+		t.Fail()
+		return false
+	})
+
+	// Test Range
+	es := generateTestScopeLogsSlice()
+	calls := 0
+	es.Range(func(i int, el ScopeLogs) bool {
+		assert.Equal(t, calls, i)
+		assert.Equal(t, es.At(i), el)
+		calls++
+		return true
+	})
+	assert.Equal(t, es.Len(), calls)
+
+	// Test Range stops when f returns false
+	calls = 0
+	es.Range(func(i int, el ScopeLogs) bool {
+		calls++
+		return i < 2
+	})
+	assert.Equal(t, 3, calls)
+}
+
 func TestScopeLogs_MoveTo(t *testing.T) {
 	ms := generateTestScopeLogs()
 	dest := NewScopeLogs()
@@ -435,6 +491,34 @@ func TestLogRecordSlice_RemoveIf(t *testing.T) {
 	assert.Equal(t, 5, filtered.Len())
 }
 
+func TestLogRecordSlice_Range(t *testing.T) {
+	// Test Range on empty slice
+	emptySlice := NewLogRecordSlice()
+	emptySlice.Range(func(i int, el LogRecord) bool {
+		t.Fail()
+		return false
+	})
+
+	// Test Range
+	es := generateTestLogRecordSlice()
+	calls := 0
+	es.Range(func(i int, el LogRecord) bool {
+		assert.Equal(t, calls, i)
+		assert.Equal(t, es.At(i), el)
+		calls++
+		return true
+	})
+	assert.Equal(t, es.Len(), calls)
+
+	// Test Range stops when f returns false
+	calls = 0
+	es.Range(func(i int, el LogRecord) bool {
+		calls++
+		return i < 2
+	})
+	assert.Equal(t, 3, calls)
+}
+
 func TestLogRecord_MoveTo(t *testing.T) {
 	ms := generateTestLogRecord()
 	dest := NewLogRecord()
@@ -160,6 +160,16 @@ func (es ResourceMetricsSlice) RemoveIf(f func(ResourceMetrics) bool) {
 	*es.orig = (*es.orig)[:newLen]
 }
 
+// Range calls f sequentially for each element present in the slice, with its index.
+// If f returns false, range stops the iteration.
+func (es ResourceMetricsSlice) Range(f func(int, ResourceMetrics) bool) {
+	for i := 0; i < len(*es.orig); i++ {
+		if !f(i, es.At(i)) {
+			return
+		}
+	}
+}
+
 // ResourceMetrics is a collection of metrics from a Resource.
 //
 // This is a reference type, if passed by value and callee modifies it the
@@ -354,6 +364,16 @@ func (es ScopeMetricsSlice) RemoveIf(f func(ScopeMetrics) bool) {
 	*es.orig = (*es.orig)[:newLen]
 }
 
+// Range calls f sequentially for each element present in the slice, with its index.
+// If f returns false, range stops the iteration.
+func (es ScopeMetricsSlice) Range(f func(int, ScopeMetrics) bool) {
+	for i := 0; i < len(*es.orig); i++ {
+		if !f(i, es.At(i)) {
+			return
+		}
+	}
+}
+
 // ScopeMetrics is a collection of metrics from a LibraryInstrumentation.
 //
 // This is a reference type, if passed by value and callee modifies it the
@@ -548,6 +568,16 @@ func (es MetricSlice) RemoveIf(f func(Metric) bool) {
 	*es.orig = (*es.orig)[:newLen]
 }
 
+// Range calls f sequentially for each element present in the slice, with its index.
+// If f returns false, range stops the iteration.
+func (es MetricSlice) Range(f func(int, Metric) bool) {
+	for i := 0; i < len(*es.orig); i++ {
+		if !f(i, es.At(i)) {
+			return
+		}
+	}
+}
+
 // Metric represents one metric as a collection of datapoints.
 // See Metric definition in OTLP: https://github.com/open-telemetry/opentelemetry-proto/blob/main/opentelemetry/proto/metrics/v1/metrics.proto
 //
@@ -1104,6 +1134,16 @@ func (es NumberDataPointSlice) RemoveIf(f func(NumberDataPoint) bool) {
 	*es.orig = (*es.orig)[:newLen]
 }
 
+// Range calls f sequentially for each element present in the slice, with its index.
+// If f returns false, range stops the iteration.
+func (es NumberDataPointSlice) Range(f func(int, NumberDataPoint) bool) {
+	for i := 0; i < len(*es.orig); i++ {
+		if !f(i, es.At(i)) {
+			return
+		}
+	}
+}
+
 // NumberDataPoint is a single data point in a timeseries that describes the time-varying value of a number metric.
 //
 // This is a reference type, if passed by value and callee modifies it the
@@ -1363,6 +1403,16 @@ func (es HistogramDataPointSlice) RemoveIf(f func(HistogramDataPoint) bool) {
 	*es.orig = (*es.orig)[:newLen]
 }
 
+// Range calls f sequentially for each element present in the slice, with its index.
+// If f returns false, range stops the iteration.
+func (es HistogramDataPointSlice) Range(f func(int, HistogramDataPoint) bool) {
+	for i := 0; i < len(*es.orig); i++ {
+		if !f(i, es.At(i)) {
+			return
+		}
+	}
+}
+
 // HistogramDataPoint is a single data point in a timeseries that describes the time-varying values of a Histogram of values.
 //
 // This is a reference type, if passed by value and callee modifies it the
@@ -1479,6 +1529,38 @@ func (ms HistogramDataPoint) SetFlags(v MetricDataPointFlags) {
 	(*ms.orig).Flags = uint32(v)
 }
 
+// Min returns the min associated with this HistogramDataPoint.
+func (ms HistogramDataPoint) Min() float64 {
+	return (*ms.orig).GetMin()
+}
+
+// HasMin returns true if the HistogramDataPoint contains a
+// Min value, false otherwise.
+func (ms HistogramDataPoint) HasMin() bool {
+	return ms.orig.Min_ != nil
+}
+
+// SetMin replaces the min associated with this HistogramDataPoint.
+func (ms HistogramDataPoint) SetMin(v float64) {
+	(*ms.orig).Min_ = &otlpmetrics.HistogramDataPoint_Min{Min: v}
+}
+
+// Max returns the max associated with this HistogramDataPoint.
+func (ms HistogramDataPoint) Max() float64 {
+	return (*ms.orig).GetMax()
+}
+
+// HasMax returns true if the HistogramDataPoint contains a
+// Max value, false otherwise.
+func (ms HistogramDataPoint) HasMax() bool {
+	return ms.orig.Max_ != nil
+}
+
+// SetMax replaces the max associated with this HistogramDataPoint.
+func (ms HistogramDataPoint) SetMax(v float64) {
+	(*ms.orig).Max_ = &otlpmetrics.HistogramDataPoint_Max{Max: v}
+}
+
 // CopyTo copies all properties from the current struct to the dest.
 func (ms HistogramDataPoint) CopyTo(dest HistogramDataPoint) {
 	ms.Attributes().CopyTo(dest.Attributes())
@@ -1505,6 +1587,14 @@ func (ms HistogramDataPoint) CopyTo(dest HistogramDataPoint) {
 
 	ms.Exemplars().CopyTo(dest.Exemplars())
 	dest.SetFlags(ms.Flags())
+	if ms.HasMin() {
+		dest.SetMin(ms.Min())
+	}
+
+	if ms.HasMax() {
+		dest.SetMax(ms.Max())
+	}
+
 }
 
 // ExponentialHistogramDataPointSlice logically represents a slice of ExponentialHistogramDataPoint.
@@ -1644,6 +1734,16 @@ func (es ExponentialHistogramDataPointSlice) RemoveIf(f func(ExponentialHistogra
 	*es.orig = (*es.orig)[:newLen]
 }
 
+// Range calls f sequentially for each element present in the slice, with its index.
+// If f returns false, range stops the iteration.
+func (es ExponentialHistogramDataPointSlice) Range(f func(int, ExponentialHistogramDataPoint) bool) {
+	for i := 0; i < len(*es.orig); i++ {
+		if !f(i, es.At(i)) {
+			return
+		}
+	}
+}
+
 // ExponentialHistogramDataPoint is a single data point in a timeseries that describes the
 // time-varying values of a ExponentialHistogram of double values. A ExponentialHistogram contains
 // summary statistics for a population of values, it may optionally contain the
@@ -1714,12 +1814,18 @@ func (ms ExponentialHistogramDataPoint) SetCount(v uint64) {
 
 // Sum returns the sum associated with this ExponentialHistogramDataPoint.
 func (ms ExponentialHistogramDataPoint) Sum() float64 {
-	return (*ms.orig).Sum
+	return (*ms.orig).GetSum()
+}
+
+// HasSum returns true if the ExponentialHistogramDataPoint contains a
+// Sum value, false otherwise.
+func (ms ExponentialHistogramDataPoint) HasSum() bool {
+	return ms.orig.Sum_ != nil
 }
 
 // SetSum replaces the sum associated with this ExponentialHistogramDataPoint.
 func (ms ExponentialHistogramDataPoint) SetSum(v float64) {
-	(*ms.orig).Sum = v
+	(*ms.orig).Sum_ = &otlpmetrics.ExponentialHistogramDataPoint_Sum{Sum: v}
 }
 
 // Scale returns the scale associated with this ExponentialHistogramDataPoint.
@@ -1773,7 +1879,10 @@ func (ms ExponentialHistogramDataPoint) CopyTo(dest ExponentialHistogramDataPoin
 	dest.SetStartTimestamp(ms.StartTimestamp())
 	dest.SetTimestamp(ms.Timestamp())
 	dest.SetCount(ms.Count())
-	dest.SetSum(ms.Sum())
+	if ms.HasSum() {
+		dest.SetSum(ms.Sum())
+	}
+
 	dest.SetScale(ms.Scale())
 	dest.SetZeroCount(ms.ZeroCount())
 	ms.Positive().CopyTo(dest.Positive())
@@ -1981,6 +2090,16 @@ func (es SummaryDataPointSlice) RemoveIf(f func(SummaryDataPoint) bool) {
 	*es.orig = (*es.orig)[:newLen]
 }
 
+// Range calls f sequentially for each element present in the slice, with its index.
+// If f returns false, range stops the iteration.
+func (es SummaryDataPointSlice) Range(f func(int, SummaryDataPoint) bool) {
+	for i := 0; i < len(*es.orig); i++ {
+		if !f(i, es.At(i)) {
+			return
+		}
+	}
+}
+
 // SummaryDataPoint is a single data point in a timeseries that describes the time-varying values of a Summary of double values.
 //
 // This is a reference type, if passed by value and callee modifies it the
@@ -2219,6 +2338,16 @@ func (es ValueAtQuantileSlice) RemoveIf(f func(ValueAtQuantile) bool) {
 	*es.orig = (*es.orig)[:newLen]
 }
 
+// Range calls f sequentially for each element present in the slice, with its index.
+// If f returns false, range stops the iteration.
+func (es ValueAtQuantileSlice) Range(f func(int, ValueAtQuantile) bool) {
+	for i := 0; i < len(*es.orig); i++ {
+		if !f(i, es.At(i)) {
+			return
+		}
+	}
+}
+
 // ValueAtQuantile is a quantile value within a Summary data point.
 //
 // This is a reference type, if passed by value and callee modifies it the
@@ -2393,6 +2522,16 @@ func (es ExemplarSlice) RemoveIf(f func(Exemplar) bool) {
 	*es.orig = (*es.orig)[:newLen]
 }
 
+// Range calls f sequentially for each element present in the slice, with its index.
+// If f returns false, range stops the iteration.
+func (es ExemplarSlice) Range(f func(int, Exemplar) bool) {
+	for i := 0; i < len(*es.orig); i++ {
+		if !f(i, es.At(i)) {
+			return
+		}
+	}
+}
+
 // Exemplar is a sample input double measurement.
 //
 // Exemplars also hold information about the environment when the measurement was recorded,
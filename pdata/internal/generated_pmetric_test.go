@@ -135,6 +135,34 @@ func TestResourceMetricsSlice_RemoveIf(t *testing.T) {
 	assert.Equal(t, 5, filtered.Len())
 }
 
+func TestResourceMetricsSlice_Range(t *testing.T) {
+	// Test Range on empty slice
+	emptySlice := NewResourceMetricsSlice()
+	emptySlice.Range(func(i int, el ResourceMetrics) bool {
+		t.Fail()
+		return false
+	})
+
+	// Test Range
+	es := generateTestResourceMetricsSlice()
+	calls := 0
+	es.Range(func(i int, el ResourceMetrics) bool {
+		assert.Equal(t, calls, i)
+		assert.Equal(t, es.At(i), el)
+		calls++
+		return true
+	})
+	assert.Equal(t, es.Len(), calls)
+
+	// Test Range stops when f returns false
+	calls = 0
+	es.Range(func(i int, el ResourceMetrics) bool {
+		calls++
+		return i < 2
+	})
+	assert.Equal(t, 3, calls)
+}
+
 func TestResourceMetrics_MoveTo(t *testing.T) {
 	ms := generateTestResourceMetrics()
 	dest := NewResourceMetrics()
@@ -285,6 +313,34 @@ func TestScopeMetricsSlice_RemoveIf(t *testing.T) {
 	assert.Equal(t, 5, filtered.Len())
 }
 
+func TestScopeMetricsSlice_Range(t *testing.T) {
+	// Test Range on empty slice
+	emptySlice := NewScopeMetricsSlice()
+	emptySlice.Range(func(i int, el ScopeMetrics) bool {
+		t.Fail()
+		return false
+	})
+
+	// Test Range
+	es := generateTestScopeMetricsSlice()
+	calls := 0
+	es.Range(func(i int, el ScopeMetrics) bool {
+		assert.Equal(t, calls, i)
+		assert.Equal(t, es.At(i), el)
+		calls++
+		return true
+	})
+	assert.Equal(t, es.Len(), calls)
+
+	// Test Range stops when f returns false
+	calls = 0
+	es.Range(func(i int, el ScopeMetrics) bool {
+		calls++
+		return i < 2
+	})
+	assert.Equal(t, 3, calls)
+}
+
 func TestScopeMetrics_MoveTo(t *testing.T) {
 	ms := generateTestScopeMetrics()
 	dest := NewScopeMetrics()
@@ -435,6 +491,34 @@ func TestMetricSlice_RemoveIf(t *testing.T) {
 	assert.Equal(t, 5, filtered.Len())
 }
 
+func TestMetricSlice_Range(t *testing.T) {
+	// Test Range on empty slice
+	emptySlice := NewMetricSlice()
+	emptySlice.Range(func(i int, el Metric) bool {
+		t.Fail()
+		return false
+	})
+
+	// Test Range
+	es := generateTestMetricSlice()
+	calls := 0
+	es.Range(func(i int, el Metric) bool {
+		assert.Equal(t, calls, i)
+		assert.Equal(t, es.At(i), el)
+		calls++
+		return true
+	})
+	assert.Equal(t, es.Len(), calls)
+
+	// Test Range stops when f returns false
+	calls = 0
+	es.Range(func(i int, el Metric) bool {
+		calls++
+		return i < 2
+	})
+	assert.Equal(t, 3, calls)
+}
+
 func TestMetric_MoveTo(t *testing.T) {
 	ms := generateTestMetric()
 	dest := NewMetric()
@@ -855,6 +939,34 @@ func TestNumberDataPointSlice_RemoveIf(t *testing.T) {
 	assert.Equal(t, 5, filtered.Len())
 }
 
+func TestNumberDataPointSlice_Range(t *testing.T) {
+	// Test Range on empty slice
+	emptySlice := NewNumberDataPointSlice()
+	emptySlice.Range(func(i int, el NumberDataPoint) bool {
+		t.Fail()
+		return false
+	})
+
+	// Test Range
+	es := generateTestNumberDataPointSlice()
+	calls := 0
+	es.Range(func(i int, el NumberDataPoint) bool {
+		assert.Equal(t, calls, i)
+		assert.Equal(t, es.At(i), el)
+		calls++
+		return true
+	})
+	assert.Equal(t, es.Len(), calls)
+
+	// Test Range stops when f returns false
+	calls = 0
+	es.Range(func(i int, el NumberDataPoint) bool {
+		calls++
+		return i < 2
+	})
+	assert.Equal(t, 3, calls)
+}
+
 func TestNumberDataPoint_MoveTo(t *testing.T) {
 	ms := generateTestNumberDataPoint()
 	dest := NewNumberDataPoint()
@@ -1049,6 +1161,34 @@ func TestHistogramDataPointSlice_RemoveIf(t *testing.T) {
 	assert.Equal(t, 5, filtered.Len())
 }
 
+func TestHistogramDataPointSlice_Range(t *testing.T) {
+	// Test Range on empty slice
+	emptySlice := NewHistogramDataPointSlice()
+	emptySlice.Range(func(i int, el HistogramDataPoint) bool {
+		t.Fail()
+		return false
+	})
+
+	// Test Range
+	es := generateTestHistogramDataPointSlice()
+	calls := 0
+	es.Range(func(i int, el HistogramDataPoint) bool {
+		assert.Equal(t, calls, i)
+		assert.Equal(t, es.At(i), el)
+		calls++
+		return true
+	})
+	assert.Equal(t, es.Len(), calls)
+
+	// Test Range stops when f returns false
+	calls = 0
+	es.Range(func(i int, el HistogramDataPoint) bool {
+		calls++
+		return i < 2
+	})
+	assert.Equal(t, 3, calls)
+}
+
 func TestHistogramDataPoint_MoveTo(t *testing.T) {
 	ms := generateTestHistogramDataPoint()
 	dest := NewHistogramDataPoint()
@@ -1147,6 +1287,22 @@ func TestHistogramDataPoint_Flags(t *testing.T) {
 	assert.EqualValues(t, testValFlags, ms.Flags())
 }
 
+func TestHistogramDataPoint_Min(t *testing.T) {
+	ms := NewHistogramDataPoint()
+	assert.EqualValues(t, float64(0.0), ms.Min())
+	testValMin := float64(9.23)
+	ms.SetMin(testValMin)
+	assert.EqualValues(t, testValMin, ms.Min())
+}
+
+func TestHistogramDataPoint_Max(t *testing.T) {
+	ms := NewHistogramDataPoint()
+	assert.EqualValues(t, float64(0.0), ms.Max())
+	testValMax := float64(182.55)
+	ms.SetMax(testValMax)
+	assert.EqualValues(t, testValMax, ms.Max())
+}
+
 func TestExponentialHistogramDataPointSlice(t *testing.T) {
 	es := NewExponentialHistogramDataPointSlice()
 	assert.EqualValues(t, 0, es.Len())
@@ -1257,6 +1413,34 @@ func TestExponentialHistogramDataPointSlice_RemoveIf(t *testing.T) {
 	assert.Equal(t, 5, filtered.Len())
 }
 
+func TestExponentialHistogramDataPointSlice_Range(t *testing.T) {
+	// Test Range on empty slice
+	emptySlice := NewExponentialHistogramDataPointSlice()
+	emptySlice.Range(func(i int, el ExponentialHistogramDataPoint) bool {
+		t.Fail()
+		return false
+	})
+
+	// Test Range
+	es := generateTestExponentialHistogramDataPointSlice()
+	calls := 0
+	es.Range(func(i int, el ExponentialHistogramDataPoint) bool {
+		assert.Equal(t, calls, i)
+		assert.Equal(t, es.At(i), el)
+		calls++
+		return true
+	})
+	assert.Equal(t, es.Len(), calls)
+
+	// Test Range stops when f returns false
+	calls = 0
+	es.Range(func(i int, el ExponentialHistogramDataPoint) bool {
+		calls++
+		return i < 2
+	})
+	assert.Equal(t, 3, calls)
+}
+
 func TestExponentialHistogramDataPoint_MoveTo(t *testing.T) {
 	ms := generateTestExponentialHistogramDataPoint()
 	dest := NewExponentialHistogramDataPoint()
@@ -1507,6 +1691,34 @@ func TestSummaryDataPointSlice_RemoveIf(t *testing.T) {
 	assert.Equal(t, 5, filtered.Len())
 }
 
+func TestSummaryDataPointSlice_Range(t *testing.T) {
+	// Test Range on empty slice
+	emptySlice := NewSummaryDataPointSlice()
+	emptySlice.Range(func(i int, el SummaryDataPoint) bool {
+		t.Fail()
+		return false
+	})
+
+	// Test Range
+	es := generateTestSummaryDataPointSlice()
+	calls := 0
+	es.Range(func(i int, el SummaryDataPoint) bool {
+		assert.Equal(t, calls, i)
+		assert.Equal(t, es.At(i), el)
+		calls++
+		return true
+	})
+	assert.Equal(t, es.Len(), calls)
+
+	// Test Range stops when f returns false
+	calls = 0
+	es.Range(func(i int, el SummaryDataPoint) bool {
+		calls++
+		return i < 2
+	})
+	assert.Equal(t, 3, calls)
+}
+
 func TestSummaryDataPoint_MoveTo(t *testing.T) {
 	ms := generateTestSummaryDataPoint()
 	dest := NewSummaryDataPoint()
@@ -1691,6 +1903,34 @@ func TestValueAtQuantileSlice_RemoveIf(t *testing.T) {
 	assert.Equal(t, 5, filtered.Len())
 }
 
+func TestValueAtQuantileSlice_Range(t *testing.T) {
+	// Test Range on empty slice
+	emptySlice := NewValueAtQuantileSlice()
+	emptySlice.Range(func(i int, el ValueAtQuantile) bool {
+		t.Fail()
+		return false
+	})
+
+	// Test Range
+	es := generateTestValueAtQuantileSlice()
+	calls := 0
+	es.Range(func(i int, el ValueAtQuantile) bool {
+		assert.Equal(t, calls, i)
+		assert.Equal(t, es.At(i), el)
+		calls++
+		return true
+	})
+	assert.Equal(t, es.Len(), calls)
+
+	// Test Range stops when f returns false
+	calls = 0
+	es.Range(func(i int, el ValueAtQuantile) bool {
+		calls++
+		return i < 2
+	})
+	assert.Equal(t, 3, calls)
+}
+
 func TestValueAtQuantile_MoveTo(t *testing.T) {
 	ms := generateTestValueAtQuantile()
 	dest := NewValueAtQuantile()
@@ -1826,6 +2066,34 @@ func TestExemplarSlice_RemoveIf(t *testing.T) {
 	assert.Equal(t, 5, filtered.Len())
 }
 
+func TestExemplarSlice_Range(t *testing.T) {
+	// Test Range on empty slice
+	emptySlice := NewExemplarSlice()
+	emptySlice.Range(func(i int, el Exemplar) bool {
+		t.Fail()
+		return false
+	})
+
+	// Test Range
+	es := generateTestExemplarSlice()
+	calls := 0
+	es.Range(func(i int, el Exemplar) bool {
+		assert.Equal(t, calls, i)
+		assert.Equal(t, es.At(i), el)
+		calls++
+		return true
+	})
+	assert.Equal(t, es.Len(), calls)
+
+	// Test Range stops when f returns false
+	calls = 0
+	es.Range(func(i int, el Exemplar) bool {
+		calls++
+		return i < 2
+	})
+	assert.Equal(t, 3, calls)
+}
+
 func TestExemplar_MoveTo(t *testing.T) {
 	ms := generateTestExemplar()
 	dest := NewExemplar()
@@ -2095,6 +2363,8 @@ func fillTestHistogramDataPoint(tv HistogramDataPoint) {
 	tv.SetExplicitBounds([]float64{1, 2, 3})
 	fillTestExemplarSlice(tv.Exemplars())
 	tv.SetFlags(MetricDataPointFlagsNone)
+	tv.SetMin(float64(9.23))
+	tv.SetMax(float64(182.55))
 }
 
 func generateTestExponentialHistogramDataPointSlice() ExponentialHistogramDataPointSlice {
@@ -160,6 +160,16 @@ func (es ResourceSpansSlice) RemoveIf(f func(ResourceSpans) bool) {
 	*es.orig = (*es.orig)[:newLen]
 }
 
+// Range calls f sequentially for each element present in the slice, with its index.
+// If f returns false, range stops the iteration.
+func (es ResourceSpansSlice) Range(f func(int, ResourceSpans) bool) {
+	for i := 0; i < len(*es.orig); i++ {
+		if !f(i, es.At(i)) {
+			return
+		}
+	}
+}
+
 // ResourceSpans is a collection of spans from a Resource.
 //
 // This is a reference type, if passed by value and callee modifies it the
@@ -354,6 +364,16 @@ func (es ScopeSpansSlice) RemoveIf(f func(ScopeSpans) bool) {
 	*es.orig = (*es.orig)[:newLen]
 }
 
+// Range calls f sequentially for each element present in the slice, with its index.
+// If f returns false, range stops the iteration.
+func (es ScopeSpansSlice) Range(f func(int, ScopeSpans) bool) {
+	for i := 0; i < len(*es.orig); i++ {
+		if !f(i, es.At(i)) {
+			return
+		}
+	}
+}
+
 // ScopeSpans is a collection of spans from a LibraryInstrumentation.
 //
 // This is a reference type, if passed by value and callee modifies it the
@@ -548,6 +568,16 @@ func (es SpanSlice) RemoveIf(f func(Span) bool) {
 	*es.orig = (*es.orig)[:newLen]
 }
 
+// Range calls f sequentially for each element present in the slice, with its index.
+// If f returns false, range stops the iteration.
+func (es SpanSlice) Range(f func(int, Span) bool) {
+	for i := 0; i < len(*es.orig); i++ {
+		if !f(i, es.At(i)) {
+			return
+		}
+	}
+}
+
 // Span represents a single operation within a trace.
 // See Span definition in OTLP: https://github.com/open-telemetry/opentelemetry-proto/blob/main/opentelemetry/proto/trace/v1/trace.proto
 //
@@ -865,6 +895,16 @@ func (es SpanEventSlice) RemoveIf(f func(SpanEvent) bool) {
 	*es.orig = (*es.orig)[:newLen]
 }
 
+// Range calls f sequentially for each element present in the slice, with its index.
+// If f returns false, range stops the iteration.
+func (es SpanEventSlice) Range(f func(int, SpanEvent) bool) {
+	for i := 0; i < len(*es.orig); i++ {
+		if !f(i, es.At(i)) {
+			return
+		}
+	}
+}
+
 // SpanEvent is a time-stamped annotation of the span, consisting of user-supplied
 // text description and key-value pairs. See OTLP for event definition.
 //
@@ -1076,6 +1116,16 @@ func (es SpanLinkSlice) RemoveIf(f func(SpanLink) bool) {
 	*es.orig = (*es.orig)[:newLen]
 }
 
+// Range calls f sequentially for each element present in the slice, with its index.
+// If f returns false, range stops the iteration.
+func (es SpanLinkSlice) Range(f func(int, SpanLink) bool) {
+	for i := 0; i < len(*es.orig); i++ {
+		if !f(i, es.At(i)) {
+			return
+		}
+	}
+}
+
 // SpanLink is a pointer from the current span to another span in the same trace or in a
 // different trace.
 // See Link definition in OTLP: https://github.com/open-telemetry/opentelemetry-proto/blob/main/opentelemetry/proto/trace/v1/trace.proto
@@ -135,6 +135,34 @@ func TestResourceSpansSlice_RemoveIf(t *testing.T) {
 	assert.Equal(t, 5, filtered.Len())
 }
 
+func TestResourceSpansSlice_Range(t *testing.T) {
+	// Test Range on empty slice
+	emptySlice := NewResourceSpansSlice()
+	emptySlice.Range(func(i int, el ResourceSpans) bool {
+		t.Fail()
+		return false
+	})
+
+	// Test Range
+	es := generateTestResourceSpansSlice()
+	calls := 0
+	es.Range(func(i int, el ResourceSpans) bool {
+		assert.Equal(t, calls, i)
+		assert.Equal(t, es.At(i), el)
+		calls++
+		return true
+	})
+	assert.Equal(t, es.Len(), calls)
+
+	// Test Range stops when f returns false
+	calls = 0
+	es.Range(func(i int, el ResourceSpans) bool {
+		calls++
+		return i < 2
+	})
+	assert.Equal(t, 3, calls)
+}
+
 func TestResourceSpans_MoveTo(t *testing.T) {
 	ms := generateTestResourceSpans()
 	dest := NewResourceSpans()
@@ -285,6 +313,34 @@ func TestScopeSpansSlice_RemoveIf(t *testing.T) {
 	assert.Equal(t, 5, filtered.Len())
 }
 
+func TestScopeSpansSlice_Range(t *testing.T) {
+	// Test Range on empty slice
+	emptySlice := NewScopeSpansSlice()
+	emptySlice.Range(func(i int, el ScopeSpans) bool {
+		t.Fail()
+		return false
+	})
+
+	// Test Range
+	es := generateTestScopeSpansSlice()
+	calls := 0
+	es.Range(func(i int, el ScopeSpans) bool {
+		assert.Equal(t, calls, i)
+		assert.Equal(t, es.At(i), el)
+		calls++
+		return true
+	})
+	assert.Equal(t, es.Len(), calls)
+
+	// Test Range stops when f returns false
+	calls = 0
+	es.Range(func(i int, el ScopeSpans) bool {
+		calls++
+		return i < 2
+	})
+	assert.Equal(t, 3, calls)
+}
+
 func TestScopeSpans_MoveTo(t *testing.T) {
 	ms := generateTestScopeSpans()
 	dest := NewScopeSpans()
@@ -435,6 +491,34 @@ func TestSpanSlice_RemoveIf(t *testing.T) {
 	assert.Equal(t, 5, filtered.Len())
 }
 
+func TestSpanSlice_Range(t *testing.T) {
+	// Test Range on empty slice
+	emptySlice := NewSpanSlice()
+	emptySlice.Range(func(i int, el Span) bool {
+		t.Fail()
+		return false
+	})
+
+	// Test Range
+	es := generateTestSpanSlice()
+	calls := 0
+	es.Range(func(i int, el Span) bool {
+		assert.Equal(t, calls, i)
+		assert.Equal(t, es.At(i), el)
+		calls++
+		return true
+	})
+	assert.Equal(t, es.Len(), calls)
+
+	// Test Range stops when f returns false
+	calls = 0
+	es.Range(func(i int, el Span) bool {
+		calls++
+		return i < 2
+	})
+	assert.Equal(t, 3, calls)
+}
+
 func TestSpan_MoveTo(t *testing.T) {
 	ms := generateTestSpan()
 	dest := NewSpan()
@@ -681,6 +765,34 @@ func TestSpanEventSlice_RemoveIf(t *testing.T) {
 	assert.Equal(t, 5, filtered.Len())
 }
 
+func TestSpanEventSlice_Range(t *testing.T) {
+	// Test Range on empty slice
+	emptySlice := NewSpanEventSlice()
+	emptySlice.Range(func(i int, el SpanEvent) bool {
+		t.Fail()
+		return false
+	})
+
+	// Test Range
+	es := generateTestSpanEventSlice()
+	calls := 0
+	es.Range(func(i int, el SpanEvent) bool {
+		assert.Equal(t, calls, i)
+		assert.Equal(t, es.At(i), el)
+		calls++
+		return true
+	})
+	assert.Equal(t, es.Len(), calls)
+
+	// Test Range stops when f returns false
+	calls = 0
+	es.Range(func(i int, el SpanEvent) bool {
+		calls++
+		return i < 2
+	})
+	assert.Equal(t, 3, calls)
+}
+
 func TestSpanEvent_MoveTo(t *testing.T) {
 	ms := generateTestSpanEvent()
 	dest := NewSpanEvent()
@@ -841,6 +953,34 @@ func TestSpanLinkSlice_RemoveIf(t *testing.T) {
 	assert.Equal(t, 5, filtered.Len())
 }
 
+func TestSpanLinkSlice_Range(t *testing.T) {
+	// Test Range on empty slice
+	emptySlice := NewSpanLinkSlice()
+	emptySlice.Range(func(i int, el SpanLink) bool {
+		t.Fail()
+		return false
+	})
+
+	// Test Range
+	es := generateTestSpanLinkSlice()
+	calls := 0
+	es.Range(func(i int, el SpanLink) bool {
+		assert.Equal(t, calls, i)
+		assert.Equal(t, es.At(i), el)
+		calls++
+		return true
+	})
+	assert.Equal(t, es.Len(), calls)
+
+	// Test Range stops when f returns false
+	calls = 0
+	es.Range(func(i int, el SpanLink) bool {
+		calls++
+		return i < 2
+	})
+	assert.Equal(t, 3, calls)
+}
+
 func TestSpanLink_MoveTo(t *testing.T) {
 	ms := generateTestSpanLink()
 	dest := NewSpanLink()
@@ -0,0 +1,71 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal // import "go.opentelemetry.io/collector/pdata/internal"
+
+import "bytes"
+
+// OmitEmptyJSONObjects removes from the compact JSON data all the fields with the given keys
+// whose value is an empty object, e.g. `"resource":{}`, including the separating comma.
+// Keys appearing inside JSON strings are not affected.
+func OmitEmptyJSONObjects(data []byte, keys ...string) []byte {
+	patterns := make([][]byte, len(keys))
+	for i, key := range keys {
+		patterns[i] = []byte(`"` + key + `":{}`)
+	}
+
+	out := make([]byte, 0, len(data))
+	inString := false
+	for i := 0; i < len(data); i++ {
+		c := data[i]
+		if inString {
+			out = append(out, c)
+			switch c {
+			case '\\':
+				if i+1 < len(data) {
+					i++
+					out = append(out, data[i])
+				}
+			case '"':
+				inString = false
+			}
+			continue
+		}
+		if c == '"' {
+			if n := matchEmptyObject(data[i:], patterns); n > 0 {
+				i += n - 1
+				switch {
+				case i+1 < len(data) && data[i+1] == ',':
+					i++
+				case len(out) > 0 && out[len(out)-1] == ',':
+					out = out[:len(out)-1]
+				}
+				continue
+			}
+			inString = true
+		}
+		out = append(out, c)
+	}
+	return out
+}
+
+// matchEmptyObject returns the length of the pattern data starts with, or 0 if none.
+func matchEmptyObject(data []byte, patterns [][]byte) int {
+	for _, p := range patterns {
+		if bytes.HasPrefix(data, p) {
+			return len(p)
+		}
+	}
+	return 0
+}
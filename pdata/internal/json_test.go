@@ -0,0 +1,66 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOmitEmptyJSONObjects(t *testing.T) {
+	var testCases = []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "first",
+			input:    `{"resource":{},"scopeMetrics":[]}`,
+			expected: `{"scopeMetrics":[]}`,
+		},
+		{
+			name:     "last",
+			input:    `{"scopeMetrics":[],"resource":{}}`,
+			expected: `{"scopeMetrics":[]}`,
+		},
+		{
+			name:     "only",
+			input:    `[{"scope":{}},{"resource":{}}]`,
+			expected: `[{},{}]`,
+		},
+		{
+			name:     "not_empty",
+			input:    `{"resource":{"attributes":[]},"scope":{"name":"scope"}}`,
+			expected: `{"resource":{"attributes":[]},"scope":{"name":"scope"}}`,
+		},
+		{
+			name:     "other_keys",
+			input:    `{"body":{},"status":{}}`,
+			expected: `{"body":{},"status":{}}`,
+		},
+		{
+			name:     "in_string",
+			input:    `{"stringValue":"\"resource\":{}","key":"\\","scope":{}}`,
+			expected: `{"stringValue":"\"resource\":{}","key":"\\"}`,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, string(OmitEmptyJSONObjects([]byte(test.input), "resource", "scope")))
+		})
+	}
+}
@@ -83,6 +83,30 @@ func (ld Logs) LogRecordCount() int {
 	return logCount
 }
 
+// RemoveIf removes all the log records for which f returns true, then removes the ScopeLogs
+// and ResourceLogs left without any log record, including the ones that were already empty.
+// Elements are removed in place, the backing slices are not reallocated.
+func (ld Logs) RemoveIf(f func(LogRecord) bool) {
+	ld.ResourceLogs().RemoveIf(func(rl ResourceLogs) bool {
+		rl.ScopeLogs().RemoveIf(func(sl ScopeLogs) bool {
+			sl.LogRecords().RemoveIf(f)
+			return sl.LogRecords().Len() == 0
+		})
+		return rl.ScopeLogs().Len() == 0
+	})
+}
+
+// FilterBySeverity removes all the log records with a severity number lower than min, see RemoveIf.
+// The log records without a severity number, SeverityNumberUNDEFINED, are kept if keepUndefined is true.
+func (ld Logs) FilterBySeverity(min SeverityNumber, keepUndefined bool) {
+	ld.RemoveIf(func(lr LogRecord) bool {
+		if lr.SeverityNumber() == SeverityNumberUNDEFINED {
+			return !keepUndefined
+		}
+		return lr.SeverityNumber() < min
+	})
+}
+
 // ResourceLogs returns the ResourceLogsSlice associated with this Logs.
 func (ld Logs) ResourceLogs() ResourceLogsSlice {
 	return newResourceLogsSlice(&ld.orig.ResourceLogs)
@@ -130,6 +130,54 @@ func TestLogsClone(t *testing.T) {
 	assert.EqualValues(t, logs, logs.Clone())
 }
 
+func TestLogsRemoveIf(t *testing.T) {
+	logs := NewLogs()
+	rl := logs.ResourceLogs().AppendEmpty()
+	sl := rl.ScopeLogs().AppendEmpty()
+	sl.LogRecords().AppendEmpty().Body().SetStringVal("keep")
+	sl.LogRecords().AppendEmpty().Body().SetStringVal("remove")
+	logs.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Body().SetStringVal("remove")
+	rl.ScopeLogs().AppendEmpty()
+
+	logs.RemoveIf(func(lr LogRecord) bool {
+		return lr.Body().StringVal() == "remove"
+	})
+
+	assert.Equal(t, 1, logs.ResourceLogs().Len())
+	assert.Equal(t, 1, logs.ResourceLogs().At(0).ScopeLogs().Len())
+	assert.Equal(t, 1, logs.LogRecordCount())
+	assert.Equal(t, "keep", logs.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Body().StringVal())
+}
+
+func TestLogsFilterBySeverity(t *testing.T) {
+	newLogs := func() Logs {
+		logs := NewLogs()
+		lrs := logs.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+		for _, sn := range []SeverityNumber{SeverityNumberDEBUG, SeverityNumberUNDEFINED, SeverityNumberWARN, SeverityNumberINFO, SeverityNumberERROR} {
+			lrs.AppendEmpty().SetSeverityNumber(sn)
+		}
+		logs.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().SetSeverityNumber(SeverityNumberTRACE)
+		return logs
+	}
+	severities := func(logs Logs) []SeverityNumber {
+		var sns []SeverityNumber
+		lrs := logs.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+		for i := 0; i < lrs.Len(); i++ {
+			sns = append(sns, lrs.At(i).SeverityNumber())
+		}
+		return sns
+	}
+
+	logs := newLogs()
+	logs.FilterBySeverity(SeverityNumberINFO, true)
+	assert.Equal(t, 1, logs.ResourceLogs().Len())
+	assert.Equal(t, []SeverityNumber{SeverityNumberUNDEFINED, SeverityNumberWARN, SeverityNumberINFO, SeverityNumberERROR}, severities(logs))
+
+	logs = newLogs()
+	logs.FilterBySeverity(SeverityNumberINFO, false)
+	assert.Equal(t, []SeverityNumber{SeverityNumberWARN, SeverityNumberINFO, SeverityNumberERROR}, severities(logs))
+}
+
 func BenchmarkLogsClone(b *testing.B) {
 	logs := NewLogs()
 	fillTestResourceLogsSlice(logs.ResourceLogs())
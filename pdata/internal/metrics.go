@@ -15,6 +15,12 @@
 package internal // import "go.opentelemetry.io/collector/pdata/internal"
 
 import (
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+
 	otlpcollectormetrics "go.opentelemetry.io/collector/pdata/internal/data/protogen/collector/metrics/v1"
 	otlpmetrics "go.opentelemetry.io/collector/pdata/internal/data/protogen/metrics/v1"
 )
@@ -54,7 +60,8 @@ func NewMetrics() Metrics {
 	return Metrics{orig: &otlpcollectormetrics.ExportMetricsServiceRequest{}}
 }
 
-// Clone returns a copy of MetricData.
+// Clone returns a deep copy of MetricData. The returned Metrics does not share any
+// backing data with the original, so either can be modified without affecting the other.
 func (md Metrics) Clone() Metrics {
 	cloneMd := NewMetrics()
 	md.ResourceMetrics().CopyTo(cloneMd.ResourceMetrics())
@@ -68,12 +75,32 @@ func (md Metrics) MoveTo(dest Metrics) {
 	*md.orig = otlpcollectormetrics.ExportMetricsServiceRequest{}
 }
 
+// MoveAndAppendTo moves all ResourceMetrics from the current struct and appends them to the dest.
+// The current struct is left empty. ResourceMetrics are moved by pointer, so no deep copy is made.
+func (md Metrics) MoveAndAppendTo(dest Metrics) {
+	md.ResourceMetrics().MoveAndAppendTo(dest.ResourceMetrics())
+}
+
+// RemoveIf removes all the metrics for which f returns true, then removes the ScopeMetrics
+// and ResourceMetrics left without any metric, including the ones that were already empty.
+// Elements are removed in place, the backing slices are not reallocated.
+func (md Metrics) RemoveIf(f func(Metric) bool) {
+	md.ResourceMetrics().RemoveIf(func(rm ResourceMetrics) bool {
+		rm.ScopeMetrics().RemoveIf(func(sm ScopeMetrics) bool {
+			sm.Metrics().RemoveIf(f)
+			return sm.Metrics().Len() == 0
+		})
+		return rm.ScopeMetrics().Len() == 0
+	})
+}
+
 // ResourceMetrics returns the ResourceMetricsSlice associated with this Metrics.
 func (md Metrics) ResourceMetrics() ResourceMetricsSlice {
 	return newResourceMetricsSlice(&md.orig.ResourceMetrics)
 }
 
 // MetricCount calculates the total number of metrics.
+// It walks all the ResourceMetrics and ScopeMetrics on every call.
 func (md Metrics) MetricCount() int {
 	metricCount := 0
 	rms := md.ResourceMetrics()
@@ -89,6 +116,7 @@ func (md Metrics) MetricCount() int {
 }
 
 // DataPointCount calculates the total number of data points.
+// It walks all the metrics on every call, use Stats to get all the counts in a single pass.
 func (md Metrics) DataPointCount() (dataPointCount int) {
 	rms := md.ResourceMetrics()
 	for i := 0; i < rms.Len(); i++ {
@@ -97,24 +125,573 @@ func (md Metrics) DataPointCount() (dataPointCount int) {
 		for j := 0; j < ilms.Len(); j++ {
 			ilm := ilms.At(j)
 			ms := ilm.Metrics()
+			for k := 0; k < ms.Len(); k++ {
+				dataPointCount += ms.At(k).dataPointCount()
+			}
+		}
+	}
+	return
+}
+
+// MetricsStats contains the number of elements at every level of a Metrics.
+type MetricsStats struct {
+	ResourceMetrics int
+	ScopeMetrics    int
+	Metrics         int
+	DataPoints      int
+}
+
+// Stats calculates the number of resource metrics, scope metrics, metrics and data points
+// in a single pass over the Metrics.
+func (md Metrics) Stats() MetricsStats {
+	rms := md.ResourceMetrics()
+	stats := MetricsStats{ResourceMetrics: rms.Len()}
+	for i := 0; i < rms.Len(); i++ {
+		ilms := rms.At(i).ScopeMetrics()
+		stats.ScopeMetrics += ilms.Len()
+		for j := 0; j < ilms.Len(); j++ {
+			ms := ilms.At(j).Metrics()
+			stats.Metrics += ms.Len()
+			for k := 0; k < ms.Len(); k++ {
+				stats.DataPoints += ms.At(k).dataPointCount()
+			}
+		}
+	}
+	return stats
+}
+
+func (ms Metric) dataPointCount() int {
+	switch ms.DataType() {
+	case MetricDataTypeGauge:
+		return ms.Gauge().DataPoints().Len()
+	case MetricDataTypeSum:
+		return ms.Sum().DataPoints().Len()
+	case MetricDataTypeHistogram:
+		return ms.Histogram().DataPoints().Len()
+	case MetricDataTypeExponentialHistogram:
+		return ms.ExponentialHistogram().DataPoints().Len()
+	case MetricDataTypeSummary:
+		return ms.Summary().DataPoints().Len()
+	}
+	return 0
+}
+
+// IsEmpty returns true if the metric has no data points, whatever its data type.
+// A metric without a data type, MetricDataTypeNone, is always empty.
+func (ms Metric) IsEmpty() bool {
+	return ms.dataPointCount() == 0
+}
+
+// IsEmpty returns true if none of the metrics has data points, including when there are no metrics.
+func (ms ScopeMetrics) IsEmpty() bool {
+	metrics := ms.Metrics()
+	for i := 0; i < metrics.Len(); i++ {
+		if !metrics.At(i).IsEmpty() {
+			return false
+		}
+	}
+	return true
+}
+
+// IsEmpty returns true if none of the metrics of any scope has data points, including when there are no scopes.
+func (ms ResourceMetrics) IsEmpty() bool {
+	ilms := ms.ScopeMetrics()
+	for i := 0; i < ilms.Len(); i++ {
+		if !ilms.At(i).IsEmpty() {
+			return false
+		}
+	}
+	return true
+}
+
+// RangeDataPointAttributes calls f with the attributes of every data point of every metric,
+// regardless of the metric data type, e.g. to remove or hash some attributes in place.
+func (md Metrics) RangeDataPointAttributes(f func(Map)) {
+	rms := md.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		ilms := rms.At(i).ScopeMetrics()
+		for j := 0; j < ilms.Len(); j++ {
+			ms := ilms.At(j).Metrics()
+			for k := 0; k < ms.Len(); k++ {
+				ms.At(k).rangeDataPointAttributes(f)
+			}
+		}
+	}
+}
+
+func (ms Metric) rangeDataPointAttributes(f func(Map)) {
+	switch ms.DataType() {
+	case MetricDataTypeGauge:
+		dps := ms.Gauge().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			f(dps.At(i).Attributes())
+		}
+	case MetricDataTypeSum:
+		dps := ms.Sum().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			f(dps.At(i).Attributes())
+		}
+	case MetricDataTypeHistogram:
+		dps := ms.Histogram().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			f(dps.At(i).Attributes())
+		}
+	case MetricDataTypeExponentialHistogram:
+		dps := ms.ExponentialHistogram().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			f(dps.At(i).Attributes())
+		}
+	case MetricDataTypeSummary:
+		dps := ms.Summary().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			f(dps.At(i).Attributes())
+		}
+	}
+}
+
+// RemoveNaNAndInf removes the Gauge and Sum data points with a NaN or infinite double value, and the Histogram
+// and ExponentialHistogram data points with a NaN sum, which are rejected by many backends. Data points with an
+// int value are never removed. Returns the number of removed data points, e.g. to report them as dropped.
+func (md Metrics) RemoveNaNAndInf() int {
+	removed := 0
+	rms := md.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		ilms := rms.At(i).ScopeMetrics()
+		for j := 0; j < ilms.Len(); j++ {
+			ms := ilms.At(j).Metrics()
+			for k := 0; k < ms.Len(); k++ {
+				removed += ms.At(k).removeNaNAndInf()
+			}
+		}
+	}
+	return removed
+}
+
+func (ms Metric) removeNaNAndInf() int {
+	removed := 0
+	switch ms.DataType() {
+	case MetricDataTypeGauge:
+		ms.Gauge().DataPoints().RemoveIf(func(dp NumberDataPoint) bool {
+			if isNaNOrInfNumberDataPoint(dp) {
+				removed++
+				return true
+			}
+			return false
+		})
+	case MetricDataTypeSum:
+		ms.Sum().DataPoints().RemoveIf(func(dp NumberDataPoint) bool {
+			if isNaNOrInfNumberDataPoint(dp) {
+				removed++
+				return true
+			}
+			return false
+		})
+	case MetricDataTypeHistogram:
+		ms.Histogram().DataPoints().RemoveIf(func(dp HistogramDataPoint) bool {
+			if dp.HasSum() && math.IsNaN(dp.Sum()) {
+				removed++
+				return true
+			}
+			return false
+		})
+	case MetricDataTypeExponentialHistogram:
+		ms.ExponentialHistogram().DataPoints().RemoveIf(func(dp ExponentialHistogramDataPoint) bool {
+			if math.IsNaN(dp.Sum()) {
+				removed++
+				return true
+			}
+			return false
+		})
+	}
+	return removed
+}
+
+func isNaNOrInfNumberDataPoint(dp NumberDataPoint) bool {
+	if dp.ValueType() != NumberDataPointValueTypeDouble {
+		return false
+	}
+	return math.IsNaN(dp.DoubleVal()) || math.IsInf(dp.DoubleVal(), 0)
+}
+
+// AppendGauge appends a new Gauge metric with the given name and returns its first, empty, data point.
+func (ms ScopeMetrics) AppendGauge(name string) NumberDataPoint {
+	m := ms.appendMetric(name, MetricDataTypeGauge)
+	return m.Gauge().DataPoints().AppendEmpty()
+}
+
+// AppendSum appends a new Sum metric with the given name, aggregation temporality and monotonicity,
+// and returns its first, empty, data point.
+func (ms ScopeMetrics) AppendSum(name string, temporality MetricAggregationTemporality, isMonotonic bool) NumberDataPoint {
+	m := ms.appendMetric(name, MetricDataTypeSum)
+	m.Sum().SetAggregationTemporality(temporality)
+	m.Sum().SetIsMonotonic(isMonotonic)
+	return m.Sum().DataPoints().AppendEmpty()
+}
+
+// AppendHistogram appends a new Histogram metric with the given name and aggregation temporality,
+// and returns its first, empty, data point.
+func (ms ScopeMetrics) AppendHistogram(name string, temporality MetricAggregationTemporality) HistogramDataPoint {
+	m := ms.appendMetric(name, MetricDataTypeHistogram)
+	m.Histogram().SetAggregationTemporality(temporality)
+	return m.Histogram().DataPoints().AppendEmpty()
+}
+
+func (ms ScopeMetrics) appendMetric(name string, dataType MetricDataType) Metric {
+	m := ms.Metrics().AppendEmpty()
+	m.SetName(name)
+	m.SetDataType(dataType)
+	return m
+}
+
+// SortDataPointsByTimestamp sorts in place the data points of every metric by their timestamp.
+// The sort is stable, data points with the same timestamp keep their relative order.
+func (md Metrics) SortDataPointsByTimestamp() {
+	rms := md.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		ilms := rms.At(i).ScopeMetrics()
+		for j := 0; j < ilms.Len(); j++ {
+			ms := ilms.At(j).Metrics()
+			for k := 0; k < ms.Len(); k++ {
+				ms.At(k).sortDataPointsByTimestamp()
+			}
+		}
+	}
+}
+
+func (ms Metric) sortDataPointsByTimestamp() {
+	switch ms.DataType() {
+	case MetricDataTypeGauge:
+		ms.Gauge().DataPoints().Sort(func(a, b NumberDataPoint) bool { return a.Timestamp() < b.Timestamp() })
+	case MetricDataTypeSum:
+		ms.Sum().DataPoints().Sort(func(a, b NumberDataPoint) bool { return a.Timestamp() < b.Timestamp() })
+	case MetricDataTypeHistogram:
+		ms.Histogram().DataPoints().Sort(func(a, b HistogramDataPoint) bool { return a.Timestamp() < b.Timestamp() })
+	case MetricDataTypeExponentialHistogram:
+		ms.ExponentialHistogram().DataPoints().Sort(func(a, b ExponentialHistogramDataPoint) bool { return a.Timestamp() < b.Timestamp() })
+	case MetricDataTypeSummary:
+		ms.Summary().DataPoints().Sort(func(a, b SummaryDataPoint) bool { return a.Timestamp() < b.Timestamp() })
+	}
+}
+
+// DeduplicatePolicy defines which data point Metrics.Deduplicate keeps among duplicates.
+type DeduplicatePolicy int32
+
+const (
+	// DeduplicateKeepLast keeps the last data point of every identity, dropping the earlier ones.
+	DeduplicateKeepLast DeduplicatePolicy = iota
+	// DeduplicateKeepFirst keeps the first data point of every identity, dropping the later ones.
+	DeduplicateKeepFirst
+)
+
+// Deduplicate removes, within every metric, the data points with the same attributes and timestamp
+// as another data point of the metric, keeping only the first or the last one according to policy.
+// The order of attributes doesn't matter when comparing them. It returns the number of removed data points.
+func (md Metrics) Deduplicate(policy DeduplicatePolicy) int {
+	removed := 0
+	rms := md.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		ilms := rms.At(i).ScopeMetrics()
+		for j := 0; j < ilms.Len(); j++ {
+			ms := ilms.At(j).Metrics()
+			for k := 0; k < ms.Len(); k++ {
+				removed += ms.At(k).deduplicate(policy)
+			}
+		}
+	}
+	return removed
+}
+
+func (ms Metric) deduplicate(policy DeduplicatePolicy) int {
+	switch ms.DataType() {
+	case MetricDataTypeGauge:
+		return deduplicateNumberDataPoints(ms.Gauge().DataPoints(), policy)
+	case MetricDataTypeSum:
+		return deduplicateNumberDataPoints(ms.Sum().DataPoints(), policy)
+	case MetricDataTypeHistogram:
+		dps := ms.Histogram().DataPoints()
+		drop, removed := duplicates(dps.Len(), policy, func(i int) string {
+			return dataPointKey(dps.At(i).Attributes(), dps.At(i).Timestamp())
+		})
+		i := 0
+		dps.RemoveIf(func(HistogramDataPoint) bool { i++; return drop[i-1] })
+		return removed
+	case MetricDataTypeExponentialHistogram:
+		dps := ms.ExponentialHistogram().DataPoints()
+		drop, removed := duplicates(dps.Len(), policy, func(i int) string {
+			return dataPointKey(dps.At(i).Attributes(), dps.At(i).Timestamp())
+		})
+		i := 0
+		dps.RemoveIf(func(ExponentialHistogramDataPoint) bool { i++; return drop[i-1] })
+		return removed
+	case MetricDataTypeSummary:
+		dps := ms.Summary().DataPoints()
+		drop, removed := duplicates(dps.Len(), policy, func(i int) string {
+			return dataPointKey(dps.At(i).Attributes(), dps.At(i).Timestamp())
+		})
+		i := 0
+		dps.RemoveIf(func(SummaryDataPoint) bool { i++; return drop[i-1] })
+		return removed
+	}
+	return 0
+}
+
+func deduplicateNumberDataPoints(dps NumberDataPointSlice, policy DeduplicatePolicy) int {
+	drop, removed := duplicates(dps.Len(), policy, func(i int) string {
+		return dataPointKey(dps.At(i).Attributes(), dps.At(i).Timestamp())
+	})
+	i := 0
+	dps.RemoveIf(func(NumberDataPoint) bool { i++; return drop[i-1] })
+	return removed
+}
+
+// duplicates returns, for each of the n data points identified by key, whether it must be dropped
+// according to policy, and the number of data points to drop.
+func duplicates(n int, policy DeduplicatePolicy, key func(int) string) ([]bool, int) {
+	drop := make([]bool, n)
+	kept := make(map[string]int, n)
+	removed := 0
+	for i := 0; i < n; i++ {
+		k := key(i)
+		prev, ok := kept[k]
+		if !ok {
+			kept[k] = i
+			continue
+		}
+		removed++
+		if policy == DeduplicateKeepFirst {
+			drop[i] = true
+			continue
+		}
+		drop[prev] = true
+		kept[k] = i
+	}
+	return drop, removed
+}
+
+// dataPointKey returns the identity of a data point, which doesn't depend on the order of the attributes.
+func dataPointKey(attrs Map, ts Timestamp) string {
+	return strconv.FormatUint(uint64(ts), 10) + "\x00" + AttributesKey(attrs)
+}
+
+// RenameMetric sets the name of all the metrics named from to to, and returns the number of renamed metrics.
+func (md Metrics) RenameMetric(from, to string) int {
+	renamed := 0
+	rms := md.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		ilms := rms.At(i).ScopeMetrics()
+		for j := 0; j < ilms.Len(); j++ {
+			ms := ilms.At(j).Metrics()
+			for k := 0; k < ms.Len(); k++ {
+				if m := ms.At(k); m.Name() == from {
+					m.SetName(to)
+					renamed++
+				}
+			}
+		}
+	}
+	return renamed
+}
+
+// AttributeCollisionPolicy defines which value Metrics.RenameAttributeKey keeps when the new key
+// of an attribute is already used by another attribute.
+type AttributeCollisionPolicy int32
+
+const (
+	// AttributeCollisionKeepExisting keeps the value of the attribute already using the new key,
+	// dropping the value of the renamed attribute.
+	AttributeCollisionKeepExisting AttributeCollisionPolicy = iota
+	// AttributeCollisionOverwrite replaces the value of the attribute already using the new key
+	// with the value of the renamed attribute.
+	AttributeCollisionOverwrite
+)
+
+// RenameAttributeKey renames the attribute from to to in the attributes of every data point of every metric.
+// If a data point already has an attribute to, the two attributes are merged according to policy, so the data
+// point always ends with a single attribute to. The order of the attributes is kept, unless they are merged.
+// Returns the number of data points whose attributes changed.
+func (md Metrics) RenameAttributeKey(from, to string, policy AttributeCollisionPolicy) int {
+	renamed := 0
+	if from == to {
+		return renamed
+	}
+	md.RangeDataPointAttributes(func(attrs Map) {
+		if attrs.renameKey(from, to, policy) {
+			renamed++
+		}
+	})
+	return renamed
+}
+
+// renameKey renames the key from to to, see Metrics.RenameAttributeKey. Returns false if there is no key from.
+func (m Map) renameKey(from, to string, policy AttributeCollisionPolicy) bool {
+	fromIdx, toIdx := -1, -1
+	for i := range *m.orig {
+		switch (*m.orig)[i].Key {
+		case from:
+			fromIdx = i
+		case to:
+			toIdx = i
+		}
+	}
+	if fromIdx < 0 {
+		return false
+	}
+	if toIdx < 0 {
+		(*m.orig)[fromIdx].Key = to
+		return true
+	}
+	if policy == AttributeCollisionOverwrite {
+		(*m.orig)[toIdx].Value = (*m.orig)[fromIdx].Value
+	}
+	m.Remove(from)
+	return true
+}
+
+// Diff returns a human-readable description of the differences between md and other, one per line, or an empty
+// string if they are equal, e.g. to report which data point differs when a test comparing two Metrics fails.
+// Every line contains the path of a difference, using the OTLP/JSON field names, followed by the value in md
+// and the value in other, for example:
+//
+//	resourceMetrics[0].scopeMetrics[0].metrics[1]("requests").sum.dataPoints[0].asInt: 5 != 6
+//
+// Elements of lists are compared by position, and attributes by key regardless of their order.
+func (md Metrics) Diff(other Metrics) string {
+	var diffs []string
+	diffValues("", reflect.ValueOf(md.orig).Elem(), reflect.ValueOf(other.orig).Elem(), &diffs)
+	return strings.Join(diffs, "\n")
+}
+
+// SplitByDataPointCount returns copies of md split in batches of at most max data points, keeping the
+// order of the data points. A data point is never split, and a resource, scope or metric is repeated
+// in consecutive batches only when its data points don't fit in a single batch. md is not modified.
+// A single copy of md is returned if it doesn't have more than max data points, or if max is not positive.
+func (md Metrics) SplitByDataPointCount(max int) []Metrics {
+	if max <= 0 || md.DataPointCount() <= max {
+		return []Metrics{md.Clone()}
+	}
+
+	s := &dataPointSplitter{max: max}
+	s.newBatch()
+	rms := md.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		rm := rms.At(i)
+		s.hasResource = false
+		ilms := rm.ScopeMetrics()
+		if ilms.Len() == 0 {
+			// Copied only when they are empty, otherwise with their first data point,
+			// so that a batch doesn't end with an empty resource, scope or metric.
+			s.resource(rm)
+		}
+		for j := 0; j < ilms.Len(); j++ {
+			ilm := ilms.At(j)
+			s.hasScope = false
+			ms := ilm.Metrics()
+			if ms.Len() == 0 {
+				s.scope(rm, ilm)
+			}
 			for k := 0; k < ms.Len(); k++ {
 				m := ms.At(k)
-				switch m.DataType() {
-				case MetricDataTypeGauge:
-					dataPointCount += m.Gauge().DataPoints().Len()
-				case MetricDataTypeSum:
-					dataPointCount += m.Sum().DataPoints().Len()
-				case MetricDataTypeHistogram:
-					dataPointCount += m.Histogram().DataPoints().Len()
-				case MetricDataTypeExponentialHistogram:
-					dataPointCount += m.ExponentialHistogram().DataPoints().Len()
-				case MetricDataTypeSummary:
-					dataPointCount += m.Summary().DataPoints().Len()
+				s.hasMetric = false
+				if m.dataPointCount() == 0 {
+					s.metric(rm, ilm, m)
+				}
+				for l := 0; l < m.dataPointCount(); l++ {
+					if s.count == s.max {
+						s.newBatch()
+					}
+					copyDataPoint(m, l, s.metric(rm, ilm, m))
+					s.count++
 				}
 			}
 		}
 	}
-	return
+	return s.batches
+}
+
+// dataPointSplitter keeps the state of Metrics.SplitByDataPointCount: the batches and the resource,
+// scope and metric of the last batch the data points of the current source metric are copied to.
+type dataPointSplitter struct {
+	max     int
+	batches []Metrics
+	// count is the number of data points in the last batch.
+	count int
+
+	destResource ResourceMetrics
+	destScope    ScopeMetrics
+	destMetric   Metric
+	hasResource  bool
+	hasScope     bool
+	hasMetric    bool
+}
+
+func (s *dataPointSplitter) newBatch() {
+	s.batches = append(s.batches, NewMetrics())
+	s.count = 0
+	s.hasResource = false
+	s.hasScope = false
+	s.hasMetric = false
+}
+
+// resource returns the copy of rm in the last batch, creating it if needed.
+func (s *dataPointSplitter) resource(rm ResourceMetrics) ResourceMetrics {
+	if !s.hasResource {
+		s.destResource = s.batches[len(s.batches)-1].ResourceMetrics().AppendEmpty()
+		rm.Resource().CopyTo(s.destResource.Resource())
+		s.destResource.SetSchemaUrl(rm.SchemaUrl())
+		s.hasResource = true
+		s.hasScope = false
+	}
+	return s.destResource
+}
+
+// scope returns the copy of ilm in the last batch, without its metrics, creating it if needed.
+func (s *dataPointSplitter) scope(rm ResourceMetrics, ilm ScopeMetrics) ScopeMetrics {
+	if !s.hasScope {
+		s.destScope = s.resource(rm).ScopeMetrics().AppendEmpty()
+		ilm.Scope().CopyTo(s.destScope.Scope())
+		s.destScope.SetSchemaUrl(ilm.SchemaUrl())
+		s.hasScope = true
+		s.hasMetric = false
+	}
+	return s.destScope
+}
+
+// metric returns the copy of m in the last batch, without its data points, creating it if needed.
+func (s *dataPointSplitter) metric(rm ResourceMetrics, ilm ScopeMetrics, m Metric) Metric {
+	if !s.hasMetric {
+		s.destMetric = s.scope(rm, ilm).Metrics().AppendEmpty()
+		s.destMetric.SetName(m.Name())
+		s.destMetric.SetDescription(m.Description())
+		s.destMetric.SetUnit(m.Unit())
+		s.destMetric.SetDataType(m.DataType())
+		switch m.DataType() {
+		case MetricDataTypeSum:
+			s.destMetric.Sum().SetAggregationTemporality(m.Sum().AggregationTemporality())
+			s.destMetric.Sum().SetIsMonotonic(m.Sum().IsMonotonic())
+		case MetricDataTypeHistogram:
+			s.destMetric.Histogram().SetAggregationTemporality(m.Histogram().AggregationTemporality())
+		case MetricDataTypeExponentialHistogram:
+			s.destMetric.ExponentialHistogram().SetAggregationTemporality(m.ExponentialHistogram().AggregationTemporality())
+		}
+		s.hasMetric = true
+	}
+	return s.destMetric
+}
+
+// copyDataPoint appends a copy of the i-th data point of src to the data points of dest, of the same type.
+func copyDataPoint(src Metric, i int, dest Metric) {
+	switch src.DataType() {
+	case MetricDataTypeGauge:
+		src.Gauge().DataPoints().At(i).CopyTo(dest.Gauge().DataPoints().AppendEmpty())
+	case MetricDataTypeSum:
+		src.Sum().DataPoints().At(i).CopyTo(dest.Sum().DataPoints().AppendEmpty())
+	case MetricDataTypeHistogram:
+		src.Histogram().DataPoints().At(i).CopyTo(dest.Histogram().DataPoints().AppendEmpty())
+	case MetricDataTypeExponentialHistogram:
+		src.ExponentialHistogram().DataPoints().At(i).CopyTo(dest.ExponentialHistogram().DataPoints().AppendEmpty())
+	case MetricDataTypeSummary:
+		src.Summary().DataPoints().At(i).CopyTo(dest.Summary().DataPoints().AppendEmpty())
+	}
 }
 
 // MetricDataType specifies the type of data in a Metric.
@@ -148,6 +725,17 @@ func (mdt MetricDataType) String() string {
 	return ""
 }
 
+// ParseMetricDataType returns the MetricDataType with the given string representation,
+// as returned by MetricDataType.String. The comparison is case-insensitive.
+func ParseMetricDataType(s string) (MetricDataType, error) {
+	for mdt := MetricDataTypeNone; mdt <= MetricDataTypeSummary; mdt++ {
+		if strings.EqualFold(s, mdt.String()) {
+			return mdt, nil
+		}
+	}
+	return MetricDataTypeNone, fmt.Errorf("unknown metric data type %q", s)
+}
+
 // SetDataType clears any existing data and initialize it with an empty data of the given type.
 // Calling this function on zero-initialized Metric will cause a panic.
 func (ms Metric) SetDataType(ty MetricDataType) {
@@ -180,7 +768,26 @@ const (
 
 // String returns the string representation of the MetricAggregationTemporality.
 func (at MetricAggregationTemporality) String() string {
-	return otlpmetrics.AggregationTemporality(at).String()
+	switch at {
+	case MetricAggregationTemporalityUnspecified:
+		return "Unspecified"
+	case MetricAggregationTemporalityDelta:
+		return "Delta"
+	case MetricAggregationTemporalityCumulative:
+		return "Cumulative"
+	}
+	return ""
+}
+
+// ParseMetricAggregationTemporality returns the MetricAggregationTemporality with the given string
+// representation, as returned by MetricAggregationTemporality.String. The comparison is case-insensitive.
+func ParseMetricAggregationTemporality(s string) (MetricAggregationTemporality, error) {
+	for _, at := range []MetricAggregationTemporality{MetricAggregationTemporalityUnspecified, MetricAggregationTemporalityDelta, MetricAggregationTemporalityCumulative} {
+		if strings.EqualFold(s, at.String()) {
+			return at, nil
+		}
+	}
+	return MetricAggregationTemporalityUnspecified, fmt.Errorf("unknown metric aggregation temporality %q", s)
 }
 
 // MetricDataPointFlags defines how a metric aggregator reports aggregated values.
@@ -284,3 +891,150 @@ func (ot OptionalType) String() string {
 	}
 	return ""
 }
+
+// RangePositiveBuckets calls f sequentially for each populated bucket in the Positive buckets,
+// passing the bucket boundaries computed from the Scale, so that the bucket covers values in
+// the range (lowerBound, upperBound]. If f returns false, RangePositiveBuckets stops the iteration.
+func (ms ExponentialHistogramDataPoint) RangePositiveBuckets(f func(lowerBound, upperBound float64, count uint64) bool) {
+	scale := ms.Scale()
+	rangeBuckets(ms.Positive(), func(index int64, count uint64) bool {
+		return f(exponentialBucketLowerBound(index, scale), exponentialBucketLowerBound(index+1, scale), count)
+	})
+}
+
+// RangeNegativeBuckets calls f sequentially for each populated bucket in the Negative buckets,
+// passing the bucket boundaries computed from the Scale, so that the bucket covers values in
+// the range [lowerBound, upperBound). If f returns false, RangeNegativeBuckets stops the iteration.
+func (ms ExponentialHistogramDataPoint) RangeNegativeBuckets(f func(lowerBound, upperBound float64, count uint64) bool) {
+	scale := ms.Scale()
+	rangeBuckets(ms.Negative(), func(index int64, count uint64) bool {
+		return f(-exponentialBucketLowerBound(index+1, scale), -exponentialBucketLowerBound(index, scale), count)
+	})
+}
+
+func rangeBuckets(b Buckets, f func(index int64, count uint64) bool) {
+	offset := int64(b.Offset())
+	for i, count := range b.orig.BucketCounts {
+		if count == 0 {
+			continue
+		}
+		if !f(offset+int64(i), count) {
+			return
+		}
+	}
+}
+
+// exponentialBucketLowerBound returns base^index, where base = 2^(2^-scale), which is the lower
+// boundary of the bucket with the given index and upper boundary of the previous one.
+func exponentialBucketLowerBound(index int64, scale int32) float64 {
+	if scale <= 0 {
+		return math.Ldexp(1, int(index<<-scale))
+	}
+	// Split the index into the power of two and the fractional part to keep
+	// the boundaries exact for every power of two.
+	exp := index >> scale
+	frac := index & (int64(1)<<scale - 1)
+	return math.Ldexp(math.Exp2(float64(frac)/float64(int64(1)<<scale)), int(exp))
+}
+
+// Rebucket returns a copy of the data point with its bucket counts redistributed into the buckets
+// defined by bounds, e.g. to convert fine-grained histograms to a coarser set of boundaries.
+// The Count, Sum, Min, Max, attributes and exemplars are copied unchanged.
+//
+// The result is an approximation: the values are assumed to be uniformly distributed within every
+// source bucket, so a source bucket overlapping several target buckets is split proportionally to
+// the length of the overlaps. The first and last source buckets are unbounded, so their counts are
+// assigned entirely to the target bucket containing their finite boundary. The count of a data point with
+// a single bucket and no explicit bounds is assigned to the target bucket containing the mean of the values,
+// Sum divided by Count, or 0 if the Sum is not set. Counts are rounded so
+// that the target counts always add up to the source counts.
+//
+// An error is returned if bounds are not strictly increasing finite numbers, or if the bucket counts
+// of the data point don't match its explicit bounds. A data point without buckets is copied unchanged.
+func (ms HistogramDataPoint) Rebucket(bounds []float64) (HistogramDataPoint, error) {
+	for i, b := range bounds {
+		if math.IsNaN(b) || math.IsInf(b, 0) || (i > 0 && b <= bounds[i-1]) {
+			return HistogramDataPoint{}, fmt.Errorf("bounds must be strictly increasing finite numbers, got %v", bounds)
+		}
+	}
+	srcBounds, srcCounts := ms.ExplicitBounds(), ms.BucketCounts()
+	if len(srcCounts) != 0 && len(srcCounts) != len(srcBounds)+1 {
+		return HistogramDataPoint{}, fmt.Errorf("%d bucket counts don't match %d explicit bounds", len(srcCounts), len(srcBounds))
+	}
+
+	dest := NewHistogramDataPoint()
+	ms.CopyTo(dest)
+	if len(srcCounts) == 0 {
+		return dest, nil
+	}
+
+	counts := make([]uint64, len(bounds)+1)
+	for i, count := range srcCounts {
+		switch {
+		case count == 0:
+		case len(srcBounds) == 0:
+			// A single bucket covers (-Inf, +Inf), its values are assigned to the bucket containing their mean.
+			counts[bucketIndex(bounds, meanValue(ms))] += count
+		case i == 0:
+			// Values in (-Inf, srcBounds[0]] are assigned to the bucket containing srcBounds[0].
+			counts[bucketIndex(bounds, srcBounds[0])] += count
+		case i == len(srcBounds):
+			// Values in (srcBounds[i-1], +Inf) are assigned to the bucket containing the values right above srcBounds[i-1].
+			counts[bucketIndex(bounds, math.Nextafter(srcBounds[i-1], math.Inf(1)))] += count
+		default:
+			redistribute(bounds, srcBounds[i-1], srcBounds[i], count, counts)
+		}
+	}
+	dest.SetExplicitBounds(append([]float64(nil), bounds...))
+	dest.SetBucketCounts(counts)
+	return dest, nil
+}
+
+// meanValue returns the mean of the values of the data point, or 0 if it cannot be computed.
+func meanValue(ms HistogramDataPoint) float64 {
+	if !ms.HasSum() || ms.Count() == 0 {
+		return 0
+	}
+	return ms.Sum() / float64(ms.Count())
+}
+
+// bucketIndex returns the index of the bucket containing v, where bucket i covers (bounds[i-1], bounds[i]].
+func bucketIndex(bounds []float64, v float64) int {
+	i := 0
+	for i < len(bounds) && bounds[i] < v {
+		i++
+	}
+	return i
+}
+
+// redistribute adds count, uniformly distributed in (lower, upper], to the counts of the buckets defined by bounds.
+// The cumulative counts are rounded, so the added counts always add up to count.
+func redistribute(bounds []float64, lower, upper float64, count uint64, counts []uint64) {
+	width := upper - lower
+	var cumulative float64
+	var assigned uint64
+	for i := bucketIndex(bounds, math.Nextafter(lower, math.Inf(1))); i < len(counts); i++ {
+		bucketUpper := upper
+		if i < len(bounds) && bounds[i] < upper {
+			bucketUpper = bounds[i]
+		}
+		cumulative += (bucketUpper - math.Max(lower, bucketLower(bounds, i))) / width
+		total := uint64(math.Round(cumulative * float64(count)))
+		if bucketUpper == upper {
+			total = count
+		}
+		counts[i] += total - assigned
+		assigned = total
+		if bucketUpper == upper {
+			return
+		}
+	}
+}
+
+// bucketLower returns the lower boundary of the bucket i defined by bounds.
+func bucketLower(bounds []float64, i int) float64 {
+	if i == 0 {
+		return math.Inf(-1)
+	}
+	return bounds[i-1]
+}
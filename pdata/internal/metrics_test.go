@@ -15,10 +15,14 @@
 package internal
 
 import (
+	"math"
+	"strconv"
+	"strings"
 	"testing"
 
 	gogoproto "github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	goproto "google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
 
@@ -43,6 +47,43 @@ func TestMetricDataTypeString(t *testing.T) {
 	assert.Equal(t, "", (MetricDataTypeSummary + 1).String())
 }
 
+func TestParseMetricDataType(t *testing.T) {
+	for mdt := MetricDataTypeNone; mdt <= MetricDataTypeSummary; mdt++ {
+		parsed, err := ParseMetricDataType(mdt.String())
+		assert.NoError(t, err)
+		assert.Equal(t, mdt, parsed)
+	}
+	parsed, err := ParseMetricDataType("exponentialhistogram")
+	assert.NoError(t, err)
+	assert.Equal(t, MetricDataTypeExponentialHistogram, parsed)
+
+	_, err = ParseMetricDataType("")
+	assert.EqualError(t, err, `unknown metric data type ""`)
+	_, err = ParseMetricDataType("Counter")
+	assert.EqualError(t, err, `unknown metric data type "Counter"`)
+}
+
+func TestMetricAggregationTemporalityString(t *testing.T) {
+	assert.Equal(t, "Unspecified", MetricAggregationTemporalityUnspecified.String())
+	assert.Equal(t, "Delta", MetricAggregationTemporalityDelta.String())
+	assert.Equal(t, "Cumulative", MetricAggregationTemporalityCumulative.String())
+	assert.Equal(t, "", (MetricAggregationTemporalityCumulative + 1).String())
+}
+
+func TestParseMetricAggregationTemporality(t *testing.T) {
+	for _, at := range []MetricAggregationTemporality{MetricAggregationTemporalityUnspecified, MetricAggregationTemporalityDelta, MetricAggregationTemporalityCumulative} {
+		parsed, err := ParseMetricAggregationTemporality(at.String())
+		assert.NoError(t, err)
+		assert.Equal(t, at, parsed)
+	}
+	parsed, err := ParseMetricAggregationTemporality("DELTA")
+	assert.NoError(t, err)
+	assert.Equal(t, MetricAggregationTemporalityDelta, parsed)
+
+	_, err = ParseMetricAggregationTemporality("AGGREGATION_TEMPORALITY_DELTA")
+	assert.EqualError(t, err, `unknown metric aggregation temporality "AGGREGATION_TEMPORALITY_DELTA"`)
+}
+
 func TestNumberDataPointValueTypeString(t *testing.T) {
 	assert.Equal(t, "None", NumberDataPointValueTypeNone.String())
 	assert.Equal(t, "Int", NumberDataPointValueTypeInt.String())
@@ -57,6 +98,236 @@ func TestExemplarValueTypeString(t *testing.T) {
 	assert.Equal(t, "", (ExemplarValueTypeDouble + 1).String())
 }
 
+func TestDataPointExemplars(t *testing.T) {
+	traceID := NewTraceID([16]byte{1, 2, 3, 4, 5, 6, 7, 8, 8, 7, 6, 5, 4, 3, 2, 1})
+	spanID := NewSpanID([8]byte{1, 2, 3, 4, 5, 6, 7, 8})
+
+	hdp := NewHistogramDataPoint()
+	hdp.SetExplicitBounds([]float64{1, 10})
+	hdp.SetBucketCounts([]uint64{0, 1, 0})
+	ex := hdp.Exemplars().AppendEmpty()
+	ex.SetTimestamp(Timestamp(1234567890))
+	ex.SetDoubleVal(5.5)
+	ex.SetTraceID(traceID)
+	ex.SetSpanID(spanID)
+	ex.FilteredAttributes().UpsertString("http.method", "GET")
+
+	require.Equal(t, 1, hdp.Exemplars().Len())
+	got := hdp.Exemplars().At(0)
+	assert.Equal(t, ExemplarValueTypeDouble, got.ValueType())
+	assert.Equal(t, 5.5, got.DoubleVal())
+	assert.Equal(t, traceID, got.TraceID())
+	assert.Equal(t, spanID, got.SpanID())
+	val, ok := got.FilteredAttributes().Get("http.method")
+	require.True(t, ok)
+	assert.Equal(t, "GET", val.StringVal())
+
+	ndp := NewNumberDataPoint()
+	ndp.SetIntVal(7)
+	ex = ndp.Exemplars().AppendEmpty()
+	ex.SetIntVal(7)
+	ex.SetTraceID(traceID)
+	require.Equal(t, 1, ndp.Exemplars().Len())
+	assert.Equal(t, ExemplarValueTypeInt, ndp.Exemplars().At(0).ValueType())
+	assert.Equal(t, int64(7), ndp.Exemplars().At(0).IntVal())
+	assert.Equal(t, traceID, ndp.Exemplars().At(0).TraceID())
+	assert.True(t, ndp.Exemplars().At(0).SpanID().IsEmpty())
+}
+
+type exponentialBucket struct {
+	lower float64
+	upper float64
+	count uint64
+}
+
+func TestExponentialHistogramDataPointRangeBuckets(t *testing.T) {
+	var testCases = []struct {
+		name             string
+		scale            int32
+		offset           int32
+		counts           []uint64
+		expectedPositive []exponentialBucket
+		expectedNegative []exponentialBucket
+	}{
+		{
+			name:   "scale_zero",
+			scale:  0,
+			offset: -1,
+			counts: []uint64{1, 0, 2, 3},
+			expectedPositive: []exponentialBucket{
+				{lower: 0.5, upper: 1, count: 1},
+				{lower: 2, upper: 4, count: 2},
+				{lower: 4, upper: 8, count: 3},
+			},
+			expectedNegative: []exponentialBucket{
+				{lower: -1, upper: -0.5, count: 1},
+				{lower: -4, upper: -2, count: 2},
+				{lower: -8, upper: -4, count: 3},
+			},
+		},
+		{
+			name:   "negative_scale",
+			scale:  -1,
+			offset: 0,
+			counts: []uint64{1, 2},
+			expectedPositive: []exponentialBucket{
+				{lower: 1, upper: 4, count: 1},
+				{lower: 4, upper: 16, count: 2},
+			},
+			expectedNegative: []exponentialBucket{
+				{lower: -4, upper: -1, count: 1},
+				{lower: -16, upper: -4, count: 2},
+			},
+		},
+		{
+			name:   "positive_scale",
+			scale:  1,
+			offset: -2,
+			counts: []uint64{1, 1, 1, 1},
+			expectedPositive: []exponentialBucket{
+				{lower: 0.5, upper: math.Sqrt2 / 2, count: 1},
+				{lower: math.Sqrt2 / 2, upper: 1, count: 1},
+				{lower: 1, upper: math.Sqrt2, count: 1},
+				{lower: math.Sqrt2, upper: 2, count: 1},
+			},
+			expectedNegative: []exponentialBucket{
+				{lower: -math.Sqrt2 / 2, upper: -0.5, count: 1},
+				{lower: -1, upper: -math.Sqrt2 / 2, count: 1},
+				{lower: -math.Sqrt2, upper: -1, count: 1},
+				{lower: -2, upper: -math.Sqrt2, count: 1},
+			},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			dp := NewExponentialHistogramDataPoint()
+			dp.SetScale(test.scale)
+			dp.Positive().SetOffset(test.offset)
+			dp.Positive().SetBucketCounts(test.counts)
+			dp.Negative().SetOffset(test.offset)
+			dp.Negative().SetBucketCounts(test.counts)
+
+			var positive []exponentialBucket
+			dp.RangePositiveBuckets(func(lower, upper float64, count uint64) bool {
+				positive = append(positive, exponentialBucket{lower: lower, upper: upper, count: count})
+				return true
+			})
+			assertExponentialBuckets(t, test.expectedPositive, positive)
+
+			var negative []exponentialBucket
+			dp.RangeNegativeBuckets(func(lower, upper float64, count uint64) bool {
+				negative = append(negative, exponentialBucket{lower: lower, upper: upper, count: count})
+				return true
+			})
+			assertExponentialBuckets(t, test.expectedNegative, negative)
+		})
+	}
+}
+
+func TestExponentialHistogramDataPointRangeBucketsStop(t *testing.T) {
+	dp := NewExponentialHistogramDataPoint()
+	dp.Positive().SetBucketCounts([]uint64{1, 2, 3})
+	calls := 0
+	dp.RangePositiveBuckets(func(lower, upper float64, count uint64) bool {
+		calls++
+		return false
+	})
+	assert.Equal(t, 1, calls)
+}
+
+func TestHistogramDataPointRebucket(t *testing.T) {
+	dp := NewHistogramDataPoint()
+	dp.SetCount(23)
+	dp.SetSum(300)
+	dp.SetMin(-1)
+	dp.Attributes().InsertString("a", "1")
+	dp.SetExplicitBounds([]float64{0, 10, 20, 30})
+	dp.SetBucketCounts([]uint64{1, 10, 4, 6, 2})
+
+	coarse, err := dp.Rebucket([]float64{15, 30})
+	require.NoError(t, err)
+	assert.Equal(t, []float64{15, 30}, coarse.ExplicitBounds())
+	// (10, 20] is split in half, (-Inf, 0] and (30, +Inf) are assigned to the buckets containing their bounds.
+	assert.Equal(t, []uint64{13, 8, 2}, coarse.BucketCounts())
+	assert.Equal(t, uint64(23), coarse.Count())
+	assert.Equal(t, 300.0, coarse.Sum())
+	assert.Equal(t, -1.0, coarse.Min())
+	assert.Equal(t, map[string]interface{}{"a": "1"}, coarse.Attributes().AsRaw())
+	// The source data point is not modified.
+	assert.Equal(t, []uint64{1, 10, 4, 6, 2}, dp.BucketCounts())
+
+	// Rounded counts add up to the source count.
+	dp.SetExplicitBounds([]float64{0, 3})
+	dp.SetBucketCounts([]uint64{0, 10, 0})
+	fine, err := dp.Rebucket([]float64{1, 2, 3})
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{3, 4, 3, 0}, fine.BucketCounts())
+
+	// A single bucket.
+	single, err := dp.Rebucket(nil)
+	require.NoError(t, err)
+	assert.Empty(t, single.ExplicitBounds())
+	assert.Equal(t, []uint64{10}, single.BucketCounts())
+}
+
+func TestHistogramDataPointRebucketSingleBucket(t *testing.T) {
+	dp := NewHistogramDataPoint()
+	dp.SetCount(4)
+	dp.SetSum(50)
+	dp.SetBucketCounts([]uint64{4})
+
+	// The whole count goes to the bucket containing the mean, 12.5.
+	got, err := dp.Rebucket([]float64{10, 20})
+	require.NoError(t, err)
+	assert.Equal(t, []float64{10, 20}, got.ExplicitBounds())
+	assert.Equal(t, []uint64{0, 4, 0}, got.BucketCounts())
+
+	// Empty target bounds keep a single bucket.
+	got, err = dp.Rebucket(nil)
+	require.NoError(t, err)
+	assert.Empty(t, got.ExplicitBounds())
+	assert.Equal(t, []uint64{4}, got.BucketCounts())
+
+	// Without a Sum, the count goes to the bucket containing 0.
+	noSum := NewHistogramDataPoint()
+	noSum.SetCount(4)
+	noSum.SetBucketCounts([]uint64{4})
+	got, err = noSum.Rebucket([]float64{-1, 1})
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{0, 4, 0}, got.BucketCounts())
+}
+
+func TestHistogramDataPointRebucketNoBuckets(t *testing.T) {
+	dp := NewHistogramDataPoint()
+	dp.SetCount(5)
+	got, err := dp.Rebucket([]float64{1, 2})
+	require.NoError(t, err)
+	assert.Equal(t, dp, got)
+}
+
+func TestHistogramDataPointRebucketError(t *testing.T) {
+	dp := NewHistogramDataPoint()
+	for _, bounds := range [][]float64{{2, 1}, {1, 1}, {math.NaN()}, {1, math.Inf(1)}} {
+		_, err := dp.Rebucket(bounds)
+		assert.Error(t, err, bounds)
+	}
+
+	dp.SetExplicitBounds([]float64{1, 2})
+	dp.SetBucketCounts([]uint64{1, 2})
+	_, err := dp.Rebucket([]float64{1})
+	assert.EqualError(t, err, "2 bucket counts don't match 2 explicit bounds")
+}
+
+func assertExponentialBuckets(t *testing.T, expected []exponentialBucket, actual []exponentialBucket) {
+	require.Len(t, actual, len(expected))
+	for i := range expected {
+		assert.InDelta(t, expected[i].lower, actual[i].lower, 1e-12)
+		assert.InDelta(t, expected[i].upper, actual[i].upper, 1e-12)
+		assert.Equal(t, expected[i].count, actual[i].count)
+	}
+}
+
 func TestResourceMetricsWireCompatibility(t *testing.T) {
 	// This test verifies that OTLP ProtoBufs generated using goproto lib in
 	// opentelemetry-proto repository OTLP ProtoBufs generated using gogoproto lib in
@@ -187,6 +458,25 @@ func TestDataPointCountWithEmpty(t *testing.T) {
 	assert.EqualValues(t, 1, generateMetricsEmptyDataPoints().DataPointCount())
 }
 
+func TestMetricsStats(t *testing.T) {
+	md := NewMetrics()
+	assert.Equal(t, MetricsStats{}, md.Stats())
+
+	rms := md.ResourceMetrics()
+	rms.AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+	ilm := rms.AppendEmpty().ScopeMetrics().AppendEmpty().Metrics()
+	rms.At(1).ScopeMetrics().AppendEmpty()
+	ilm.AppendEmpty().SetDataType(MetricDataTypeGauge)
+	ilm.At(0).Gauge().DataPoints().AppendEmpty()
+	ilm.At(0).Gauge().DataPoints().AppendEmpty()
+	ilm.AppendEmpty().SetDataType(MetricDataTypeSummary)
+	ilm.At(1).Summary().DataPoints().AppendEmpty()
+
+	assert.Equal(t, MetricsStats{ResourceMetrics: 2, ScopeMetrics: 3, Metrics: 3, DataPoints: 3}, md.Stats())
+	assert.Equal(t, md.MetricCount(), md.Stats().Metrics)
+	assert.Equal(t, md.DataPointCount(), md.Stats().DataPoints)
+}
+
 func TestDataPointCountWithNilDataPoints(t *testing.T) {
 	metrics := NewMetrics()
 	ilm := metrics.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty()
@@ -224,6 +514,26 @@ func TestHistogramWithValidSum(t *testing.T) {
 	assert.EqualValues(t, histo, dest)
 }
 
+func TestHistogramDataPointMinMaxWire(t *testing.T) {
+	// min and max are the fixed64 fields 11 and 12 of the OTLP HistogramDataPoint.
+	dp := NewHistogramDataPoint()
+	dp.SetMin(1)
+	dp.SetMax(2)
+	wire, err := gogoproto.Marshal(dp.orig)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{
+		0x59, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f,
+		0x61, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x40,
+	}, wire)
+
+	got := NewHistogramDataPoint()
+	require.NoError(t, gogoproto.Unmarshal(wire, got.orig))
+	assert.True(t, got.HasMin())
+	assert.Equal(t, 1.0, got.Min())
+	assert.True(t, got.HasMax())
+	assert.Equal(t, 2.0, got.Max())
+}
+
 func TestMetricsMoveTo(t *testing.T) {
 	metrics := NewMetrics()
 	fillTestResourceMetricsSlice(metrics.ResourceMetrics())
@@ -233,6 +543,207 @@ func TestMetricsMoveTo(t *testing.T) {
 	assert.EqualValues(t, generateTestResourceMetricsSlice(), dest.ResourceMetrics())
 }
 
+func TestMetricsMoveAndAppendTo(t *testing.T) {
+	metrics := NewMetrics()
+	fillTestResourceMetricsSlice(metrics.ResourceMetrics())
+	dest := NewMetrics()
+	metrics.MoveAndAppendTo(dest)
+	assert.EqualValues(t, NewMetrics(), metrics)
+	assert.EqualValues(t, generateTestResourceMetricsSlice(), dest.ResourceMetrics())
+
+	// Append to a non-empty dest.
+	metrics = NewMetrics()
+	fillTestResourceMetricsSlice(metrics.ResourceMetrics())
+	metrics.MoveAndAppendTo(dest)
+	assert.EqualValues(t, NewMetrics(), metrics)
+	assert.Equal(t, 2*generateTestResourceMetricsSlice().Len(), dest.ResourceMetrics().Len())
+	assert.EqualValues(t, generateTestResourceMetricsSlice().At(0), dest.ResourceMetrics().At(generateTestResourceMetricsSlice().Len()))
+}
+
+func TestMetricsRangeDataPointAttributes(t *testing.T) {
+	md := NewMetrics()
+	ms := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics()
+	for _, dt := range []MetricDataType{MetricDataTypeNone, MetricDataTypeGauge, MetricDataTypeSum, MetricDataTypeHistogram, MetricDataTypeExponentialHistogram, MetricDataTypeSummary} {
+		m := ms.AppendEmpty()
+		m.SetDataType(dt)
+		var attrs []Map
+		switch dt {
+		case MetricDataTypeGauge:
+			attrs = append(attrs, m.Gauge().DataPoints().AppendEmpty().Attributes(), m.Gauge().DataPoints().AppendEmpty().Attributes())
+		case MetricDataTypeSum:
+			attrs = append(attrs, m.Sum().DataPoints().AppendEmpty().Attributes())
+		case MetricDataTypeHistogram:
+			attrs = append(attrs, m.Histogram().DataPoints().AppendEmpty().Attributes())
+		case MetricDataTypeExponentialHistogram:
+			attrs = append(attrs, m.ExponentialHistogram().DataPoints().AppendEmpty().Attributes())
+		case MetricDataTypeSummary:
+			attrs = append(attrs, m.Summary().DataPoints().AppendEmpty().Attributes())
+		}
+		for _, attr := range attrs {
+			attr.UpsertString("user.email", "user@example.com")
+			attr.UpsertString("http.method", "GET")
+		}
+	}
+	md.ResourceMetrics().AppendEmpty()
+
+	calls := 0
+	md.RangeDataPointAttributes(func(attrs Map) {
+		calls++
+		attrs.Remove("user.email")
+	})
+	assert.Equal(t, 6, calls)
+	assert.Equal(t, md.DataPointCount(), calls)
+
+	md.RangeDataPointAttributes(func(attrs Map) {
+		_, ok := attrs.Get("user.email")
+		assert.False(t, ok)
+		val, ok := attrs.Get("http.method")
+		assert.True(t, ok)
+		assert.Equal(t, "GET", val.StringVal())
+	})
+}
+
+func TestMetricsRemoveNaNAndInf(t *testing.T) {
+	md := NewMetrics()
+	sm := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty()
+	gauge := sm.Metrics().AppendEmpty()
+	gauge.SetDataType(MetricDataTypeGauge)
+	for _, v := range []float64{1, math.NaN(), math.Inf(1), 2, math.Inf(-1)} {
+		gauge.Gauge().DataPoints().AppendEmpty().SetDoubleVal(v)
+	}
+	gauge.Gauge().DataPoints().AppendEmpty().SetIntVal(3)
+	sum := sm.Metrics().AppendEmpty()
+	sum.SetDataType(MetricDataTypeSum)
+	sum.Sum().DataPoints().AppendEmpty().SetDoubleVal(math.NaN())
+	sum.Sum().DataPoints().AppendEmpty().SetIntVal(0)
+	histogram := sm.Metrics().AppendEmpty()
+	histogram.SetDataType(MetricDataTypeHistogram)
+	histogram.Histogram().DataPoints().AppendEmpty().SetSum(math.NaN())
+	histogram.Histogram().DataPoints().AppendEmpty().SetSum(4)
+	histogram.Histogram().DataPoints().AppendEmpty()
+	expHistogram := sm.Metrics().AppendEmpty()
+	expHistogram.SetDataType(MetricDataTypeExponentialHistogram)
+	expHistogram.ExponentialHistogram().DataPoints().AppendEmpty().SetSum(math.NaN())
+
+	assert.Equal(t, 6, md.RemoveNaNAndInf())
+
+	gdps := gauge.Gauge().DataPoints()
+	require.Equal(t, 3, gdps.Len())
+	assert.Equal(t, float64(1), gdps.At(0).DoubleVal())
+	assert.Equal(t, float64(2), gdps.At(1).DoubleVal())
+	assert.Equal(t, int64(3), gdps.At(2).IntVal())
+	require.Equal(t, 1, sum.Sum().DataPoints().Len())
+	assert.Equal(t, NumberDataPointValueTypeInt, sum.Sum().DataPoints().At(0).ValueType())
+	require.Equal(t, 2, histogram.Histogram().DataPoints().Len())
+	assert.Equal(t, float64(4), histogram.Histogram().DataPoints().At(0).Sum())
+	assert.False(t, histogram.Histogram().DataPoints().At(1).HasSum())
+	assert.Equal(t, 0, expHistogram.ExponentialHistogram().DataPoints().Len())
+
+	assert.Equal(t, 0, md.RemoveNaNAndInf())
+}
+
+func TestScopeMetricsAppendMetrics(t *testing.T) {
+	sm := NewScopeMetrics()
+	sm.AppendGauge("gauge").SetIntVal(1)
+	sm.AppendSum("sum", MetricAggregationTemporalityCumulative, true).SetDoubleVal(2.5)
+	sm.AppendHistogram("histogram", MetricAggregationTemporalityDelta).SetCount(3)
+
+	ms := sm.Metrics()
+	require.Equal(t, 3, ms.Len())
+
+	assert.Equal(t, "gauge", ms.At(0).Name())
+	assert.Equal(t, MetricDataTypeGauge, ms.At(0).DataType())
+	assert.Equal(t, int64(1), ms.At(0).Gauge().DataPoints().At(0).IntVal())
+
+	assert.Equal(t, "sum", ms.At(1).Name())
+	assert.Equal(t, MetricDataTypeSum, ms.At(1).DataType())
+	assert.Equal(t, MetricAggregationTemporalityCumulative, ms.At(1).Sum().AggregationTemporality())
+	assert.True(t, ms.At(1).Sum().IsMonotonic())
+	assert.Equal(t, 2.5, ms.At(1).Sum().DataPoints().At(0).DoubleVal())
+
+	assert.Equal(t, "histogram", ms.At(2).Name())
+	assert.Equal(t, MetricDataTypeHistogram, ms.At(2).DataType())
+	assert.Equal(t, MetricAggregationTemporalityDelta, ms.At(2).Histogram().AggregationTemporality())
+	assert.Equal(t, uint64(3), ms.At(2).Histogram().DataPoints().At(0).Count())
+}
+
+func TestMetricsSortDataPointsByTimestamp(t *testing.T) {
+	md := NewMetrics()
+	ms := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics()
+	gauge := ms.AppendEmpty()
+	gauge.SetDataType(MetricDataTypeGauge)
+	for i, ts := range []Timestamp{30, 10, 20, 10} {
+		dp := gauge.Gauge().DataPoints().AppendEmpty()
+		dp.SetTimestamp(ts)
+		dp.SetIntVal(int64(i))
+	}
+	histogram := ms.AppendEmpty()
+	histogram.SetDataType(MetricDataTypeHistogram)
+	for _, ts := range []Timestamp{20, 10} {
+		histogram.Histogram().DataPoints().AppendEmpty().SetTimestamp(ts)
+	}
+	summary := ms.AppendEmpty()
+	summary.SetDataType(MetricDataTypeSummary)
+	for _, ts := range []Timestamp{20, 10} {
+		summary.Summary().DataPoints().AppendEmpty().SetTimestamp(ts)
+	}
+
+	md.SortDataPointsByTimestamp()
+
+	gdps := gauge.Gauge().DataPoints()
+	var values []int64
+	for i := 0; i < gdps.Len(); i++ {
+		if i > 0 {
+			assert.LessOrEqual(t, gdps.At(i-1).Timestamp(), gdps.At(i).Timestamp())
+		}
+		values = append(values, gdps.At(i).IntVal())
+	}
+	// Data points with the same timestamp keep their relative order.
+	assert.Equal(t, []int64{1, 3, 2, 0}, values)
+	assert.Equal(t, Timestamp(10), histogram.Histogram().DataPoints().At(0).Timestamp())
+	assert.Equal(t, Timestamp(20), histogram.Histogram().DataPoints().At(1).Timestamp())
+	assert.Equal(t, Timestamp(10), summary.Summary().DataPoints().At(0).Timestamp())
+	assert.Equal(t, Timestamp(20), summary.Summary().DataPoints().At(1).Timestamp())
+}
+
+func TestMetricsRemoveIf(t *testing.T) {
+	md := NewMetrics()
+	rms := md.ResourceMetrics()
+	// Resource with a metric to keep and a metric to remove.
+	ilm := rms.AppendEmpty().ScopeMetrics().AppendEmpty().Metrics()
+	ilm.AppendEmpty().SetName("keep")
+	ilm.AppendEmpty().SetName("drop")
+	// Resource with only metrics to remove, compacted after removal.
+	rms.AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty().SetName("drop")
+	// Resource with an empty scope, compacted as well.
+	rms.AppendEmpty().ScopeMetrics().AppendEmpty()
+	backing := &(*rms.orig)[0]
+
+	md.RemoveIf(func(m Metric) bool {
+		return m.Name() == "drop"
+	})
+
+	require.Equal(t, 1, rms.Len())
+	assert.Same(t, backing, &(*rms.orig)[0])
+	require.Equal(t, 1, rms.At(0).ScopeMetrics().Len())
+	require.Equal(t, 1, rms.At(0).ScopeMetrics().At(0).Metrics().Len())
+	assert.Equal(t, "keep", rms.At(0).ScopeMetrics().At(0).Metrics().At(0).Name())
+}
+
+func TestDataPointsRemoveIf(t *testing.T) {
+	dps := NewNumberDataPointSlice()
+	dps.AppendEmpty().SetTimestamp(1)
+	dps.AppendEmpty().SetTimestamp(2)
+	dps.AppendEmpty().SetTimestamp(3)
+
+	// Drop the stale points.
+	dps.RemoveIf(func(dp NumberDataPoint) bool {
+		return dp.Timestamp() < 3
+	})
+	require.Equal(t, 1, dps.Len())
+	assert.Equal(t, Timestamp(3), dps.At(0).Timestamp())
+}
+
 func TestOtlpToInternalReadOnly(t *testing.T) {
 	md := Metrics{orig: &otlpcollectormetrics.ExportMetricsServiceRequest{
 		ResourceMetrics: []*otlpmetrics.ResourceMetrics{
@@ -714,6 +1225,23 @@ func BenchmarkMetricsClone(b *testing.B) {
 	}
 }
 
+func BenchmarkMetricsRemoveIf(b *testing.B) {
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		b.StopTimer()
+		md := NewMetrics()
+		ms := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics()
+		for i := 0; i < 100; i++ {
+			ms.AppendEmpty().SetName(strconv.Itoa(i))
+		}
+		b.StartTimer()
+		// Remove half of the metrics, no allocation is expected.
+		md.RemoveIf(func(m Metric) bool {
+			return m.Name()[len(m.Name())-1]%2 == 0
+		})
+	}
+}
+
 func BenchmarkOtlpToFromInternal_PassThrough(b *testing.B) {
 	req := &otlpcollectormetrics.ExportMetricsServiceRequest{
 		ResourceMetrics: []*otlpmetrics.ResourceMetrics{
@@ -1007,3 +1535,338 @@ func generateMetricsEmptyDataPoints() Metrics {
 		},
 	}}
 }
+
+func TestMetricsDeduplicate(t *testing.T) {
+	newMetrics := func() Metrics {
+		md := NewMetrics()
+		sm := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty()
+		sum := sm.Metrics().AppendEmpty()
+		sum.SetDataType(MetricDataTypeSum)
+		for i, ts := range []Timestamp{1, 2, 1, 1, 2} {
+			dp := sum.Sum().DataPoints().AppendEmpty()
+			dp.SetTimestamp(ts)
+			dp.SetIntVal(int64(i))
+			dp.Attributes().InsertString("a", "1")
+			dp.Attributes().InsertString("b", "2")
+		}
+		// Same attributes in a different order.
+		dp := sum.Sum().DataPoints().AppendEmpty()
+		dp.SetTimestamp(2)
+		dp.SetIntVal(5)
+		dp.Attributes().InsertString("b", "2")
+		dp.Attributes().InsertString("a", "1")
+		// Different attributes.
+		dp = sum.Sum().DataPoints().AppendEmpty()
+		dp.SetTimestamp(1)
+		dp.SetIntVal(6)
+		dp.Attributes().InsertString("a", "2")
+		// Attribute value with a different type.
+		dp = sum.Sum().DataPoints().AppendEmpty()
+		dp.SetTimestamp(1)
+		dp.SetIntVal(7)
+		dp.Attributes().InsertInt("a", 2)
+
+		summary := sm.Metrics().AppendEmpty()
+		summary.SetDataType(MetricDataTypeSummary)
+		for i := 0; i < 2; i++ {
+			sdp := summary.Summary().DataPoints().AppendEmpty()
+			sdp.SetTimestamp(1)
+			sdp.SetCount(uint64(i))
+		}
+		// Duplicates across metrics are kept.
+		other := sm.Metrics().AppendEmpty()
+		other.SetDataType(MetricDataTypeGauge)
+		other.Gauge().DataPoints().AppendEmpty().SetTimestamp(1)
+		return md
+	}
+	values := func(md Metrics) []int64 {
+		dps := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints()
+		var vals []int64
+		for i := 0; i < dps.Len(); i++ {
+			vals = append(vals, dps.At(i).IntVal())
+		}
+		return vals
+	}
+	summaryCounts := func(md Metrics) []uint64 {
+		dps := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(1).Summary().DataPoints()
+		var counts []uint64
+		for i := 0; i < dps.Len(); i++ {
+			counts = append(counts, dps.At(i).Count())
+		}
+		return counts
+	}
+
+	md := newMetrics()
+	assert.Equal(t, 5, md.Deduplicate(DeduplicateKeepLast))
+	assert.Equal(t, []int64{3, 5, 6, 7}, values(md))
+	assert.Equal(t, []uint64{1}, summaryCounts(md))
+	assert.Equal(t, 1, md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(2).Gauge().DataPoints().Len())
+	assert.Equal(t, 0, md.Deduplicate(DeduplicateKeepLast))
+
+	md = newMetrics()
+	assert.Equal(t, 5, md.Deduplicate(DeduplicateKeepFirst))
+	assert.Equal(t, []int64{0, 1, 6, 7}, values(md))
+	assert.Equal(t, []uint64{0}, summaryCounts(md))
+}
+
+func TestMetricsRenameMetric(t *testing.T) {
+	md := NewMetrics()
+	rms := md.ResourceMetrics()
+	rms.AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty().SetName("old")
+	sm := rms.AppendEmpty().ScopeMetrics().AppendEmpty()
+	sm.Metrics().AppendEmpty().SetName("old")
+	sm.Metrics().AppendEmpty().SetName("other")
+
+	assert.Equal(t, 2, md.RenameMetric("old", "new"))
+	assert.Equal(t, "new", rms.At(0).ScopeMetrics().At(0).Metrics().At(0).Name())
+	assert.Equal(t, "new", sm.Metrics().At(0).Name())
+	assert.Equal(t, "other", sm.Metrics().At(1).Name())
+	assert.Equal(t, 0, md.RenameMetric("old", "new"))
+}
+
+func TestMetricsRenameAttributeKey(t *testing.T) {
+	newMetrics := func() Metrics {
+		md := NewMetrics()
+		ms := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics()
+		sum := ms.AppendEmpty()
+		sum.SetDataType(MetricDataTypeSum)
+		// No collision.
+		dp := sum.Sum().DataPoints().AppendEmpty()
+		dp.Attributes().InsertString("old", "v1")
+		dp.Attributes().InsertString("a", "1")
+		// Collision with an existing attribute.
+		dp = sum.Sum().DataPoints().AppendEmpty()
+		dp.Attributes().InsertString("new", "existing")
+		dp.Attributes().InsertString("old", "v2")
+		// Attribute missing.
+		sum.Sum().DataPoints().AppendEmpty().Attributes().InsertString("a", "1")
+
+		histogram := ms.AppendEmpty()
+		histogram.SetDataType(MetricDataTypeHistogram)
+		histogram.Histogram().DataPoints().AppendEmpty().Attributes().InsertInt("old", 3)
+		return md
+	}
+	attributes := func(md Metrics) []map[string]interface{} {
+		var attrs []map[string]interface{}
+		md.RangeDataPointAttributes(func(m Map) {
+			attrs = append(attrs, m.AsRaw())
+		})
+		return attrs
+	}
+
+	md := newMetrics()
+	assert.Equal(t, 3, md.RenameAttributeKey("old", "new", AttributeCollisionKeepExisting))
+	assert.Equal(t, []map[string]interface{}{
+		{"new": "v1", "a": "1"},
+		{"new": "existing"},
+		{"a": "1"},
+		{"new": int64(3)},
+	}, attributes(md))
+	// The order of the attributes is kept.
+	keys := []string{}
+	md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints().At(0).Attributes().Range(func(k string, _ Value) bool {
+		keys = append(keys, k)
+		return true
+	})
+	assert.Equal(t, []string{"new", "a"}, keys)
+	assert.Equal(t, 0, md.RenameAttributeKey("old", "new", AttributeCollisionKeepExisting))
+
+	md = newMetrics()
+	assert.Equal(t, 3, md.RenameAttributeKey("old", "new", AttributeCollisionOverwrite))
+	assert.Equal(t, []map[string]interface{}{
+		{"new": "v1", "a": "1"},
+		{"new": "v2"},
+		{"a": "1"},
+		{"new": int64(3)},
+	}, attributes(md))
+
+	md = newMetrics()
+	assert.Equal(t, 0, md.RenameAttributeKey("old", "old", AttributeCollisionOverwrite))
+	assert.Equal(t, newMetrics(), md)
+}
+
+func TestMetricsDiff(t *testing.T) {
+	newMetrics := func() Metrics {
+		md := NewMetrics()
+		rm := md.ResourceMetrics().AppendEmpty()
+		rm.Resource().Attributes().InsertString("host.name", "host")
+		ms := rm.ScopeMetrics().AppendEmpty().Metrics()
+		gauge := ms.AppendEmpty()
+		gauge.SetName("gauge")
+		gauge.SetDataType(MetricDataTypeGauge)
+		gauge.Gauge().DataPoints().AppendEmpty().SetDoubleVal(1.5)
+		sum := ms.AppendEmpty()
+		sum.SetName("requests")
+		sum.SetDataType(MetricDataTypeSum)
+		dp := sum.Sum().DataPoints().AppendEmpty()
+		dp.SetIntVal(5)
+		dp.SetTimestamp(10)
+		dp.Attributes().InsertString("a", "1")
+		dp.Attributes().InsertInt("b", 2)
+		dp.Exemplars().AppendEmpty().SetTraceID(NewTraceID([16]byte{1}))
+		return md
+	}
+
+	md := newMetrics()
+	assert.Empty(t, md.Diff(newMetrics()))
+	assert.Empty(t, NewMetrics().Diff(NewMetrics()))
+
+	other := newMetrics()
+	ms := other.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	ms.At(0).SetDataType(MetricDataTypeSum)
+	dp := ms.At(1).Sum().DataPoints().At(0)
+	dp.SetIntVal(6)
+	dp.SetTimestamp(11)
+	dp.Attributes().Remove("a")
+	dp.Attributes().UpdateInt("b", 3)
+	dp.Attributes().InsertString("c", "3")
+	dp.Exemplars().At(0).SetTraceID(NewTraceID([16]byte{2}))
+	other.ResourceMetrics().At(0).Resource().Attributes().UpdateString("host.name", "other")
+	other.ResourceMetrics().AppendEmpty()
+
+	assert.Equal(t, strings.Join([]string{
+		`resourceMetrics: 1 elements != 2 elements`,
+		`resourceMetrics[0].resource.attributes["host.name"].stringValue: "host" != "other"`,
+		`resourceMetrics[0].scopeMetrics[0].metrics[0]("gauge"): gauge != sum`,
+		`resourceMetrics[0].scopeMetrics[0].metrics[1]("requests").sum.dataPoints[0].attributes["a"]: "1" != <missing>`,
+		`resourceMetrics[0].scopeMetrics[0].metrics[1]("requests").sum.dataPoints[0].attributes["b"].intValue: 2 != 3`,
+		`resourceMetrics[0].scopeMetrics[0].metrics[1]("requests").sum.dataPoints[0].attributes["c"]: <missing> != "3"`,
+		`resourceMetrics[0].scopeMetrics[0].metrics[1]("requests").sum.dataPoints[0].timeUnixNano: 10 != 11`,
+		`resourceMetrics[0].scopeMetrics[0].metrics[1]("requests").sum.dataPoints[0].asInt: 5 != 6`,
+		`resourceMetrics[0].scopeMetrics[0].metrics[1]("requests").sum.dataPoints[0].exemplars[0].traceId: "01000000000000000000000000000000" != "02000000000000000000000000000000"`,
+	}, "\n"), md.Diff(other))
+
+	// The order of the attributes doesn't matter.
+	md = NewMetrics()
+	attrs := md.ResourceMetrics().AppendEmpty().Resource().Attributes()
+	attrs.InsertString("a", "1")
+	attrs.InsertString("b", "2")
+	other = NewMetrics()
+	attrs = other.ResourceMetrics().AppendEmpty().Resource().Attributes()
+	attrs.InsertString("b", "2")
+	attrs.InsertString("a", "1")
+	assert.Empty(t, md.Diff(other))
+}
+
+func TestMetricsSplitByDataPointCount(t *testing.T) {
+	md := NewMetrics()
+	rm1 := md.ResourceMetrics().AppendEmpty()
+	rm1.Resource().Attributes().InsertString("resource", "r1")
+	sm1 := rm1.ScopeMetrics().AppendEmpty()
+	sm1.Scope().SetName("s1")
+	for i := 0; i < 3; i++ {
+		sm1.AppendGauge("gauge").SetIntVal(int64(i))
+	}
+	sum := sm1.Metrics().AppendEmpty()
+	sum.SetName("sum")
+	sum.SetDataType(MetricDataTypeSum)
+	sum.Sum().SetAggregationTemporality(MetricAggregationTemporalityCumulative)
+	sum.Sum().SetIsMonotonic(true)
+	sum.Sum().DataPoints().AppendEmpty().SetIntVal(10)
+	sum.Sum().DataPoints().AppendEmpty().SetIntVal(11)
+	rm2 := md.ResourceMetrics().AppendEmpty()
+	rm2.Resource().Attributes().InsertString("resource", "r2")
+	sm2 := rm2.ScopeMetrics().AppendEmpty()
+	sm2.Scope().SetName("s2")
+	histogram := sm2.AppendHistogram("histogram", MetricAggregationTemporalityDelta)
+	histogram.SetCount(20)
+	sm2.Metrics().At(0).Histogram().DataPoints().AppendEmpty().SetCount(21)
+	sm2.Metrics().AppendEmpty().SetName("empty")
+	orig := md.Clone()
+
+	batches := md.SplitByDataPointCount(3)
+	assert.Equal(t, orig, md)
+	require.Len(t, batches, 3)
+
+	// The gauge metric was appended three times with one data point each, so it's in the first batch only.
+	assert.Equal(t, 3, batches[0].DataPointCount())
+	require.Equal(t, 1, batches[0].ResourceMetrics().Len())
+	assert.Equal(t, 3, batches[0].ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().Len())
+
+	assert.Equal(t, 3, batches[1].DataPointCount())
+	require.Equal(t, 2, batches[1].ResourceMetrics().Len())
+	r1 := batches[1].ResourceMetrics().At(0)
+	assert.Equal(t, rm1.Resource(), r1.Resource())
+	assert.Equal(t, "s1", r1.ScopeMetrics().At(0).Scope().Name())
+	require.Equal(t, 1, r1.ScopeMetrics().At(0).Metrics().Len())
+	assert.Equal(t, sum, r1.ScopeMetrics().At(0).Metrics().At(0))
+	r2 := batches[1].ResourceMetrics().At(1)
+	assert.Equal(t, rm2.Resource(), r2.Resource())
+	require.Equal(t, 1, r2.ScopeMetrics().At(0).Metrics().Len())
+	h := r2.ScopeMetrics().At(0).Metrics().At(0)
+	assert.Equal(t, "histogram", h.Name())
+	assert.Equal(t, MetricAggregationTemporalityDelta, h.Histogram().AggregationTemporality())
+	require.Equal(t, 1, h.Histogram().DataPoints().Len())
+	assert.Equal(t, uint64(20), h.Histogram().DataPoints().At(0).Count())
+
+	// The histogram and its resource and scope are repeated in the last batch, followed by the empty metric.
+	assert.Equal(t, 1, batches[2].DataPointCount())
+	require.Equal(t, 1, batches[2].ResourceMetrics().Len())
+	r2 = batches[2].ResourceMetrics().At(0)
+	assert.Equal(t, rm2.Resource(), r2.Resource())
+	assert.Equal(t, "s2", r2.ScopeMetrics().At(0).Scope().Name())
+	ms := r2.ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 2, ms.Len())
+	assert.Equal(t, uint64(21), ms.At(0).Histogram().DataPoints().At(0).Count())
+	assert.Equal(t, "empty", ms.At(1).Name())
+
+	// No split needed.
+	batches = md.SplitByDataPointCount(7)
+	require.Len(t, batches, 1)
+	assert.Equal(t, md, batches[0])
+	batches = md.SplitByDataPointCount(0)
+	require.Len(t, batches, 1)
+	assert.Equal(t, md, batches[0])
+
+	// Every batch is full but the last one.
+	batches = md.SplitByDataPointCount(1)
+	require.Len(t, batches, 7)
+	for _, b := range batches {
+		assert.Equal(t, 1, b.DataPointCount())
+	}
+}
+
+func TestMetricsIsEmpty(t *testing.T) {
+	rm := NewResourceMetrics()
+	assert.True(t, rm.IsEmpty())
+	sm := rm.ScopeMetrics().AppendEmpty()
+	assert.True(t, sm.IsEmpty())
+	assert.True(t, rm.IsEmpty())
+
+	none := sm.Metrics().AppendEmpty()
+	assert.True(t, none.IsEmpty())
+	for _, dt := range []MetricDataType{MetricDataTypeGauge, MetricDataTypeSum, MetricDataTypeHistogram, MetricDataTypeExponentialHistogram, MetricDataTypeSummary} {
+		m := sm.Metrics().AppendEmpty()
+		m.SetDataType(dt)
+		assert.True(t, m.IsEmpty(), dt.String())
+	}
+	assert.True(t, sm.IsEmpty())
+	assert.True(t, rm.IsEmpty())
+
+	tests := []struct {
+		dataType MetricDataType
+		append   func(Metric)
+	}{
+		{MetricDataTypeGauge, func(m Metric) { m.Gauge().DataPoints().AppendEmpty() }},
+		{MetricDataTypeSum, func(m Metric) { m.Sum().DataPoints().AppendEmpty() }},
+		{MetricDataTypeHistogram, func(m Metric) { m.Histogram().DataPoints().AppendEmpty() }},
+		{MetricDataTypeExponentialHistogram, func(m Metric) { m.ExponentialHistogram().DataPoints().AppendEmpty() }},
+		{MetricDataTypeSummary, func(m Metric) { m.Summary().DataPoints().AppendEmpty() }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.dataType.String(), func(t *testing.T) {
+			rm := NewResourceMetrics()
+			sms := rm.ScopeMetrics()
+			sms.AppendEmpty()
+			sm := sms.AppendEmpty()
+			sm.Metrics().AppendEmpty().SetDataType(MetricDataTypeGauge)
+			m := sm.Metrics().AppendEmpty()
+			m.SetDataType(tt.dataType)
+			tt.append(m)
+			assert.False(t, m.IsEmpty())
+			assert.True(t, sms.At(0).IsEmpty())
+			assert.False(t, sm.IsEmpty())
+			assert.False(t, rm.IsEmpty())
+		})
+	}
+}
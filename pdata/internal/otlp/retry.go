@@ -0,0 +1,86 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otlp // import "go.opentelemetry.io/collector/pdata/internal/otlp"
+
+import (
+	"errors"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// IsRetryable returns true if the export that returned err can be retried, following the OTLP/gRPC
+// specification: https://github.com/open-telemetry/opentelemetry-specification/blob/main/specification/protocol/otlp.md#failures
+//
+// Canceled, DeadlineExceeded, Aborted, OutOfRange, Unavailable and DataLoss errors are retryable.
+// ResourceExhausted errors are retryable only if the server supplied a RetryInfo, which indicates
+// that it can recover from the resource exhaustion. A nil error is not retryable.
+// The gRPC status is also found in errors wrapping it.
+func IsRetryable(err error) bool {
+	if err == nil {
+		return false
+	}
+	st := grpcStatus(err)
+	switch st.Code() {
+	case codes.Canceled,
+		codes.DeadlineExceeded,
+		codes.Aborted,
+		codes.OutOfRange,
+		codes.Unavailable,
+		codes.DataLoss:
+		return true
+	case codes.ResourceExhausted:
+		return getRetryInfo(st) != nil
+	}
+	return false
+}
+
+// RetryDelay returns the delay requested by the server with a RetryInfo in the status details of err,
+// or 0 if no delay was requested.
+func RetryDelay(err error) time.Duration {
+	if err == nil {
+		return 0
+	}
+	retryInfo := getRetryInfo(grpcStatus(err))
+	if retryInfo == nil || retryInfo.RetryDelay == nil {
+		return 0
+	}
+	if delay := retryInfo.RetryDelay.AsDuration(); delay > 0 {
+		return delay
+	}
+	return 0
+}
+
+// grpcStatus returns the gRPC status of err, or of the first error it wraps having a gRPC status.
+func grpcStatus(err error) *status.Status {
+	var se interface {
+		GRPCStatus() *status.Status
+	}
+	if errors.As(err, &se) {
+		return se.GRPCStatus()
+	}
+	return status.Convert(err)
+}
+
+func getRetryInfo(st *status.Status) *errdetails.RetryInfo {
+	for _, detail := range st.Details() {
+		if retryInfo, ok := detail.(*errdetails.RetryInfo); ok {
+			return retryInfo
+		}
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otlp
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func statusWithRetryInfo(t *testing.T, code codes.Code, delay time.Duration) error {
+	st, err := status.New(code, "error").WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(delay)})
+	require.NoError(t, err)
+	return st.Err()
+}
+
+func TestIsRetryable(t *testing.T) {
+	var testCases = []struct {
+		name      string
+		err       error
+		retryable bool
+		delay     time.Duration
+	}{
+		{name: "nil", err: nil},
+		{name: "not_status", err: errors.New("error")},
+		{name: "ok", err: status.Error(codes.OK, "")},
+		{name: "invalid_argument", err: status.Error(codes.InvalidArgument, "error")},
+		{name: "permission_denied", err: status.Error(codes.PermissionDenied, "error")},
+		{name: "unavailable", err: status.Error(codes.Unavailable, "error"), retryable: true},
+		{name: "deadline_exceeded", err: status.Error(codes.DeadlineExceeded, "error"), retryable: true},
+		{name: "canceled", err: status.Error(codes.Canceled, "error"), retryable: true},
+		{name: "aborted", err: status.Error(codes.Aborted, "error"), retryable: true},
+		{name: "out_of_range", err: status.Error(codes.OutOfRange, "error"), retryable: true},
+		{name: "data_loss", err: status.Error(codes.DataLoss, "error"), retryable: true},
+		{name: "resource_exhausted", err: status.Error(codes.ResourceExhausted, "error")},
+		{name: "resource_exhausted_retry_info", err: statusWithRetryInfo(t, codes.ResourceExhausted, time.Second), retryable: true, delay: time.Second},
+		{name: "unavailable_retry_info", err: statusWithRetryInfo(t, codes.Unavailable, 1500*time.Millisecond), retryable: true, delay: 1500 * time.Millisecond},
+		{name: "wrapped", err: fmt.Errorf("export failed: %w", status.Error(codes.Unavailable, "error")), retryable: true},
+		{name: "negative_delay", err: statusWithRetryInfo(t, codes.Unavailable, -time.Second), retryable: true},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.retryable, IsRetryable(tt.err))
+			assert.Equal(t, tt.delay, RetryDelay(tt.err))
+		})
+	}
+}
@@ -83,6 +83,72 @@ func (td Traces) SpanCount() int {
 	return spanCount
 }
 
+// RemoveIf removes all the spans for which f returns true, then removes the ScopeSpans
+// and ResourceSpans left without any span, including the ones that were already empty.
+// Elements are removed in place, the backing slices are not reallocated.
+func (td Traces) RemoveIf(f func(Span) bool) {
+	td.ResourceSpans().RemoveIf(func(rs ResourceSpans) bool {
+		rs.ScopeSpans().RemoveIf(func(ss ScopeSpans) bool {
+			ss.Spans().RemoveIf(f)
+			return ss.Spans().Len() == 0
+		})
+		return rs.ScopeSpans().Len() == 0
+	})
+}
+
+// spanKey identifies a span across all the ResourceSpans and ScopeSpans.
+type spanKey struct {
+	traceID TraceID
+	spanID  SpanID
+}
+
+// KeepErrorsOnly removes all the spans except the ones with a StatusCodeError status code and their
+// ancestors, so that the kept spans are not orphaned. Ancestors are looked up by parent span ID in the
+// same trace, across all the ResourceSpans and ScopeSpans, see RemoveIf.
+func (td Traces) KeepErrorsOnly() {
+	parents := make(map[spanKey]SpanID)
+	var errorSpans []spanKey
+	rss := td.ResourceSpans()
+	for i := 0; i < rss.Len(); i++ {
+		sss := rss.At(i).ScopeSpans()
+		for j := 0; j < sss.Len(); j++ {
+			spans := sss.At(j).Spans()
+			for k := 0; k < spans.Len(); k++ {
+				span := spans.At(k)
+				key := spanKey{traceID: span.TraceID(), spanID: span.SpanID()}
+				parents[key] = span.ParentSpanID()
+				if span.Status().Code() == StatusCodeError {
+					errorSpans = append(errorSpans, key)
+				}
+			}
+		}
+	}
+
+	kept := make(map[spanKey]struct{}, len(errorSpans))
+	for _, key := range errorSpans {
+		// Walk up the ancestors until the root, a span already kept, or a parent not in td.
+		for {
+			if _, ok := kept[key]; ok {
+				break
+			}
+			kept[key] = struct{}{}
+			parentID := parents[key]
+			if parentID.IsEmpty() {
+				break
+			}
+			key = spanKey{traceID: key.traceID, spanID: parentID}
+			if _, ok := parents[key]; !ok {
+				break
+			}
+		}
+	}
+
+	td.RemoveIf(func(span Span) bool {
+		_, ok := kept[spanKey{traceID: span.TraceID(), spanID: span.SpanID()}]
+		return !ok
+	})
+}
+
 // ResourceSpans returns the ResourceSpansSlice associated with this Metrics.
 func (td Traces) ResourceSpans() ResourceSpansSlice {
 	return newResourceSpansSlice(&td.orig.ResourceSpans)
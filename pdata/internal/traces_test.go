@@ -125,6 +125,65 @@ func TestTracesMoveTo(t *testing.T) {
 	assert.EqualValues(t, generateTestResourceSpansSlice(), dest.ResourceSpans())
 }
 
+func TestTracesRemoveIf(t *testing.T) {
+	td := NewTraces()
+	rs := td.ResourceSpans().AppendEmpty()
+	ss := rs.ScopeSpans().AppendEmpty()
+	ss.Spans().AppendEmpty().SetName("keep")
+	ss.Spans().AppendEmpty().SetName("remove")
+	td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty().SetName("remove")
+	rs.ScopeSpans().AppendEmpty()
+
+	td.RemoveIf(func(span Span) bool {
+		return span.Name() == "remove"
+	})
+
+	assert.Equal(t, 1, td.ResourceSpans().Len())
+	assert.Equal(t, 1, td.ResourceSpans().At(0).ScopeSpans().Len())
+	assert.Equal(t, 1, td.SpanCount())
+	assert.Equal(t, "keep", td.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).Name())
+}
+
+func TestTracesKeepErrorsOnly(t *testing.T) {
+	traceID := NewTraceID([16]byte{1})
+	otherTraceID := NewTraceID([16]byte{2})
+	td := NewTraces()
+	spans := td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans()
+	appendSpan := func(spans SpanSlice, name string, traceID TraceID, spanID, parentID byte, code StatusCode) {
+		span := spans.AppendEmpty()
+		span.SetName(name)
+		span.SetTraceID(traceID)
+		span.SetSpanID(NewSpanID([8]byte{spanID}))
+		if parentID != 0 {
+			span.SetParentSpanID(NewSpanID([8]byte{parentID}))
+		}
+		span.Status().SetCode(code)
+	}
+	appendSpan(spans, "root", traceID, 1, 0, StatusCodeUnset)
+	appendSpan(spans, "parent", traceID, 2, 1, StatusCodeOk)
+	appendSpan(spans, "sibling", traceID, 3, 1, StatusCodeOk)
+	// The child is in another resource.
+	appendSpan(td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans(), "error", traceID, 4, 2, StatusCodeError)
+	// The same span ID in another trace is not an ancestor.
+	appendSpan(spans, "other trace", otherTraceID, 2, 0, StatusCodeOk)
+	// The parent of the error is not in the traces.
+	appendSpan(spans, "orphan error", otherTraceID, 5, 6, StatusCodeError)
+	appendSpan(td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans(), "removed", otherTraceID, 7, 0, StatusCodeUnset)
+
+	td.KeepErrorsOnly()
+
+	var names []string
+	rss := td.ResourceSpans()
+	for i := 0; i < rss.Len(); i++ {
+		spans := rss.At(i).ScopeSpans().At(0).Spans()
+		for j := 0; j < spans.Len(); j++ {
+			names = append(names, spans.At(j).Name())
+		}
+	}
+	assert.Equal(t, []string{"root", "parent", "orphan error", "error"}, names)
+	assert.Equal(t, 2, td.ResourceSpans().Len())
+}
+
 func TestTracesClone(t *testing.T) {
 	traces := NewTraces()
 	fillTestResourceSpansSlice(traces.ResourceSpans())
@@ -0,0 +1,140 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otlptest // import "go.opentelemetry.io/collector/pdata/otlptest"
+
+import (
+	"context"
+	"net"
+	"sync"
+	"sync/atomic"
+
+	"google.golang.org/grpc"
+
+	"go.opentelemetry.io/collector/pdata/plog/plogotlp"
+	"go.opentelemetry.io/collector/pdata/pmetric/pmetricotlp"
+	"go.opentelemetry.io/collector/pdata/ptrace/ptraceotlp"
+)
+
+// DiscardServer is an OTLP/gRPC server for traces, metrics and logs that discards all the received
+// data, only counting the requests and items. It can be configured to return an error from every
+// export call, e.g. to test the retry behavior of a client. It is safe for concurrent use.
+type DiscardServer struct {
+	requests    int64
+	spans       int64
+	dataPoints  int64
+	logRecords  int64
+	errMu       sync.RWMutex
+	err         error
+	grpcServer  *grpc.Server
+	serveWaiter sync.WaitGroup
+}
+
+// NewDiscardServer returns a new DiscardServer that accepts all the requests.
+func NewDiscardServer() *DiscardServer {
+	return &DiscardServer{}
+}
+
+// Register registers the traces, metrics and logs services of the DiscardServer to s.
+func (ds *DiscardServer) Register(s *grpc.Server) {
+	ptraceotlp.RegisterServer(s, &discardTracesServer{ds: ds})
+	pmetricotlp.RegisterServer(s, &discardMetricsServer{ds: ds})
+	plogotlp.RegisterServer(s, &discardLogsServer{ds: ds})
+}
+
+// Start starts serving on a new gRPC server listening on the given endpoint, e.g. "localhost:0",
+// and returns the address the server is listening on. Stop must be called to stop the server.
+func (ds *DiscardServer) Start(endpoint string, opts ...grpc.ServerOption) (net.Addr, error) {
+	lis, err := net.Listen("tcp", endpoint)
+	if err != nil {
+		return nil, err
+	}
+	ds.grpcServer = grpc.NewServer(opts...)
+	ds.Register(ds.grpcServer)
+	ds.serveWaiter.Add(1)
+	go func() {
+		defer ds.serveWaiter.Done()
+		_ = ds.grpcServer.Serve(lis)
+	}()
+	return lis.Addr(), nil
+}
+
+// Stop stops the gRPC server started with Start, closing all the open connections.
+func (ds *DiscardServer) Stop() {
+	if ds.grpcServer == nil {
+		return
+	}
+	ds.grpcServer.Stop()
+	ds.serveWaiter.Wait()
+}
+
+// SetError sets the error returned by every export call, a nil error accepts the requests.
+// Requests are counted even when an error is returned.
+func (ds *DiscardServer) SetError(err error) {
+	ds.errMu.Lock()
+	defer ds.errMu.Unlock()
+	ds.err = err
+}
+
+// RequestCount returns the number of export requests received for all the signals.
+func (ds *DiscardServer) RequestCount() int64 {
+	return atomic.LoadInt64(&ds.requests)
+}
+
+// SpanCount returns the number of spans received.
+func (ds *DiscardServer) SpanCount() int64 {
+	return atomic.LoadInt64(&ds.spans)
+}
+
+// DataPointCount returns the number of metric data points received.
+func (ds *DiscardServer) DataPointCount() int64 {
+	return atomic.LoadInt64(&ds.dataPoints)
+}
+
+// LogRecordCount returns the number of log records received.
+func (ds *DiscardServer) LogRecordCount() int64 {
+	return atomic.LoadInt64(&ds.logRecords)
+}
+
+func (ds *DiscardServer) received(counter *int64, items int) error {
+	atomic.AddInt64(&ds.requests, 1)
+	atomic.AddInt64(counter, int64(items))
+	ds.errMu.RLock()
+	defer ds.errMu.RUnlock()
+	return ds.err
+}
+
+type discardTracesServer struct {
+	ds *DiscardServer
+}
+
+func (s *discardTracesServer) Export(_ context.Context, req ptraceotlp.Request) (ptraceotlp.Response, error) {
+	return ptraceotlp.NewResponse(), s.ds.received(&s.ds.spans, req.Traces().SpanCount())
+}
+
+type discardMetricsServer struct {
+	ds *DiscardServer
+}
+
+func (s *discardMetricsServer) Export(_ context.Context, req pmetricotlp.Request) (pmetricotlp.Response, error) {
+	return pmetricotlp.NewResponse(), s.ds.received(&s.ds.dataPoints, req.Metrics().DataPointCount())
+}
+
+type discardLogsServer struct {
+	ds *DiscardServer
+}
+
+func (s *discardLogsServer) Export(_ context.Context, req plogotlp.Request) (plogotlp.Response, error) {
+	return plogotlp.NewResponse(), s.ds.received(&s.ds.logRecords, req.Logs().LogRecordCount())
+}
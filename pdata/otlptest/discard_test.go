@@ -0,0 +1,91 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otlptest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/plog/plogotlp"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/pmetric/pmetricotlp"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/pdata/ptrace/ptraceotlp"
+)
+
+func TestDiscardServer(t *testing.T) {
+	ds := NewDiscardServer()
+	addr, err := ds.Start("localhost:0")
+	require.NoError(t, err)
+	t.Cleanup(ds.Stop)
+
+	cc, err := grpc.Dial(addr.String(), grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithBlock())
+	require.NoError(t, err)
+	t.Cleanup(func() { assert.NoError(t, cc.Close()) })
+
+	td := ptrace.NewTraces()
+	spans := td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans()
+	spans.AppendEmpty()
+	spans.AppendEmpty()
+	_, err = ptraceotlp.NewClient(cc).Export(context.Background(), ptraceotlp.NewRequestFromTraces(td))
+	require.NoError(t, err)
+
+	md := pmetric.NewMetrics()
+	m := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+	m.SetDataType(pmetric.MetricDataTypeGauge)
+	m.Gauge().DataPoints().AppendEmpty()
+	m.Gauge().DataPoints().AppendEmpty()
+	m.Gauge().DataPoints().AppendEmpty()
+	_, err = pmetricotlp.NewClient(cc).Export(context.Background(), pmetricotlp.NewRequestFromMetrics(md))
+	require.NoError(t, err)
+
+	ld := plog.NewLogs()
+	ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+	_, err = plogotlp.NewClient(cc).Export(context.Background(), plogotlp.NewRequestFromLogs(ld))
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(3), ds.RequestCount())
+	assert.Equal(t, int64(2), ds.SpanCount())
+	assert.Equal(t, int64(3), ds.DataPointCount())
+	assert.Equal(t, int64(1), ds.LogRecordCount())
+
+	// The configured error is returned, and the requests are still counted.
+	ds.SetError(status.Error(codes.Unavailable, "unavailable"))
+	_, err = ptraceotlp.NewClient(cc).Export(context.Background(), ptraceotlp.NewRequestFromTraces(td))
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Equal(t, int64(4), ds.RequestCount())
+	assert.Equal(t, int64(4), ds.SpanCount())
+
+	ds.SetError(nil)
+	_, err = plogotlp.NewClient(cc).Export(context.Background(), plogotlp.NewRequestFromLogs(ld))
+	assert.NoError(t, err)
+}
+
+func TestDiscardServerStopWithoutStart(t *testing.T) {
+	NewDiscardServer().Stop()
+}
+
+func TestDiscardServerStartError(t *testing.T) {
+	_, err := NewDiscardServer().Start("invalid:endpoint:0")
+	assert.Error(t, err)
+}
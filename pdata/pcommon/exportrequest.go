@@ -0,0 +1,42 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pcommon // import "go.opentelemetry.io/collector/pdata/pcommon"
+
+import "io"
+
+// ExportRequest is the interface implemented by the OTLP export requests of all the signals,
+// pmetricotlp.Request, ptraceotlp.Request and plogotlp.Request, allowing to handle them
+// generically, e.g. to split batches based on the encoded size.
+// All the signals expose the same method set, so exporters can serialize any of them the same way.
+type ExportRequest interface {
+	// Size returns the size in bytes of the request encoded with MarshalProto.
+	Size() int
+	// MarshalProto marshals the request into proto bytes.
+	MarshalProto() ([]byte, error)
+	// UnmarshalProto unmarshalls the request from proto bytes.
+	UnmarshalProto(data []byte) error
+	// MarshalProtoTo appends the proto bytes of the request to buf and returns the extended buffer.
+	MarshalProtoTo(buf []byte) ([]byte, error)
+	// MarshalJSON marshals the request into JSON bytes.
+	MarshalJSON() ([]byte, error)
+	// MarshalJSONIndent is like MarshalJSON but indents the output.
+	MarshalJSONIndent(prefix, indent string) ([]byte, error)
+	// UnmarshalJSON unmarshalls the request from JSON bytes.
+	UnmarshalJSON(data []byte) error
+	// UnmarshalJSONFrom unmarshalls the request from JSON read from r.
+	UnmarshalJSONFrom(r io.Reader) error
+	// ItemCount returns the number of data points, spans or log records in the request.
+	ItemCount() int
+}
@@ -52,3 +52,71 @@ func TestLogsJSON_Marshal(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, logsJSON, string(jsonBuf))
 }
+
+func TestLogsJSON_MarshalOmitEmptyResourceAndScope(t *testing.T) {
+	ld := NewLogs()
+	sls := ld.ResourceLogs().AppendEmpty().ScopeLogs()
+	sls.AppendEmpty().LogRecords().AppendEmpty().SetSeverityText("Error")
+	sl := sls.AppendEmpty()
+	sl.Scope().SetName("name")
+	sl.LogRecords().AppendEmpty().SetSeverityText("Error")
+
+	encoder := NewJSONMarshaler(WithOmitEmptyResourceAndScope())
+	jsonBuf, err := encoder.MarshalLogs(ld)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"resourceLogs":[{"scopeLogs":[{"logRecords":[{"severityText":"Error","body":{},"traceId":"","spanId":""}]},{"scope":{"name":"name"},"logRecords":[{"severityText":"Error","body":{},"traceId":"","spanId":""}]}]}]}`, string(jsonBuf))
+
+	got, err := NewJSONUnmarshaler().UnmarshalLogs(jsonBuf)
+	assert.NoError(t, err)
+	assert.EqualValues(t, ld, got)
+
+	// Non empty resource and scope are kept.
+	jsonBuf, err = encoder.MarshalLogs(logsOTLP)
+	assert.NoError(t, err)
+	assert.Equal(t, logsJSON, string(jsonBuf))
+}
+
+func TestLogsJSON_OmitZeroTimestamps(t *testing.T) {
+	ld := NewLogs()
+	lrs := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+	lrs.AppendEmpty().Body().SetStringVal("unset")
+	lr := lrs.AppendEmpty()
+	lr.SetTimestamp(1234)
+	lr.Body().SetStringVal("set")
+
+	jsonBuf, err := NewJSONMarshaler(WithOmitEmptyResourceAndScope()).MarshalLogs(ld)
+	assert.NoError(t, err)
+	assert.NotContains(t, string(jsonBuf), `"timeUnixNano":"0"`)
+	assert.NotContains(t, string(jsonBuf), `"observedTimeUnixNano"`)
+	assert.Contains(t, string(jsonBuf), `"timeUnixNano":"1234"`)
+
+	got, err := NewJSONUnmarshaler().UnmarshalLogs(jsonBuf)
+	assert.NoError(t, err)
+	assert.EqualValues(t, ld, got)
+}
+
+func TestLogsJSON_SnakeCaseFieldNames(t *testing.T) {
+	ld := NewLogs()
+	lr := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+	lr.SetTimestamp(1234)
+	lr.SetSeverityText("Error")
+
+	camelCase, err := NewJSONMarshaler().MarshalLogs(ld)
+	assert.NoError(t, err)
+	assert.Contains(t, string(camelCase), `"timeUnixNano":"1234"`)
+	assert.Contains(t, string(camelCase), `"severityText"`)
+	assert.NotContains(t, string(camelCase), `"time_unix_nano"`)
+
+	snakeCase, err := NewJSONMarshaler(WithSnakeCaseFieldNames()).MarshalLogs(ld)
+	assert.NoError(t, err)
+	assert.Contains(t, string(snakeCase), `"time_unix_nano":"1234"`)
+	assert.Contains(t, string(snakeCase), `"severity_text"`)
+	assert.NotContains(t, string(snakeCase), `"timeUnixNano"`)
+
+	// Both naming conventions are accepted.
+	for _, jsonBuf := range [][]byte{camelCase, snakeCase} {
+		got, err := NewJSONUnmarshaler().UnmarshalLogs(jsonBuf)
+		assert.NoError(t, err)
+		assert.EqualValues(t, ld, got)
+	}
+}
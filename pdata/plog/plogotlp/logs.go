@@ -17,12 +17,23 @@ package plogotlp // import "go.opentelemetry.io/collector/pdata/plog/plogotlp"
 import (
 	"bytes"
 	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync/atomic"
+	"time"
 
 	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	// Register the gzip compressor, so that the server accepts gzip compressed requests
+	// and the client can send them using grpc.UseCompressor(gzip.Name).
+	_ "google.golang.org/grpc/encoding/gzip"
 
 	"go.opentelemetry.io/collector/pdata/internal"
 	otlpcollectorlog "go.opentelemetry.io/collector/pdata/internal/data/protogen/collector/logs/v1"
+	otlplogs "go.opentelemetry.io/collector/pdata/internal/data/protogen/logs/v1"
 	"go.opentelemetry.io/collector/pdata/internal/otlp"
 	"go.opentelemetry.io/collector/pdata/plog"
 )
@@ -30,6 +41,70 @@ import (
 var jsonMarshaler = &jsonpb.Marshaler{}
 var jsonUnmarshaler = &jsonpb.Unmarshaler{}
 
+// JSONCodec marshals and unmarshals the OTLP messages to and from JSON.
+//
+// The values passed to a JSONCodec are the generated protobuf messages of the export requests and
+// responses, they implement the github.com/gogo/protobuf/proto.Message interface. Their concrete types
+// are internal, and change along with the OTLP version, so a JSONCodec must not depend on them.
+// To stay compatible with the other OTLP/JSON implementations, a JSONCodec must follow the protobuf
+// JSON mapping, as the default one using the gogo protobuf jsonpb package does. A codec relying on the
+// struct tags of the messages, as encoding/json or a compatible library does, uses the proto field names,
+// e.g. "resource_logs" instead of "resourceLogs", and does not handle the oneof fields.
+type JSONCodec interface {
+	// Marshal returns the JSON encoding of v.
+	Marshal(v interface{}) ([]byte, error)
+	// Unmarshal decodes the JSON encoded data into v.
+	Unmarshal(data []byte, v interface{}) error
+}
+
+// jsonCodec holds the jsonCodecHolder of the JSONCodec set by SetJSONCodec, if any.
+var jsonCodec atomic.Value
+
+// jsonCodecHolder allows to store any JSONCodec in jsonCodec, which requires values of the same type.
+type jsonCodecHolder struct {
+	codec JSONCodec
+}
+
+// SetJSONCodec sets the JSONCodec used by the MarshalJSON and UnmarshalJSON methods of Request and Response,
+// so a faster JSON library can be plugged in. A nil codec restores the default one.
+// It is safe to call concurrently with the marshaling, the calls already started keep using the previous codec.
+func SetJSONCodec(codec JSONCodec) {
+	if codec == nil {
+		codec = jsonpbCodec{}
+	}
+	jsonCodec.Store(jsonCodecHolder{codec: codec})
+}
+
+// currentJSONCodec returns the JSONCodec set by SetJSONCodec, or the default one.
+func currentJSONCodec() JSONCodec {
+	if holder, ok := jsonCodec.Load().(jsonCodecHolder); ok {
+		return holder.codec
+	}
+	return jsonpbCodec{}
+}
+
+type jsonpbCodec struct{}
+
+func (jsonpbCodec) Marshal(v interface{}) ([]byte, error) {
+	msg, ok := v.(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("cannot marshal %T, not a proto message", v)
+	}
+	var buf bytes.Buffer
+	if err := jsonMarshaler.Marshal(&buf, msg); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (jsonpbCodec) Unmarshal(data []byte, v interface{}) error {
+	msg, ok := v.(proto.Message)
+	if !ok {
+		return fmt.Errorf("cannot unmarshal into %T, not a proto message", v)
+	}
+	return jsonUnmarshaler.Unmarshal(bytes.NewReader(data), msg)
+}
+
 // Response represents the response for gRPC/HTTP client/server.
 type Response struct {
 	orig *otlpcollectorlog.ExportLogsServiceResponse
@@ -52,20 +127,57 @@ func (lr Response) UnmarshalProto(data []byte) error {
 
 // MarshalJSON marshals Response into JSON bytes.
 func (lr Response) MarshalJSON() ([]byte, error) {
-	var buf bytes.Buffer
-	if err := jsonMarshaler.Marshal(&buf, lr.orig); err != nil {
-		return nil, err
-	}
-	return buf.Bytes(), nil
+	return currentJSONCodec().Marshal(lr.orig)
 }
 
 // UnmarshalJSON unmarshalls Response from JSON bytes.
 func (lr Response) UnmarshalJSON(data []byte) error {
-	return jsonUnmarshaler.Unmarshal(bytes.NewReader(data), lr.orig)
+	return currentJSONCodec().Unmarshal(data, lr.orig)
+}
+
+// PartialSuccess returns the PartialSuccess associated with this Response.
+// For a Response without a message, e.g. the one returned along with an error by Client.Export,
+// it returns an empty PartialSuccess that is not associated with the Response.
+func (lr Response) PartialSuccess() PartialSuccess {
+	if lr.orig == nil {
+		return PartialSuccess{orig: &otlpcollectorlog.ExportLogsPartialSuccess{}}
+	}
+	return PartialSuccess{orig: &lr.orig.PartialSuccess}
+}
+
+// PartialSuccess represents the details of a partially successful export request.
+// An empty PartialSuccess (no rejected log records and no error message) is equivalent to a full success.
+type PartialSuccess struct {
+	orig *otlpcollectorlog.ExportLogsPartialSuccess
+}
+
+// RejectedLogRecords returns the number of log records rejected by the server.
+func (ps PartialSuccess) RejectedLogRecords() int64 {
+	return ps.orig.RejectedLogRecords
+}
+
+// SetRejectedLogRecords replaces the number of log records rejected by the server.
+func (ps PartialSuccess) SetRejectedLogRecords(v int64) {
+	ps.orig.RejectedLogRecords = v
+}
+
+// ErrorMessage returns the human-readable message explaining the partial success.
+func (ps PartialSuccess) ErrorMessage() string {
+	return ps.orig.ErrorMessage
+}
+
+// SetErrorMessage replaces the human-readable message explaining the partial success.
+func (ps PartialSuccess) SetErrorMessage(v string) {
+	ps.orig.ErrorMessage = v
 }
 
 // Request represents the request for gRPC/HTTP client/server.
 // It's a wrapper for plog.Logs data.
+//
+// The underlying protobuf message is not exposed, since its generated type is an implementation detail
+// that may change. To integrate with other protobuf tooling, use MarshalProto and UnmarshalProto: the
+// bytes are wire compatible with the opentelemetry.proto.collector.logs.v1.ExportLogsServiceRequest message,
+// e.g. as generated in the go.opentelemetry.io/proto/otlp module.
 type Request struct {
 	orig *otlpcollectorlog.ExportLogsServiceRequest
 }
@@ -82,24 +194,69 @@ func NewRequestFromLogs(l plog.Logs) Request {
 	return Request{orig: internal.LogsToOtlp(l)}
 }
 
+// NewRequestFromLogsCopy returns a Request from a deep copy of plog.Logs.
+// Unlike NewRequestFromLogs, changes to the provided Logs struct are not reflected
+// in the Request and vice versa, so the caller can keep modifying the Logs concurrently.
+func NewRequestFromLogsCopy(l plog.Logs) Request {
+	return NewRequestFromLogs(l.Clone())
+}
+
 // MarshalProto marshals Request into proto bytes.
 func (lr Request) MarshalProto() ([]byte, error) {
 	return lr.orig.Marshal()
 }
 
+// MarshalProtoTo appends the proto bytes of the Request to buf and returns the extended buffer.
+// The buffer is grown only if its capacity is not enough, so a pool of buffers can be reused
+// across calls to avoid allocating a new byte slice for every Request.
+func (lr Request) MarshalProtoTo(buf []byte) ([]byte, error) {
+	size := lr.orig.Size()
+	start := len(buf)
+	if cap(buf)-start < size {
+		grown := make([]byte, start, start+size)
+		copy(grown, buf)
+		buf = grown
+	}
+	buf = buf[:start+size]
+	if _, err := lr.orig.MarshalToSizedBuffer(buf[start:]); err != nil {
+		return buf[:start], err
+	}
+	return buf, nil
+}
+
+// Clone returns a deep copy of the Request, see plog.Logs.Clone.
+// Changes to the returned Request are not reflected in the original and vice versa.
+func (lr Request) Clone() Request {
+	return NewRequestFromLogs(lr.Logs().Clone())
+}
+
+// Size returns the size in bytes of the Request encoded with MarshalProto,
+// computed without marshaling the Request.
+func (lr Request) Size() int {
+	return lr.orig.Size()
+}
+
 // UnmarshalProto unmarshalls Request from proto bytes.
 func (lr Request) UnmarshalProto(data []byte) error {
-	if err := lr.orig.Unmarshal(data); err != nil {
-		return err
-	}
-	otlp.InstrumentationLibraryLogsToScope(lr.orig.ResourceLogs)
-	return nil
+	return lr.orig.Unmarshal(data)
 }
 
 // MarshalJSON marshals Request into JSON bytes.
+// With the default JSONCodec, fields are always emitted in the order they are declared in the
+// OTLP proto definition, so marshaling the same Request always produces the same output.
 func (lr Request) MarshalJSON() ([]byte, error) {
+	return currentJSONCodec().Marshal(lr.orig)
+}
+
+// MarshalJSONIndent is like MarshalJSON but each JSON element begins on a new line
+// starting with prefix followed by one or more copies of indent according to the nesting.
+func (lr Request) MarshalJSONIndent(prefix, indent string) ([]byte, error) {
+	data, err := lr.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
 	var buf bytes.Buffer
-	if err := jsonMarshaler.Marshal(&buf, lr.orig); err != nil {
+	if err = json.Indent(&buf, data, prefix, indent); err != nil {
 		return nil, err
 	}
 	return buf.Bytes(), nil
@@ -107,10 +264,58 @@ func (lr Request) MarshalJSON() ([]byte, error) {
 
 // UnmarshalJSON unmarshalls Request from JSON bytes.
 func (lr Request) UnmarshalJSON(data []byte) error {
-	if err := jsonUnmarshaler.Unmarshal(bytes.NewReader(data), lr.orig); err != nil {
+	return currentJSONCodec().Unmarshal(data, lr.orig)
+}
+
+// UnmarshalJSONFrom unmarshalls Request from JSON read from r.
+// Unlike UnmarshalJSON, the input is decoded incrementally one ResourceLogs at a time,
+// so large payloads can be streamed without first being read into memory.
+// It always uses the default JSONCodec, regardless of SetJSONCodec.
+// The decoded ResourceLogs are appended to the ones already in the Request.
+func (lr Request) UnmarshalJSONFrom(r io.Reader) error {
+	dec := json.NewDecoder(r)
+	if err := expectDelim(dec, '{'); err != nil {
 		return err
 	}
-	otlp.InstrumentationLibraryLogsToScope(lr.orig.ResourceLogs)
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		if key := tok.(string); key != "resourceLogs" && key != "resource_logs" {
+			return fmt.Errorf("unknown field %q in ExportLogsServiceRequest", key)
+		}
+		if tok, err = dec.Token(); err != nil {
+			return err
+		}
+		if tok == nil {
+			continue
+		}
+		if tok != json.Delim('[') {
+			return fmt.Errorf("expected '[' for resourceLogs, got %v", tok)
+		}
+		for dec.More() {
+			rs := &otlplogs.ResourceLogs{}
+			if err = jsonUnmarshaler.UnmarshalNext(dec, rs); err != nil {
+				return err
+			}
+			lr.orig.ResourceLogs = append(lr.orig.ResourceLogs, rs)
+		}
+		if err = expectDelim(dec, ']'); err != nil {
+			return err
+		}
+	}
+	return expectDelim(dec, '}')
+}
+
+func expectDelim(dec *json.Decoder, delim json.Delim) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if tok != delim {
+		return fmt.Errorf("expected %q, got %v", delim, tok)
+	}
 	return nil
 }
 
@@ -123,6 +328,26 @@ func (lr Request) Logs() plog.Logs {
 	return internal.LogsFromOtlp(lr.orig)
 }
 
+// ItemCount returns the number of log records in the Request, which is the canonical OTLP item
+// count for logs. This is the count reported by the collector receivers as
+// otelcol_receiver_accepted_log_records and otelcol_receiver_refused_log_records, and the one used for throttling and batching.
+func (lr Request) ItemCount() int {
+	return lr.Logs().LogRecordCount()
+}
+
+// IsRetryable returns true if the Client.Export call that returned err can be retried,
+// following the OTLP/gRPC specification. ResourceExhausted errors are retryable only if
+// the server supplied a RetryInfo, use RetryDelay to get the delay requested by the server.
+func IsRetryable(err error) bool {
+	return otlp.IsRetryable(err)
+}
+
+// RetryDelay returns the delay before retrying requested by the server with a RetryInfo
+// in the status details of the error returned by Client.Export, or 0 if none was requested.
+func RetryDelay(err error) time.Duration {
+	return otlp.RetryDelay(err)
+}
+
 // Client is the client API for OTLP-GRPC Logs service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
@@ -131,19 +356,52 @@ type Client interface {
 	//
 	// For performance reasons, it is recommended to keep this RPC
 	// alive for the entire life of the application.
+	//
+	// If ctx is already done, a codes.Canceled or codes.DeadlineExceeded status error is
+	// returned without sending the request.
 	Export(ctx context.Context, request Request, opts ...grpc.CallOption) (Response, error)
 }
 
 type logsClient struct {
-	rawClient otlpcollectorlog.LogsServiceClient
+	rawClient      otlpcollectorlog.LogsServiceClient
+	defaultTimeout time.Duration
+}
+
+// Option represents the possible options for NewClient.
+type Option func(*logsClient)
+
+// WithDefaultTimeout sets a timeout applied to every Export call. The context passed to Export
+// is given a deadline d from the start of the call: if it already has a deadline, the earlier of
+// the two is used, so a shorter deadline set by the caller always wins.
+func WithDefaultTimeout(d time.Duration) Option {
+	return func(c *logsClient) {
+		c.defaultTimeout = d
+	}
 }
 
 // NewClient returns a new Client connected using the given connection.
-func NewClient(cc *grpc.ClientConn) Client {
-	return &logsClient{rawClient: otlpcollectorlog.NewLogsServiceClient(cc)}
+//
+// The Client does not own the connection: callers must keep a reference to cc to observe its state,
+// using cc.GetState and cc.WaitForStateChange, and to close it. The grpc.WaitForReady(true) call option
+// makes Export wait for the connection to be ready, instead of failing when it is not.
+func NewClient(cc *grpc.ClientConn, opts ...Option) Client {
+	c := &logsClient{rawClient: otlpcollectorlog.NewLogsServiceClient(cc)}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
 }
 
 func (c *logsClient) Export(ctx context.Context, request Request, opts ...grpc.CallOption) (Response, error) {
+	if err := ctx.Err(); err != nil {
+		// Fail fast, without dialing, with the status code matching the context error.
+		return Response{}, status.FromContextError(err).Err()
+	}
+	if c.defaultTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.defaultTimeout)
+		defer cancel()
+	}
 	rsp, err := c.rawClient.Export(ctx, request.orig, opts...)
 	return Response{orig: rsp}, err
 }
@@ -157,17 +415,78 @@ type Server interface {
 	Export(context.Context, Request) (Response, error)
 }
 
+// ServerOption represents the possible options for RegisterServer.
+type ServerOption func(*interceptedServer)
+
+// WithUnaryServerInterceptors sets interceptors that are called only for the OTLP logs Export method,
+// instead of for every method of the grpc.Server. The first interceptor is the outermost one.
+// Interceptors receive the Request as the req argument and return the Response as the resp value.
+func WithUnaryServerInterceptors(interceptors ...grpc.UnaryServerInterceptor) ServerOption {
+	return func(s *interceptedServer) {
+		s.interceptors = append(s.interceptors, interceptors...)
+	}
+}
+
+// ServiceDesc returns the description of the OTLP gRPC LogsService, as registered by RegisterServer,
+// e.g. to check its method names against the other services registered on the same grpc.Server.
+// The returned description can be registered with grpc.Server.RegisterService and a Server implementation.
+func ServiceDesc() *grpc.ServiceDesc {
+	// The generated handlers are called with the Server wrapped as the generated server interface.
+	desc := otlpcollectorlog.LogsServiceDesc()
+	for i := range desc.Methods {
+		handler := desc.Methods[i].Handler
+		desc.Methods[i].Handler = func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+			return handler(&rawLogsServer{srv: srv.(Server)}, ctx, dec, interceptor)
+		}
+	}
+	desc.HandlerType = (*Server)(nil)
+	return &desc
+}
+
 // RegisterServer registers the Server to the grpc.Server.
-func RegisterServer(s *grpc.Server, srv Server) {
-	otlpcollectorlog.RegisterLogsServiceServer(s, &rawLogsServer{srv: srv})
+// Importing this package registers the gzip compressor, so gzip compressed requests are accepted.
+func RegisterServer(s *grpc.Server, srv Server, opts ...ServerOption) {
+	if len(opts) > 0 {
+		is := &interceptedServer{srv: srv}
+		for _, opt := range opts {
+			opt(is)
+		}
+		srv = is
+	}
+	s.RegisterService(ServiceDesc(), srv)
 }
 
+// exportFullMethod is the full gRPC method name of the Export method, passed to the interceptors.
+const exportFullMethod = "/opentelemetry.proto.collector.logs.v1.LogsService/Export"
+
 type rawLogsServer struct {
 	srv Server
 }
 
-func (s rawLogsServer) Export(ctx context.Context, request *otlpcollectorlog.ExportLogsServiceRequest) (*otlpcollectorlog.ExportLogsServiceResponse, error) {
-	otlp.InstrumentationLibraryLogsToScope(request.ResourceLogs)
+func (s *rawLogsServer) Export(ctx context.Context, request *otlpcollectorlog.ExportLogsServiceRequest) (*otlpcollectorlog.ExportLogsServiceResponse, error) {
 	rsp, err := s.srv.Export(ctx, Request{orig: request})
 	return rsp.orig, err
 }
+
+// interceptedServer is the Server calling the interceptors set by WithUnaryServerInterceptors before the Server.
+type interceptedServer struct {
+	srv          Server
+	interceptors []grpc.UnaryServerInterceptor
+}
+
+func (s *interceptedServer) Export(ctx context.Context, request Request) (Response, error) {
+	info := &grpc.UnaryServerInfo{Server: s.srv, FullMethod: exportFullMethod}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return s.srv.Export(ctx, req.(Request))
+	}
+	for i := len(s.interceptors) - 1; i >= 0; i-- {
+		interceptor, next := s.interceptors[i], handler
+		handler = func(ctx context.Context, req interface{}) (interface{}, error) {
+			return interceptor(ctx, req, info, next)
+		}
+	}
+	resp, err := handler(ctx, request)
+	// Interceptors may return an error without calling the handler.
+	rsp, _ := resp.(Response)
+	return rsp, err
+}
@@ -15,6 +15,7 @@
 package plogotlp
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
@@ -22,17 +23,21 @@ import (
 	"strings"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/encoding/gzip"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/durationpb"
 
-	v1 "go.opentelemetry.io/collector/pdata/internal/data/protogen/logs/v1"
-	"go.opentelemetry.io/collector/pdata/internal/otlp"
+	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/plog"
 )
 
@@ -42,6 +47,8 @@ var _ json.Marshaler = Response{}
 var _ json.Unmarshaler = Request{}
 var _ json.Marshaler = Request{}
 
+var _ pcommon.ExportRequest = Request{}
+
 var logsRequestJSON = []byte(`
 	{
 		"resourceLogs": [
@@ -65,65 +72,37 @@ var logsRequestJSON = []byte(`
 		]
 	}`)
 
-var logsTransitionData = [][]byte{
-	[]byte(`
-	{
-		"resourceLogs": [
-		{
-			"resource": {},
-			"instrumentationLibraryLogs": [
-				{
-					"instrumentationLibrary": {},
-					"logRecords": [
-						{
-							"body": {
-								"stringValue": "test_log_record"
-							},
-							"traceId": "",
-							"spanId": ""
-						}
-					]
-				}
-			]
-		}
-		]
-	}`),
-	[]byte(`
-	{
-		"resourceLogs": [
-		{
-			"resource": {},
-			"instrumentationLibraryLogs": [
-				{
-					"instrumentationLibrary": {},
-					"logRecords": [
-						{
-							"body": {
-								"stringValue": "test_log_record"
-							},
-							"traceId": "",
-							"spanId": ""
-						}
-					]
-				}
-			],
-			"scopeLogs": [
-				{
-					"scope": {},
-					"logRecords": [
-						{
-							"body": {
-								"stringValue": "test_log_record"
-							},
-							"traceId": "",
-							"spanId": ""
-						}
-					]
-				}
-			]
-		}
-		]
-	}`),
+func TestResponsePartialSuccess(t *testing.T) {
+	lr := NewResponse()
+	assert.Equal(t, int64(0), lr.PartialSuccess().RejectedLogRecords())
+	assert.Equal(t, "", lr.PartialSuccess().ErrorMessage())
+
+	lr.PartialSuccess().SetRejectedLogRecords(5)
+	lr.PartialSuccess().SetErrorMessage("throttled")
+	assert.Equal(t, int64(5), lr.PartialSuccess().RejectedLogRecords())
+	assert.Equal(t, "throttled", lr.PartialSuccess().ErrorMessage())
+
+	jsonBuf, err := lr.MarshalJSON()
+	assert.NoError(t, err)
+	assert.Equal(t, `{"partialSuccess":{"rejectedLogRecords":"5","errorMessage":"throttled"}}`, string(jsonBuf))
+	fromJSON := NewResponse()
+	assert.NoError(t, fromJSON.UnmarshalJSON(jsonBuf))
+	assert.Equal(t, lr, fromJSON)
+
+	protoBuf, err := lr.MarshalProto()
+	assert.NoError(t, err)
+	fromProto := NewResponse()
+	assert.NoError(t, fromProto.UnmarshalProto(protoBuf))
+	assert.Equal(t, lr, fromProto)
+}
+
+func TestResponsePartialSuccessEmptyResponse(t *testing.T) {
+	// The Response returned along with an error by Client.Export has no message.
+	ps := Response{}.PartialSuccess()
+	assert.Equal(t, int64(0), ps.RejectedLogRecords())
+	assert.Equal(t, "", ps.ErrorMessage())
+	ps.SetErrorMessage("ignored")
+	assert.Equal(t, "ignored", ps.ErrorMessage())
 }
 
 func TestRequestToPData(t *testing.T) {
@@ -143,15 +122,209 @@ func TestRequestJSON(t *testing.T) {
 	assert.Equal(t, strings.Join(strings.Fields(string(logsRequestJSON)), ""), string(got))
 }
 
-func TestRequestJSONTransition(t *testing.T) {
-	for _, data := range logsTransitionData {
-		lr := NewRequest()
-		assert.NoError(t, lr.UnmarshalJSON(data))
-		assert.Equal(t, "test_log_record", lr.Logs().ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Body().AsString())
+func TestRequestJSONIndent(t *testing.T) {
+	lr := NewRequest()
+	assert.NoError(t, lr.UnmarshalJSON(logsRequestJSON))
+
+	got, err := lr.MarshalJSONIndent("\t", "\t")
+	assert.NoError(t, err)
+	assert.Contains(t, string(got), "\n\t\t\"resourceLogs\": [")
+	assert.Equal(t, strings.Join(strings.Fields(string(logsRequestJSON)), ""), strings.Join(strings.Fields(string(got)), ""))
+}
+
+// stdJSONCodec uses encoding/json, as a compatible library like jsoniter does.
+type stdJSONCodec struct{}
+
+func (stdJSONCodec) Marshal(v interface{}) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func (stdJSONCodec) Unmarshal(data []byte, v interface{}) error {
+	return json.Unmarshal(data, v)
+}
 
-		got, err := lr.MarshalJSON()
-		assert.NoError(t, err)
-		assert.Equal(t, strings.Join(strings.Fields(string(logsRequestJSON)), ""), string(got))
+func TestSetJSONCodec(t *testing.T) {
+	SetJSONCodec(stdJSONCodec{})
+	t.Cleanup(func() { SetJSONCodec(nil) })
+
+	ld := plog.NewLogs()
+	ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().SetSeverityText("info")
+	req := NewRequestFromLogs(ld)
+	got, err := req.MarshalJSON()
+	require.NoError(t, err)
+	// encoding/json uses the proto field names of the struct tags.
+	assert.Contains(t, string(got), `"resource_logs":[`)
+	assert.Contains(t, string(got), `"severity_text":"info"`)
+	decoded := NewRequest()
+	require.NoError(t, decoded.UnmarshalJSON(got))
+	assert.Equal(t, req, decoded)
+
+	resp := NewResponse()
+	resp.PartialSuccess().SetErrorMessage("error")
+	got, err = resp.MarshalJSON()
+	require.NoError(t, err)
+	assert.Equal(t, `{"partial_success":{"error_message":"error"}}`, string(got))
+	decodedResp := NewResponse()
+	require.NoError(t, decodedResp.UnmarshalJSON(got))
+	assert.Equal(t, "error", decodedResp.PartialSuccess().ErrorMessage())
+
+	SetJSONCodec(nil)
+	got, err = req.MarshalJSON()
+	require.NoError(t, err)
+	assert.Contains(t, string(got), `"resourceLogs":[`)
+
+	_, err = jsonpbCodec{}.Marshal("not a proto")
+	assert.EqualError(t, err, "cannot marshal string, not a proto message")
+	assert.EqualError(t, jsonpbCodec{}.Unmarshal([]byte("{}"), "not a proto"), "cannot unmarshal into string, not a proto message")
+}
+
+func TestSetJSONCodecConcurrent(t *testing.T) {
+	t.Cleanup(func() { SetJSONCodec(nil) })
+	req := NewRequest()
+	require.NoError(t, req.UnmarshalJSON(logsRequestJSON))
+
+	wg := sync.WaitGroup{}
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			SetJSONCodec(stdJSONCodec{})
+			SetJSONCodec(nil)
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			_, err := req.MarshalJSON()
+			assert.NoError(t, err)
+		}
+	}()
+	wg.Wait()
+}
+
+func TestRequestSize(t *testing.T) {
+	lr := NewRequest()
+	assert.Equal(t, 0, lr.Size())
+
+	assert.NoError(t, lr.UnmarshalJSON(logsRequestJSON))
+	buf, err := lr.MarshalProto()
+	assert.NoError(t, err)
+	assert.Equal(t, len(buf), lr.Size())
+}
+
+func TestRequestProto(t *testing.T) {
+	lr := NewRequest()
+	assert.NoError(t, lr.UnmarshalJSON(logsRequestJSON))
+
+	buf, err := lr.MarshalProto()
+	assert.NoError(t, err)
+
+	got := NewRequest()
+	assert.NoError(t, got.UnmarshalProto(buf))
+	assert.Equal(t, lr, got)
+}
+
+func TestRequestClone(t *testing.T) {
+	lr := NewRequest()
+	assert.NoError(t, lr.UnmarshalJSON(logsRequestJSON))
+
+	clone := lr.Clone()
+	assert.Equal(t, lr, clone)
+
+	// Modifying the clone must not change the original.
+	clone.Logs().ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Body().SetStringVal("changed")
+	clone.Logs().ResourceLogs().AppendEmpty()
+	assert.Equal(t, "test_log_record", lr.Logs().ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Body().StringVal())
+	assert.Equal(t, 1, lr.Logs().ResourceLogs().Len())
+}
+
+func TestNewRequestFromLogs(t *testing.T) {
+	ld := generateLogsRequest().Logs()
+
+	shared := NewRequestFromLogs(ld)
+	copied := NewRequestFromLogsCopy(ld)
+	assert.Equal(t, shared, copied)
+
+	// Changes to the Logs are reflected only in the Request sharing the data.
+	ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Body().SetStringVal("changed")
+	ld.ResourceLogs().AppendEmpty()
+	assert.Equal(t, ld, shared.Logs())
+	assert.Equal(t, generateLogsRequest().Logs(), copied.Logs())
+}
+
+func TestRequestMarshalProtoTo(t *testing.T) {
+	lr := NewRequest()
+	assert.NoError(t, lr.UnmarshalJSON(logsRequestJSON))
+	expected, err := lr.MarshalProto()
+	require.NoError(t, err)
+
+	// Nil buffer.
+	buf, err := lr.MarshalProtoTo(nil)
+	require.NoError(t, err)
+	assert.Equal(t, expected, buf)
+
+	// The existing content is preserved.
+	buf, err = lr.MarshalProtoTo([]byte("prefix"))
+	require.NoError(t, err)
+	assert.Equal(t, append([]byte("prefix"), expected...), buf)
+
+	// A buffer with enough capacity is reused.
+	reused := make([]byte, 0, len(expected)+10)
+	buf, err = lr.MarshalProtoTo(reused)
+	require.NoError(t, err)
+	assert.Equal(t, expected, buf)
+	assert.Same(t, &reused[:1][0], &buf[0])
+
+	// Empty request.
+	buf, err = NewRequest().MarshalProtoTo(reused[:0])
+	require.NoError(t, err)
+	assert.Empty(t, buf)
+}
+
+func TestRequestItemCount(t *testing.T) {
+	assert.Equal(t, 0, NewRequest().ItemCount())
+
+	ld := plog.NewLogs()
+	sl := ld.ResourceLogs().AppendEmpty().ScopeLogs()
+	sl.AppendEmpty().LogRecords().AppendEmpty()
+	sl.AppendEmpty().LogRecords().AppendEmpty()
+	sl.AppendEmpty().LogRecords().AppendEmpty()
+	sl.AppendEmpty()
+
+	assert.Equal(t, 3, NewRequestFromLogs(ld).ItemCount())
+}
+
+func TestRequestJSONFrom(t *testing.T) {
+	lr := NewRequest()
+	assert.NoError(t, lr.UnmarshalJSONFrom(bytes.NewReader(logsRequestJSON)))
+
+	expected := NewRequest()
+	assert.NoError(t, expected.UnmarshalJSON(logsRequestJSON))
+	assert.Equal(t, expected, lr)
+}
+
+func TestRequestJSONFromAppends(t *testing.T) {
+	lr := NewRequest()
+	assert.NoError(t, lr.UnmarshalJSONFrom(bytes.NewReader(logsRequestJSON)))
+	assert.NoError(t, lr.UnmarshalJSONFrom(bytes.NewReader(logsRequestJSON)))
+	assert.Equal(t, 2, lr.Logs().ResourceLogs().Len())
+
+	assert.NoError(t, lr.UnmarshalJSONFrom(strings.NewReader(`{"resourceLogs":null}`)))
+	assert.NoError(t, lr.UnmarshalJSONFrom(strings.NewReader(`{}`)))
+	assert.Equal(t, 2, lr.Logs().ResourceLogs().Len())
+}
+
+func TestRequestJSONFromError(t *testing.T) {
+	for _, data := range []string{
+		``,
+		`[]`,
+		`{"unknown":[]}`,
+		`{"resourceLogs":{}}`,
+		`{"resourceLogs":[{"unknown":1}]}`,
+		`{"resourceLogs":[]`,
+	} {
+		lr := NewRequest()
+		assert.Error(t, lr.UnmarshalJSONFrom(strings.NewReader(data)), data)
 	}
 }
 
@@ -188,7 +361,7 @@ func TestGrpc(t *testing.T) {
 	assert.Equal(t, NewResponse(), resp)
 }
 
-func TestGrpcTransition(t *testing.T) {
+func TestGrpcGzip(t *testing.T) {
 	lis := bufconn.Listen(1024 * 1024)
 	s := grpc.NewServer()
 	RegisterServer(s, &fakeLogsServer{t: t})
@@ -216,9 +389,7 @@ func TestGrpcTransition(t *testing.T) {
 
 	logClient := NewClient(cc)
 
-	req := generateLogsRequestWithInstrumentationLibrary()
-	otlp.InstrumentationLibraryLogsToScope(req.orig.ResourceLogs)
-	resp, err := logClient.Export(context.Background(), req)
+	resp, err := logClient.Export(context.Background(), generateLogsRequest(), grpc.UseCompressor(gzip.Name))
 	assert.NoError(t, err)
 	assert.Equal(t, NewResponse(), resp)
 }
@@ -260,7 +431,104 @@ func TestGrpcExport(t *testing.T) {
 
 	logClient := NewClient(cc)
 
-	resp, err := logClient.Export(context.Background(), generateLogsRequestWithInstrumentationLibrary())
+	resp, err := logClient.Export(context.Background(), generateLogsRequest())
+	assert.NoError(t, err)
+	assert.Equal(t, NewResponse(), resp)
+}
+
+func TestGrpcServerInterceptors(t *testing.T) {
+	var calls []string
+	recordInterceptor := func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		assert.Equal(t, "/opentelemetry.proto.collector.logs.v1.LogsService/Export", info.FullMethod)
+		assert.Equal(t, generateLogsRequest(), req)
+		calls = append(calls, "record")
+		resp, err := handler(ctx, req)
+		if err == nil {
+			assert.Equal(t, NewResponse(), resp)
+		}
+		return resp, err
+	}
+	authInterceptor := func(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		calls = append(calls, "auth")
+		md, _ := metadata.FromIncomingContext(ctx)
+		if len(md.Get("authorization")) == 0 {
+			return nil, status.Error(codes.Unauthenticated, "missing authorization")
+		}
+		return handler(ctx, req)
+	}
+
+	lis := bufconn.Listen(1024 * 1024)
+	s := grpc.NewServer()
+	RegisterServer(s, &fakeLogsServer{t: t}, WithUnaryServerInterceptors(recordInterceptor, authInterceptor))
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		assert.NoError(t, s.Serve(lis))
+	}()
+	t.Cleanup(func() {
+		s.Stop()
+		wg.Wait()
+	})
+
+	cc, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+			return lis.Dial()
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithBlock())
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		assert.NoError(t, cc.Close())
+	})
+
+	logClient := NewClient(cc)
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer token")
+	resp, err := logClient.Export(ctx, generateLogsRequest())
+	assert.NoError(t, err)
+	assert.Equal(t, NewResponse(), resp)
+	assert.Equal(t, []string{"record", "auth"}, calls)
+
+	_, err = logClient.Export(context.Background(), generateLogsRequest())
+	st, okSt := status.FromError(err)
+	require.True(t, okSt)
+	assert.Equal(t, codes.Unauthenticated, st.Code())
+}
+
+func TestServiceDesc(t *testing.T) {
+	lis := bufconn.Listen(1024 * 1024)
+	s := grpc.NewServer()
+	s.RegisterService(ServiceDesc(), &fakeLogsServer{t: t})
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		assert.NoError(t, s.Serve(lis))
+	}()
+	t.Cleanup(func() {
+		s.Stop()
+		wg.Wait()
+	})
+
+	// The description matches the service registered by RegisterServer.
+	registered := grpc.NewServer()
+	RegisterServer(registered, &fakeLogsServer{t: t})
+	assert.Equal(t, registered.GetServiceInfo(), s.GetServiceInfo())
+
+	cc, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+			return lis.Dial()
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithBlock())
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		assert.NoError(t, cc.Close())
+	})
+
+	logClient := NewClient(cc)
+	resp, err := logClient.Export(context.Background(), generateLogsRequest())
 	assert.NoError(t, err)
 	assert.Equal(t, NewResponse(), resp)
 }
@@ -301,6 +569,118 @@ func TestGrpcError(t *testing.T) {
 	assert.Equal(t, Response{}, resp)
 }
 
+func TestGrpcContextDone(t *testing.T) {
+	lis := bufconn.Listen(1024 * 1024)
+	s := grpc.NewServer()
+	RegisterServer(s, &fakeLogsServer{t: t})
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		assert.NoError(t, s.Serve(lis))
+	}()
+	t.Cleanup(func() {
+		s.Stop()
+		wg.Wait()
+	})
+
+	cc, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+			return lis.Dial()
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithBlock())
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		assert.NoError(t, cc.Close())
+	})
+
+	logClient := NewClient(cc)
+
+	canceledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+	resp, err := logClient.Export(canceledCtx, generateLogsRequest())
+	assert.Equal(t, codes.Canceled, status.Code(err))
+	assert.Equal(t, Response{}, resp)
+	assert.Equal(t, "", resp.PartialSuccess().ErrorMessage())
+
+	expiredCtx, cancel := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancel()
+	resp, err = logClient.Export(expiredCtx, generateLogsRequest())
+	assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+	assert.Equal(t, Response{}, resp)
+}
+
+func TestGrpcDefaultTimeout(t *testing.T) {
+	lis := bufconn.Listen(1024 * 1024)
+	s := grpc.NewServer()
+	srv := &deadlineLogsServer{}
+	RegisterServer(s, srv)
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		assert.NoError(t, s.Serve(lis))
+	}()
+	t.Cleanup(func() {
+		s.Stop()
+		wg.Wait()
+	})
+
+	cc, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+			return lis.Dial()
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithBlock())
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		assert.NoError(t, cc.Close())
+	})
+
+	logClient := NewClient(cc, WithDefaultTimeout(time.Minute))
+
+	// The default timeout is applied when the caller sets no deadline.
+	start := time.Now()
+	_, err = logClient.Export(context.Background(), generateLogsRequest())
+	require.NoError(t, err)
+	require.True(t, srv.hasDeadline)
+	assert.WithinDuration(t, start.Add(time.Minute), srv.deadline, 10*time.Second)
+
+	// A shorter deadline set by the caller wins.
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	callerDeadline, _ := ctx.Deadline()
+	_, err = logClient.Export(ctx, generateLogsRequest())
+	require.NoError(t, err)
+	require.True(t, srv.hasDeadline)
+	assert.WithinDuration(t, callerDeadline, srv.deadline, time.Second)
+
+	// A longer deadline set by the caller is shortened to the default timeout.
+	ctx, cancel = context.WithTimeout(context.Background(), time.Hour)
+	defer cancel()
+	start = time.Now()
+	_, err = logClient.Export(ctx, generateLogsRequest())
+	require.NoError(t, err)
+	require.True(t, srv.hasDeadline)
+	assert.WithinDuration(t, start.Add(time.Minute), srv.deadline, 10*time.Second)
+
+	// Without the option the caller context is used as is.
+	_, err = NewClient(cc).Export(context.Background(), generateLogsRequest())
+	require.NoError(t, err)
+	assert.False(t, srv.hasDeadline)
+}
+
+type deadlineLogsServer struct {
+	deadline    time.Time
+	hasDeadline bool
+}
+
+func (f *deadlineLogsServer) Export(ctx context.Context, _ Request) (Response, error) {
+	f.deadline, f.hasDeadline = ctx.Deadline()
+	return NewResponse(), nil
+}
+
 type fakeLogsServer struct {
 	t   *testing.T
 	err error
@@ -317,13 +697,15 @@ func generateLogsRequest() Request {
 	return NewRequestFromLogs(ld)
 }
 
-func generateLogsRequestWithInstrumentationLibrary() Request {
-	lr := generateLogsRequest()
-	lr.orig.ResourceLogs[0].InstrumentationLibraryLogs = []*v1.InstrumentationLibraryLogs{ //nolint:staticcheck // SA1019 ignore this!
-		{
-			LogRecords: lr.orig.ResourceLogs[0].ScopeLogs[0].LogRecords,
-		},
-	}
-	lr.orig.ResourceLogs[0].ScopeLogs = []*v1.ScopeLogs{}
-	return lr
+func TestIsRetryable(t *testing.T) {
+	assert.False(t, IsRetryable(nil))
+	assert.True(t, IsRetryable(status.Error(codes.Unavailable, "unavailable")))
+	assert.False(t, IsRetryable(status.Error(codes.InvalidArgument, "invalid")))
+	assert.False(t, IsRetryable(status.Error(codes.ResourceExhausted, "exhausted")))
+	assert.Equal(t, time.Duration(0), RetryDelay(status.Error(codes.Unavailable, "unavailable")))
+
+	st, err := status.New(codes.ResourceExhausted, "exhausted").WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(time.Second)})
+	require.NoError(t, err)
+	assert.True(t, IsRetryable(st.Err()))
+	assert.Equal(t, time.Second, RetryDelay(st.Err()))
 }
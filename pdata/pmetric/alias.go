@@ -23,6 +23,9 @@ type Metrics = internal.Metrics
 // NewMetrics creates a new Metrics struct.
 var NewMetrics = internal.NewMetrics
 
+// MetricsStats contains the number of elements at every level of a Metrics.
+type MetricsStats = internal.MetricsStats
+
 // MetricDataType specifies the type of data in a Metric.
 type MetricDataType = internal.MetricDataType
 
@@ -35,6 +38,10 @@ const (
 	MetricDataTypeSummary              = internal.MetricDataTypeSummary
 )
 
+// ParseMetricDataType returns the MetricDataType with the given string representation,
+// as returned by MetricDataType.String. The comparison is case-insensitive.
+var ParseMetricDataType = internal.ParseMetricDataType
+
 // MetricAggregationTemporality defines how a metric aggregator reports aggregated values.
 // It describes how those values relate to the time interval over which they are aggregated.
 type MetricAggregationTemporality = internal.MetricAggregationTemporality
@@ -50,6 +57,35 @@ const (
 	MetricAggregationTemporalityCumulative = internal.MetricAggregationTemporalityCumulative
 )
 
+// ParseMetricAggregationTemporality returns the MetricAggregationTemporality with the given string
+// representation, as returned by MetricAggregationTemporality.String. The comparison is case-insensitive.
+var ParseMetricAggregationTemporality = internal.ParseMetricAggregationTemporality
+
+// DeduplicatePolicy defines which data point Metrics.Deduplicate keeps among duplicates.
+type DeduplicatePolicy = internal.DeduplicatePolicy
+
+const (
+	// DeduplicateKeepLast keeps the last data point of every identity, dropping the earlier ones.
+	DeduplicateKeepLast = internal.DeduplicateKeepLast
+
+	// DeduplicateKeepFirst keeps the first data point of every identity, dropping the later ones.
+	DeduplicateKeepFirst = internal.DeduplicateKeepFirst
+)
+
+// AttributeCollisionPolicy defines which value Metrics.RenameAttributeKey keeps when the new key
+// of an attribute is already used by another attribute.
+type AttributeCollisionPolicy = internal.AttributeCollisionPolicy
+
+const (
+	// AttributeCollisionKeepExisting keeps the value of the attribute already using the new key,
+	// dropping the value of the renamed attribute.
+	AttributeCollisionKeepExisting = internal.AttributeCollisionKeepExisting
+
+	// AttributeCollisionOverwrite replaces the value of the attribute already using the new key
+	// with the value of the renamed attribute.
+	AttributeCollisionOverwrite = internal.AttributeCollisionOverwrite
+)
+
 // MetricDataPointFlags defines how a metric aggregator reports aggregated values.
 // It describes how those values relate to the time interval over which they are aggregated.
 type MetricDataPointFlags = internal.MetricDataPointFlags
@@ -0,0 +1,138 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pmetric // import "go.opentelemetry.io/collector/pdata/pmetric"
+
+import (
+	"strings"
+	"sync"
+
+	"go.opentelemetry.io/collector/pdata/internal"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+// DeltaToCumulative converts Sum metrics with delta temporality to cumulative temporality,
+// accumulating the deltas of every series. A series is identified by the resource attributes,
+// the scope name and version, the metric name and the data point attributes. The data point start
+// timestamp is not part of the series identity, it is only used to detect the resets of the series.
+//
+// The running total of a series is re-initialized, with a new start timestamp, when:
+//   - the data point start timestamp is before the timestamp of the last accumulated data point,
+//     which means that the producer restarted, the running total starts from the received delta;
+//   - the Sum is monotonic and the delta is negative, which means that the counter went backwards,
+//     the running total starts from zero and the negative delta is dropped.
+//
+// Series are kept in memory until evicted with RemoveStale. It is safe for concurrent use.
+type DeltaToCumulative struct {
+	mu     sync.Mutex
+	series map[string]*cumulativeSeries
+}
+
+type cumulativeSeries struct {
+	startTimestamp pcommon.Timestamp
+	lastTimestamp  pcommon.Timestamp
+	intVal         int64
+	doubleVal      float64
+}
+
+// NewDeltaToCumulative returns a new DeltaToCumulative without any series.
+func NewDeltaToCumulative() *DeltaToCumulative {
+	return &DeltaToCumulative{series: map[string]*cumulativeSeries{}}
+}
+
+// ConvertMetrics converts in place every Sum metric with delta temporality in md to cumulative
+// temporality. Metrics of other types or temporality are not modified.
+func (c *DeltaToCumulative) ConvertMetrics(md Metrics) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	rms := md.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		rm := rms.At(i)
+		sms := rm.ScopeMetrics()
+		for j := 0; j < sms.Len(); j++ {
+			sm := sms.At(j)
+			ms := sm.Metrics()
+			for k := 0; k < ms.Len(); k++ {
+				m := ms.At(k)
+				if m.DataType() != MetricDataTypeSum || m.Sum().AggregationTemporality() != MetricAggregationTemporalityDelta {
+					continue
+				}
+				prefix := strings.Join([]string{internal.AttributesKey(rm.Resource().Attributes()), sm.Scope().Name(), sm.Scope().Version(), m.Name()}, keySeparator)
+				c.convertSum(prefix+keySeparator, m.Sum())
+			}
+		}
+	}
+}
+
+// RemoveStale evicts every series whose last accumulated data point timestamp is before the given
+// timestamp, and returns the number of evicted series. It must be called periodically to bound the
+// memory used by series that are no longer reported.
+func (c *DeltaToCumulative) RemoveStale(before pcommon.Timestamp) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	removed := 0
+	for key, s := range c.series {
+		if s.lastTimestamp < before {
+			delete(c.series, key)
+			removed++
+		}
+	}
+	return removed
+}
+
+func (c *DeltaToCumulative) convertSum(prefix string, sum Sum) {
+	dps := sum.DataPoints()
+	for i := 0; i < dps.Len(); i++ {
+		dp := dps.At(i)
+		key := prefix + internal.AttributesKey(dp.Attributes())
+
+		wentBackwards := sum.IsMonotonic() && isNegativeDelta(dp)
+		s, ok := c.series[key]
+		if !ok || dp.StartTimestamp() < s.lastTimestamp || wentBackwards {
+			s = &cumulativeSeries{startTimestamp: dp.StartTimestamp()}
+			c.series[key] = s
+		}
+		s.lastTimestamp = dp.Timestamp()
+
+		switch dp.ValueType() {
+		case NumberDataPointValueTypeInt:
+			if !wentBackwards {
+				s.intVal += dp.IntVal()
+			}
+			dp.SetIntVal(s.intVal)
+		case NumberDataPointValueTypeDouble:
+			if !wentBackwards {
+				s.doubleVal += dp.DoubleVal()
+			}
+			dp.SetDoubleVal(s.doubleVal)
+		}
+		dp.SetStartTimestamp(s.startTimestamp)
+	}
+	sum.SetAggregationTemporality(MetricAggregationTemporalityCumulative)
+}
+
+func isNegativeDelta(dp NumberDataPoint) bool {
+	switch dp.ValueType() {
+	case NumberDataPointValueTypeInt:
+		return dp.IntVal() < 0
+	case NumberDataPointValueTypeDouble:
+		return dp.DoubleVal() < 0
+	}
+	return false
+}
+
+// keySeparator separates the parts of the key identifying a series.
+const keySeparator = "\x00"
@@ -0,0 +1,156 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pmetric
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+type deltaPoint struct {
+	start pcommon.Timestamp
+	ts    pcommon.Timestamp
+	value int64
+	attr  string
+}
+
+func newDeltaSumMetrics(name string, monotonic bool, points ...deltaPoint) Metrics {
+	md := NewMetrics()
+	rm := md.ResourceMetrics().AppendEmpty()
+	rm.Resource().Attributes().UpsertString("service.name", "test")
+	m := rm.ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+	m.SetName(name)
+	m.SetDataType(MetricDataTypeSum)
+	m.Sum().SetAggregationTemporality(MetricAggregationTemporalityDelta)
+	m.Sum().SetIsMonotonic(monotonic)
+	for _, p := range points {
+		dp := m.Sum().DataPoints().AppendEmpty()
+		dp.SetStartTimestamp(p.start)
+		dp.SetTimestamp(p.ts)
+		dp.SetIntVal(p.value)
+		dp.Attributes().UpsertString("attr", p.attr)
+	}
+	return md
+}
+
+func convertedSum(t *testing.T, md Metrics) Sum {
+	sum := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum()
+	assert.Equal(t, MetricAggregationTemporalityCumulative, sum.AggregationTemporality())
+	return sum
+}
+
+func TestDeltaToCumulativeAccumulate(t *testing.T) {
+	c := NewDeltaToCumulative()
+
+	md := newDeltaSumMetrics("requests", true, deltaPoint{start: 10, ts: 20, value: 3, attr: "a"}, deltaPoint{start: 10, ts: 20, value: 1, attr: "b"})
+	c.ConvertMetrics(md)
+	dps := convertedSum(t, md).DataPoints()
+	require.Equal(t, 2, dps.Len())
+	assert.Equal(t, int64(3), dps.At(0).IntVal())
+	assert.Equal(t, int64(1), dps.At(1).IntVal())
+
+	md = newDeltaSumMetrics("requests", true, deltaPoint{start: 20, ts: 30, value: 4, attr: "a"}, deltaPoint{start: 20, ts: 30, value: 2, attr: "b"})
+	c.ConvertMetrics(md)
+	dps = convertedSum(t, md).DataPoints()
+	assert.Equal(t, int64(7), dps.At(0).IntVal())
+	assert.Equal(t, pcommon.Timestamp(10), dps.At(0).StartTimestamp())
+	assert.Equal(t, pcommon.Timestamp(30), dps.At(0).Timestamp())
+	assert.Equal(t, int64(3), dps.At(1).IntVal())
+	assert.Equal(t, pcommon.Timestamp(10), dps.At(1).StartTimestamp())
+
+	// A different metric name is a different series.
+	md = newDeltaSumMetrics("errors", true, deltaPoint{start: 20, ts: 30, value: 5, attr: "a"})
+	c.ConvertMetrics(md)
+	assert.Equal(t, int64(5), convertedSum(t, md).DataPoints().At(0).IntVal())
+}
+
+func TestDeltaToCumulativeDouble(t *testing.T) {
+	c := NewDeltaToCumulative()
+	for i, expected := range []float64{1.5, 4} {
+		md := newDeltaSumMetrics("latency", false)
+		dp := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints().AppendEmpty()
+		dp.SetStartTimestamp(pcommon.Timestamp(i * 10))
+		dp.SetTimestamp(pcommon.Timestamp((i + 1) * 10))
+		dp.SetDoubleVal(float64(i) + 1.5)
+		c.ConvertMetrics(md)
+		assert.Equal(t, expected, convertedSum(t, md).DataPoints().At(0).DoubleVal())
+	}
+}
+
+func TestDeltaToCumulativeReset(t *testing.T) {
+	c := NewDeltaToCumulative()
+	c.ConvertMetrics(newDeltaSumMetrics("requests", true, deltaPoint{start: 10, ts: 20, value: 3, attr: "a"}))
+
+	// The producer restarted, the start timestamp is before the last timestamp.
+	md := newDeltaSumMetrics("requests", true, deltaPoint{start: 15, ts: 25, value: 2, attr: "a"})
+	c.ConvertMetrics(md)
+	dp := convertedSum(t, md).DataPoints().At(0)
+	assert.Equal(t, int64(2), dp.IntVal())
+	assert.Equal(t, pcommon.Timestamp(15), dp.StartTimestamp())
+
+	// The monotonic counter went backwards.
+	md = newDeltaSumMetrics("requests", true, deltaPoint{start: 25, ts: 35, value: -1, attr: "a"})
+	c.ConvertMetrics(md)
+	dp = convertedSum(t, md).DataPoints().At(0)
+	assert.Equal(t, int64(0), dp.IntVal())
+	assert.Equal(t, pcommon.Timestamp(25), dp.StartTimestamp())
+
+	md = newDeltaSumMetrics("requests", true, deltaPoint{start: 35, ts: 45, value: 4, attr: "a"})
+	c.ConvertMetrics(md)
+	assert.Equal(t, int64(4), convertedSum(t, md).DataPoints().At(0).IntVal())
+
+	// Negative deltas are accumulated for non monotonic sums.
+	c.ConvertMetrics(newDeltaSumMetrics("queue", false, deltaPoint{start: 10, ts: 20, value: 3, attr: "a"}))
+	md = newDeltaSumMetrics("queue", false, deltaPoint{start: 20, ts: 30, value: -1, attr: "a"})
+	c.ConvertMetrics(md)
+	assert.Equal(t, int64(2), convertedSum(t, md).DataPoints().At(0).IntVal())
+}
+
+func TestDeltaToCumulativeIgnoresOtherMetrics(t *testing.T) {
+	md := NewMetrics()
+	ms := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics()
+	gauge := ms.AppendEmpty()
+	gauge.SetDataType(MetricDataTypeGauge)
+	gauge.Gauge().DataPoints().AppendEmpty().SetIntVal(1)
+	cumulative := ms.AppendEmpty()
+	cumulative.SetDataType(MetricDataTypeSum)
+	cumulative.Sum().SetAggregationTemporality(MetricAggregationTemporalityCumulative)
+	cumulative.Sum().DataPoints().AppendEmpty().SetIntVal(1)
+	expected := md.Clone()
+
+	c := NewDeltaToCumulative()
+	c.ConvertMetrics(md)
+	c.ConvertMetrics(md)
+	assert.Equal(t, expected, md)
+}
+
+func TestDeltaToCumulativeRemoveStale(t *testing.T) {
+	c := NewDeltaToCumulative()
+	c.ConvertMetrics(newDeltaSumMetrics("requests", true, deltaPoint{start: 10, ts: 20, value: 3, attr: "a"}, deltaPoint{start: 30, ts: 40, value: 1, attr: "b"}))
+
+	assert.Equal(t, 1, c.RemoveStale(30))
+	assert.Equal(t, 0, c.RemoveStale(30))
+
+	// The evicted series starts again from the received delta.
+	md := newDeltaSumMetrics("requests", true, deltaPoint{start: 40, ts: 50, value: 2, attr: "a"}, deltaPoint{start: 40, ts: 50, value: 2, attr: "b"})
+	c.ConvertMetrics(md)
+	dps := convertedSum(t, md).DataPoints()
+	assert.Equal(t, int64(2), dps.At(0).IntVal())
+	assert.Equal(t, int64(3), dps.At(1).IntVal())
+}
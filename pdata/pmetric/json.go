@@ -21,16 +21,44 @@ import (
 
 	"go.opentelemetry.io/collector/pdata/internal"
 	otlpmetrics "go.opentelemetry.io/collector/pdata/internal/data/protogen/metrics/v1"
-	"go.opentelemetry.io/collector/pdata/internal/otlp"
 )
 
 // NewJSONMarshaler returns a model.Marshaler. Marshals to OTLP json bytes.
-func NewJSONMarshaler() Marshaler {
-	return newJSONMarshaler()
+// Fields with a zero value, e.g. unset timestamps, are omitted instead of being encoded as "0",
+// they are unmarshaled back to their zero value by the Unmarshaler returned by NewJSONUnmarshaler.
+func NewJSONMarshaler(opts ...JSONMarshalerOption) Marshaler {
+	e := newJSONMarshaler()
+	for _, opt := range opts {
+		opt(e)
+	}
+	return e
+}
+
+// JSONMarshalerOption represents the possible options for NewJSONMarshaler.
+type JSONMarshalerOption func(*jsonMarshaler)
+
+// WithOmitEmptyResourceAndScope omits the "resource" and "scope" objects when they are empty,
+// producing more compact json. Empty attributes are always omitted. The output can still be
+// unmarshaled by the Unmarshaler returned by NewJSONUnmarshaler.
+func WithOmitEmptyResourceAndScope() JSONMarshalerOption {
+	return func(e *jsonMarshaler) {
+		e.omitEmptyResourceAndScope = true
+	}
+}
+
+// WithSnakeCaseFieldNames emits the field names of the OTLP proto definition, e.g. "start_time_unix_nano",
+// instead of the lowerCamelCase ones, e.g. "startTimeUnixNano", for receivers accepting only the former,
+// like some OTLP/JSON implementations predating its stable release. The Unmarshaler returned by
+// NewJSONUnmarshaler accepts both.
+func WithSnakeCaseFieldNames() JSONMarshalerOption {
+	return func(e *jsonMarshaler) {
+		e.delegate.OrigName = true
+	}
 }
 
 type jsonMarshaler struct {
-	delegate jsonpb.Marshaler
+	delegate                  jsonpb.Marshaler
+	omitEmptyResourceAndScope bool
 }
 
 func newJSONMarshaler() *jsonMarshaler {
@@ -40,7 +68,10 @@ func newJSONMarshaler() *jsonMarshaler {
 func (e *jsonMarshaler) MarshalMetrics(md Metrics) ([]byte, error) {
 	buf := bytes.Buffer{}
 	err := e.delegate.Marshal(&buf, internal.MetricsToOtlp(md))
-	return buf.Bytes(), err
+	if err != nil || !e.omitEmptyResourceAndScope {
+		return buf.Bytes(), err
+	}
+	return internal.OmitEmptyJSONObjects(buf.Bytes(), "resource", "scope"), nil
 }
 
 type jsonUnmarshaler struct {
@@ -48,6 +79,7 @@ type jsonUnmarshaler struct {
 }
 
 // NewJSONUnmarshaler returns a model.Unmarshaler. Unmarshals from OTLP json bytes.
+// Field names are accepted both in lowerCamelCase and in snake_case.
 func NewJSONUnmarshaler() Unmarshaler {
 	return newJSONUnmarshaler()
 }
@@ -61,6 +93,5 @@ func (d *jsonUnmarshaler) UnmarshalMetrics(buf []byte) (Metrics, error) {
 	if err := d.delegate.Unmarshal(bytes.NewReader(buf), &md); err != nil {
 		return Metrics{}, err
 	}
-	otlp.InstrumentationLibraryMetricsToScope(md.ResourceMetrics)
 	return internal.MetricsFromProto(md), nil
 }
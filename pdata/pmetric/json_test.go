@@ -53,6 +53,109 @@ func TestMetricsJSON_Marshal(t *testing.T) {
 	assert.Equal(t, metricsJSON, string(jsonBuf))
 }
 
+func TestMetricsJSON_MarshalOmitEmptyResourceAndScope(t *testing.T) {
+	md := NewMetrics()
+	sls := md.ResourceMetrics().AppendEmpty().ScopeMetrics()
+	sls.AppendEmpty().Metrics().AppendEmpty().SetName("testMetric")
+	sl := sls.AppendEmpty()
+	sl.Scope().SetName("name")
+	sl.Metrics().AppendEmpty().SetName("testMetric")
+
+	encoder := NewJSONMarshaler(WithOmitEmptyResourceAndScope())
+	jsonBuf, err := encoder.MarshalMetrics(md)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"resourceMetrics":[{"scopeMetrics":[{"metrics":[{"name":"testMetric"}]},{"scope":{"name":"name"},"metrics":[{"name":"testMetric"}]}]}]}`, string(jsonBuf))
+
+	got, err := NewJSONUnmarshaler().UnmarshalMetrics(jsonBuf)
+	assert.NoError(t, err)
+	assert.EqualValues(t, md, got)
+
+	// Non empty resource and scope are kept.
+	jsonBuf, err = encoder.MarshalMetrics(metricsOTLP)
+	assert.NoError(t, err)
+	assert.Equal(t, metricsJSON, string(jsonBuf))
+}
+
+func TestMetricsJSON_HistogramMinMax(t *testing.T) {
+	md := NewMetrics()
+	m := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+	m.SetDataType(MetricDataTypeHistogram)
+	dps := m.Histogram().DataPoints()
+	dp := dps.AppendEmpty()
+	dp.SetMin(0)
+	dp.SetMax(12.5)
+	dps.AppendEmpty()
+
+	jsonBuf, err := NewJSONMarshaler().MarshalMetrics(md)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"resourceMetrics":[{"resource":{},"scopeMetrics":[{"scope":{},"metrics":[{"histogram":{"dataPoints":[{"min":0,"max":12.5},{}]}}]}]}]}`, string(jsonBuf))
+
+	got, err := NewJSONUnmarshaler().UnmarshalMetrics(jsonBuf)
+	assert.NoError(t, err)
+	assert.EqualValues(t, md, got)
+
+	protoBuf, err := NewProtoMarshaler().MarshalMetrics(md)
+	assert.NoError(t, err)
+	got, err = NewProtoUnmarshaler().UnmarshalMetrics(protoBuf)
+	assert.NoError(t, err)
+	assert.EqualValues(t, md, got)
+
+	gotDps := got.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Histogram().DataPoints()
+	assert.True(t, gotDps.At(0).HasMin())
+	assert.Equal(t, 0.0, gotDps.At(0).Min())
+	assert.True(t, gotDps.At(0).HasMax())
+	assert.Equal(t, 12.5, gotDps.At(0).Max())
+	assert.False(t, gotDps.At(1).HasMin())
+	assert.False(t, gotDps.At(1).HasMax())
+}
+
+func TestMetricsJSON_OmitZeroTimestamps(t *testing.T) {
+	md := NewMetrics()
+	m := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+	m.SetDataType(MetricDataTypeGauge)
+	m.Gauge().DataPoints().AppendEmpty().SetIntVal(1)
+	dp := m.Gauge().DataPoints().AppendEmpty()
+	dp.SetTimestamp(1234)
+	dp.SetIntVal(2)
+
+	jsonBuf, err := NewJSONMarshaler().MarshalMetrics(md)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"resourceMetrics":[{"resource":{},"scopeMetrics":[{"scope":{},"metrics":[{"gauge":{"dataPoints":[{"asInt":"1"},{"timeUnixNano":"1234","asInt":"2"}]}}]}]}]}`, string(jsonBuf))
+
+	got, err := NewJSONUnmarshaler().UnmarshalMetrics(jsonBuf)
+	assert.NoError(t, err)
+	assert.EqualValues(t, md, got)
+}
+
+func TestMetricsJSON_SnakeCaseFieldNames(t *testing.T) {
+	md := NewMetrics()
+	m := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+	m.SetName("testMetric")
+	m.SetDataType(MetricDataTypeSum)
+	dp := m.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(1234)
+	dp.SetIntVal(1)
+
+	camelCase, err := NewJSONMarshaler().MarshalMetrics(md)
+	assert.NoError(t, err)
+	assert.Contains(t, string(camelCase), `"startTimeUnixNano":"1234"`)
+	assert.Contains(t, string(camelCase), `"dataPoints"`)
+	assert.NotContains(t, string(camelCase), `"start_time_unix_nano"`)
+
+	snakeCase, err := NewJSONMarshaler(WithSnakeCaseFieldNames()).MarshalMetrics(md)
+	assert.NoError(t, err)
+	assert.Contains(t, string(snakeCase), `"start_time_unix_nano":"1234"`)
+	assert.Contains(t, string(snakeCase), `"data_points"`)
+	assert.NotContains(t, string(snakeCase), `"startTimeUnixNano"`)
+
+	// Both naming conventions are accepted.
+	for _, jsonBuf := range [][]byte{camelCase, snakeCase} {
+		got, err := NewJSONUnmarshaler().UnmarshalMetrics(jsonBuf)
+		assert.NoError(t, err)
+		assert.EqualValues(t, md, got)
+	}
+}
+
 func TestMetricsNil(t *testing.T) {
 	jsonBuf := `{
 "resourceMetrics": [
@@ -17,12 +17,23 @@ package pmetricotlp // import "go.opentelemetry.io/collector/pdata/pmetric/pmetr
 import (
 	"bytes"
 	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync/atomic"
+	"time"
 
 	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	// Register the gzip compressor, so that the server accepts gzip compressed requests
+	// and the client can send them using grpc.UseCompressor(gzip.Name).
+	_ "google.golang.org/grpc/encoding/gzip"
 
 	"go.opentelemetry.io/collector/pdata/internal"
 	otlpcollectormetrics "go.opentelemetry.io/collector/pdata/internal/data/protogen/collector/metrics/v1"
+	otlpmetrics "go.opentelemetry.io/collector/pdata/internal/data/protogen/metrics/v1"
 	"go.opentelemetry.io/collector/pdata/internal/otlp"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 )
@@ -30,6 +41,70 @@ import (
 var jsonMarshaler = &jsonpb.Marshaler{}
 var jsonUnmarshaler = &jsonpb.Unmarshaler{}
 
+// JSONCodec marshals and unmarshals the OTLP messages to and from JSON.
+//
+// The values passed to a JSONCodec are the generated protobuf messages of the export requests and
+// responses, they implement the github.com/gogo/protobuf/proto.Message interface. Their concrete types
+// are internal, and change along with the OTLP version, so a JSONCodec must not depend on them.
+// To stay compatible with the other OTLP/JSON implementations, a JSONCodec must follow the protobuf
+// JSON mapping, as the default one using the gogo protobuf jsonpb package does. A codec relying on the
+// struct tags of the messages, as encoding/json or a compatible library does, uses the proto field names,
+// e.g. "resource_metrics" instead of "resourceMetrics", and does not handle the oneof fields.
+type JSONCodec interface {
+	// Marshal returns the JSON encoding of v.
+	Marshal(v interface{}) ([]byte, error)
+	// Unmarshal decodes the JSON encoded data into v.
+	Unmarshal(data []byte, v interface{}) error
+}
+
+// jsonCodec holds the jsonCodecHolder of the JSONCodec set by SetJSONCodec, if any.
+var jsonCodec atomic.Value
+
+// jsonCodecHolder allows to store any JSONCodec in jsonCodec, which requires values of the same type.
+type jsonCodecHolder struct {
+	codec JSONCodec
+}
+
+// SetJSONCodec sets the JSONCodec used by the MarshalJSON and UnmarshalJSON methods of Request and Response,
+// so a faster JSON library can be plugged in. A nil codec restores the default one.
+// It is safe to call concurrently with the marshaling, the calls already started keep using the previous codec.
+func SetJSONCodec(codec JSONCodec) {
+	if codec == nil {
+		codec = jsonpbCodec{}
+	}
+	jsonCodec.Store(jsonCodecHolder{codec: codec})
+}
+
+// currentJSONCodec returns the JSONCodec set by SetJSONCodec, or the default one.
+func currentJSONCodec() JSONCodec {
+	if holder, ok := jsonCodec.Load().(jsonCodecHolder); ok {
+		return holder.codec
+	}
+	return jsonpbCodec{}
+}
+
+type jsonpbCodec struct{}
+
+func (jsonpbCodec) Marshal(v interface{}) ([]byte, error) {
+	msg, ok := v.(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("cannot marshal %T, not a proto message", v)
+	}
+	var buf bytes.Buffer
+	if err := jsonMarshaler.Marshal(&buf, msg); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (jsonpbCodec) Unmarshal(data []byte, v interface{}) error {
+	msg, ok := v.(proto.Message)
+	if !ok {
+		return fmt.Errorf("cannot unmarshal into %T, not a proto message", v)
+	}
+	return jsonUnmarshaler.Unmarshal(bytes.NewReader(data), msg)
+}
+
 // Response represents the response for gRPC/HTTP client/server.
 type Response struct {
 	orig *otlpcollectormetrics.ExportMetricsServiceResponse
@@ -52,20 +127,57 @@ func (mr Response) UnmarshalProto(data []byte) error {
 
 // MarshalJSON marshals Response into JSON bytes.
 func (mr Response) MarshalJSON() ([]byte, error) {
-	var buf bytes.Buffer
-	if err := jsonMarshaler.Marshal(&buf, mr.orig); err != nil {
-		return nil, err
-	}
-	return buf.Bytes(), nil
+	return currentJSONCodec().Marshal(mr.orig)
 }
 
 // UnmarshalJSON unmarshalls Response from JSON bytes.
 func (mr Response) UnmarshalJSON(data []byte) error {
-	return jsonUnmarshaler.Unmarshal(bytes.NewReader(data), mr.orig)
+	return currentJSONCodec().Unmarshal(data, mr.orig)
+}
+
+// PartialSuccess returns the PartialSuccess associated with this Response.
+// For a Response without a message, e.g. the one returned along with an error by Client.Export,
+// it returns an empty PartialSuccess that is not associated with the Response.
+func (mr Response) PartialSuccess() PartialSuccess {
+	if mr.orig == nil {
+		return PartialSuccess{orig: &otlpcollectormetrics.ExportMetricsPartialSuccess{}}
+	}
+	return PartialSuccess{orig: &mr.orig.PartialSuccess}
+}
+
+// PartialSuccess represents the details of a partially successful export request.
+// An empty PartialSuccess (no rejected data points and no error message) is equivalent to a full success.
+type PartialSuccess struct {
+	orig *otlpcollectormetrics.ExportMetricsPartialSuccess
+}
+
+// RejectedDataPoints returns the number of data points rejected by the server.
+func (ps PartialSuccess) RejectedDataPoints() int64 {
+	return ps.orig.RejectedDataPoints
+}
+
+// SetRejectedDataPoints replaces the number of data points rejected by the server.
+func (ps PartialSuccess) SetRejectedDataPoints(v int64) {
+	ps.orig.RejectedDataPoints = v
+}
+
+// ErrorMessage returns the human-readable message explaining the partial success.
+func (ps PartialSuccess) ErrorMessage() string {
+	return ps.orig.ErrorMessage
+}
+
+// SetErrorMessage replaces the human-readable message explaining the partial success.
+func (ps PartialSuccess) SetErrorMessage(v string) {
+	ps.orig.ErrorMessage = v
 }
 
 // Request represents the request for gRPC/HTTP client/server.
 // It's a wrapper for pmetric.Metrics data.
+//
+// The underlying protobuf message is not exposed, since its generated type is an implementation detail
+// that may change. To integrate with other protobuf tooling, use MarshalProto and UnmarshalProto: the
+// bytes are wire compatible with the opentelemetry.proto.collector.metrics.v1.ExportMetricsServiceRequest message,
+// e.g. as generated in the go.opentelemetry.io/proto/otlp module.
 type Request struct {
 	orig *otlpcollectormetrics.ExportMetricsServiceRequest
 }
@@ -76,26 +188,76 @@ func NewRequest() Request {
 }
 
 // NewRequestFromMetrics returns a Request from pmetric.Metrics.
-// Because Request is a wrapper for pmetric.Metrics,
-// any changes to the provided Metrics struct will be reflected in the Request and vice versa.
+// Because Request is a wrapper for pmetric.Metrics, the Request shares the data with the
+// provided Metrics: any changes to the provided Metrics struct will be reflected in the Request
+// and vice versa. Use NewRequestFromMetricsCopy if the Metrics are modified after the Request is created.
 func NewRequestFromMetrics(m pmetric.Metrics) Request {
 	return Request{orig: internal.MetricsToOtlp(m)}
 }
 
+// NewRequestFromMetricsCopy returns a Request from a deep copy of pmetric.Metrics.
+// Unlike NewRequestFromMetrics, changes to the provided Metrics struct are not reflected
+// in the Request and vice versa, so the caller can keep modifying the Metrics concurrently.
+func NewRequestFromMetricsCopy(m pmetric.Metrics) Request {
+	return NewRequestFromMetrics(m.Clone())
+}
+
 // MarshalProto marshals Request into proto bytes.
 func (mr Request) MarshalProto() ([]byte, error) {
 	return mr.orig.Marshal()
 }
 
+// MarshalProtoTo appends the proto bytes of the Request to buf and returns the extended buffer.
+// The buffer is grown only if its capacity is not enough, so a pool of buffers can be reused
+// across calls to avoid allocating a new byte slice for every Request.
+func (mr Request) MarshalProtoTo(buf []byte) ([]byte, error) {
+	size := mr.orig.Size()
+	start := len(buf)
+	if cap(buf)-start < size {
+		grown := make([]byte, start, start+size)
+		copy(grown, buf)
+		buf = grown
+	}
+	buf = buf[:start+size]
+	if _, err := mr.orig.MarshalToSizedBuffer(buf[start:]); err != nil {
+		return buf[:start], err
+	}
+	return buf, nil
+}
+
+// Clone returns a deep copy of the Request, see pmetric.Metrics.Clone.
+// Changes to the returned Request are not reflected in the original and vice versa.
+func (mr Request) Clone() Request {
+	return NewRequestFromMetrics(mr.Metrics().Clone())
+}
+
+// Size returns the size in bytes of the Request encoded with MarshalProto,
+// computed without marshaling the Request.
+func (mr Request) Size() int {
+	return mr.orig.Size()
+}
+
 // UnmarshalProto unmarshalls Request from proto bytes.
 func (mr Request) UnmarshalProto(data []byte) error {
 	return mr.orig.Unmarshal(data)
 }
 
 // MarshalJSON marshals Request into JSON bytes.
+// With the default JSONCodec, fields are always emitted in the order they are declared in the
+// OTLP proto definition, so marshaling the same Request always produces the same output.
 func (mr Request) MarshalJSON() ([]byte, error) {
+	return currentJSONCodec().Marshal(mr.orig)
+}
+
+// MarshalJSONIndent is like MarshalJSON but each JSON element begins on a new line
+// starting with prefix followed by one or more copies of indent according to the nesting.
+func (mr Request) MarshalJSONIndent(prefix, indent string) ([]byte, error) {
+	data, err := mr.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
 	var buf bytes.Buffer
-	if err := jsonMarshaler.Marshal(&buf, mr.orig); err != nil {
+	if err = json.Indent(&buf, data, prefix, indent); err != nil {
 		return nil, err
 	}
 	return buf.Bytes(), nil
@@ -103,10 +265,58 @@ func (mr Request) MarshalJSON() ([]byte, error) {
 
 // UnmarshalJSON unmarshalls Request from JSON bytes.
 func (mr Request) UnmarshalJSON(data []byte) error {
-	if err := jsonUnmarshaler.Unmarshal(bytes.NewReader(data), mr.orig); err != nil {
+	return currentJSONCodec().Unmarshal(data, mr.orig)
+}
+
+// UnmarshalJSONFrom unmarshalls Request from JSON read from r.
+// Unlike UnmarshalJSON, the input is decoded incrementally one ResourceMetrics at a time,
+// so large payloads can be streamed without first being read into memory.
+// It always uses the default JSONCodec, regardless of SetJSONCodec.
+// The decoded ResourceMetrics are appended to the ones already in the Request.
+func (mr Request) UnmarshalJSONFrom(r io.Reader) error {
+	dec := json.NewDecoder(r)
+	if err := expectDelim(dec, '{'); err != nil {
 		return err
 	}
-	otlp.InstrumentationLibraryMetricsToScope(mr.orig.ResourceMetrics)
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		if key := tok.(string); key != "resourceMetrics" && key != "resource_metrics" {
+			return fmt.Errorf("unknown field %q in ExportMetricsServiceRequest", key)
+		}
+		if tok, err = dec.Token(); err != nil {
+			return err
+		}
+		if tok == nil {
+			continue
+		}
+		if tok != json.Delim('[') {
+			return fmt.Errorf("expected '[' for resourceMetrics, got %v", tok)
+		}
+		for dec.More() {
+			rm := &otlpmetrics.ResourceMetrics{}
+			if err = jsonUnmarshaler.UnmarshalNext(dec, rm); err != nil {
+				return err
+			}
+			mr.orig.ResourceMetrics = append(mr.orig.ResourceMetrics, rm)
+		}
+		if err = expectDelim(dec, ']'); err != nil {
+			return err
+		}
+	}
+	return expectDelim(dec, '}')
+}
+
+func expectDelim(dec *json.Decoder, delim json.Delim) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if tok != delim {
+		return fmt.Errorf("expected %q, got %v", delim, tok)
+	}
 	return nil
 }
 
@@ -119,27 +329,98 @@ func (mr Request) Metrics() pmetric.Metrics {
 	return internal.MetricsFromOtlp(mr.orig)
 }
 
+// ItemCount returns the number of data points in the Request, which is the canonical OTLP item
+// count for metrics. This is the count reported by the collector receivers as
+// otelcol_receiver_accepted_metric_points and otelcol_receiver_refused_metric_points, and the
+// one used for throttling and batching. Use Metrics().MetricCount() to count the metrics instead.
+func (mr Request) ItemCount() int {
+	return mr.Metrics().DataPointCount()
+}
+
+// IsRetryable returns true if the Client.Export call that returned err can be retried,
+// following the OTLP/gRPC specification. ResourceExhausted errors are retryable only if
+// the server supplied a RetryInfo, use RetryDelay to get the delay requested by the server.
+func IsRetryable(err error) bool {
+	return otlp.IsRetryable(err)
+}
+
+// RetryDelay returns the delay before retrying requested by the server with a RetryInfo
+// in the status details of the error returned by Client.Export, or 0 if none was requested.
+func RetryDelay(err error) time.Duration {
+	return otlp.RetryDelay(err)
+}
+
 // Client is the client API for OTLP-GRPC Metrics service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type Client interface {
 	// Export pmetric.Metrics to the server.
 	//
+	// The header and trailer metadata sent by the server can be read using
+	// the grpc.Header and grpc.Trailer call options, e.g. to get a retry hint
+	// when the server is throttling.
+	//
 	// For performance reasons, it is recommended to keep this RPC
 	// alive for the entire life of the application.
+	//
+	// If ctx is already done, a codes.Canceled or codes.DeadlineExceeded status error is
+	// returned without sending the request.
 	Export(ctx context.Context, request Request, opts ...grpc.CallOption) (Response, error)
 }
 
 type metricsClient struct {
-	rawClient otlpcollectormetrics.MetricsServiceClient
+	rawClient      otlpcollectormetrics.MetricsServiceClient
+	callOptions    []grpc.CallOption
+	defaultTimeout time.Duration
+}
+
+// Option represents the possible options for NewClient.
+type Option func(*metricsClient)
+
+// WithCallOptions sets default grpc.CallOption(s) applied to every Export call,
+// for example grpc.MaxCallRecvMsgSize or grpc.MaxCallSendMsgSize.
+// Options passed directly to Export are applied after these defaults.
+func WithCallOptions(opts ...grpc.CallOption) Option {
+	return func(c *metricsClient) {
+		c.callOptions = append(c.callOptions, opts...)
+	}
+}
+
+// WithDefaultTimeout sets a timeout applied to every Export call. The context passed to Export
+// is given a deadline d from the start of the call: if it already has a deadline, the earlier of
+// the two is used, so a shorter deadline set by the caller always wins.
+func WithDefaultTimeout(d time.Duration) Option {
+	return func(c *metricsClient) {
+		c.defaultTimeout = d
+	}
 }
 
 // NewClient returns a new Client connected using the given connection.
-func NewClient(cc *grpc.ClientConn) Client {
-	return &metricsClient{rawClient: otlpcollectormetrics.NewMetricsServiceClient(cc)}
+//
+// The Client does not own the connection: callers must keep a reference to cc to observe its state,
+// using cc.GetState and cc.WaitForStateChange, and to close it. The grpc.WaitForReady(true) call option
+// makes Export wait for the connection to be ready, instead of failing when it is not.
+func NewClient(cc *grpc.ClientConn, opts ...Option) Client {
+	c := &metricsClient{rawClient: otlpcollectormetrics.NewMetricsServiceClient(cc)}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
 }
 
 func (c *metricsClient) Export(ctx context.Context, request Request, opts ...grpc.CallOption) (Response, error) {
+	if len(c.callOptions) > 0 {
+		opts = append(append(make([]grpc.CallOption, 0, len(c.callOptions)+len(opts)), c.callOptions...), opts...)
+	}
+	if err := ctx.Err(); err != nil {
+		// Fail fast, without dialing, with the status code matching the context error.
+		return Response{}, status.FromContextError(err).Err()
+	}
+	if c.defaultTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.defaultTimeout)
+		defer cancel()
+	}
 	rsp, err := c.rawClient.Export(ctx, request.orig, opts...)
 	return Response{orig: rsp}, err
 }
@@ -148,22 +429,87 @@ func (c *metricsClient) Export(ctx context.Context, request Request, opts ...grp
 type Server interface {
 	// Export is called every time a new request is received.
 	//
+	// The context is the one of the incoming gRPC call, so the request headers
+	// can be read using metadata.FromIncomingContext, and header and trailer
+	// metadata can be sent back to the client using grpc.SetHeader and grpc.SetTrailer.
+	//
 	// For performance reasons, it is recommended to keep this RPC
 	// alive for the entire life of the application.
 	Export(context.Context, Request) (Response, error)
 }
 
+// ServerOption represents the possible options for RegisterServer.
+type ServerOption func(*interceptedServer)
+
+// WithUnaryServerInterceptors sets interceptors that are called only for the OTLP metrics Export method,
+// instead of for every method of the grpc.Server. The first interceptor is the outermost one.
+// Interceptors receive the Request as the req argument and return the Response as the resp value.
+func WithUnaryServerInterceptors(interceptors ...grpc.UnaryServerInterceptor) ServerOption {
+	return func(s *interceptedServer) {
+		s.interceptors = append(s.interceptors, interceptors...)
+	}
+}
+
+// ServiceDesc returns the description of the OTLP gRPC MetricsService, as registered by RegisterServer,
+// e.g. to check its method names against the other services registered on the same grpc.Server.
+// The returned description can be registered with grpc.Server.RegisterService and a Server implementation.
+func ServiceDesc() *grpc.ServiceDesc {
+	// The generated handlers are called with the Server wrapped as the generated server interface.
+	desc := otlpcollectormetrics.MetricsServiceDesc()
+	for i := range desc.Methods {
+		handler := desc.Methods[i].Handler
+		desc.Methods[i].Handler = func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+			return handler(&rawMetricsServer{srv: srv.(Server)}, ctx, dec, interceptor)
+		}
+	}
+	desc.HandlerType = (*Server)(nil)
+	return &desc
+}
+
 // RegisterServer registers the Server to the grpc.Server.
-func RegisterServer(s *grpc.Server, srv Server) {
-	otlpcollectormetrics.RegisterMetricsServiceServer(s, &rawMetricsServer{srv: srv})
+// Importing this package registers the gzip compressor, so gzip compressed requests are accepted.
+func RegisterServer(s *grpc.Server, srv Server, opts ...ServerOption) {
+	if len(opts) > 0 {
+		is := &interceptedServer{srv: srv}
+		for _, opt := range opts {
+			opt(is)
+		}
+		srv = is
+	}
+	s.RegisterService(ServiceDesc(), srv)
 }
 
+// exportFullMethod is the full gRPC method name of the Export method, passed to the interceptors.
+const exportFullMethod = "/opentelemetry.proto.collector.metrics.v1.MetricsService/Export"
+
 type rawMetricsServer struct {
 	srv Server
 }
 
-func (s rawMetricsServer) Export(ctx context.Context, request *otlpcollectormetrics.ExportMetricsServiceRequest) (*otlpcollectormetrics.ExportMetricsServiceResponse, error) {
-	otlp.InstrumentationLibraryMetricsToScope(request.ResourceMetrics)
+func (s *rawMetricsServer) Export(ctx context.Context, request *otlpcollectormetrics.ExportMetricsServiceRequest) (*otlpcollectormetrics.ExportMetricsServiceResponse, error) {
 	rsp, err := s.srv.Export(ctx, Request{orig: request})
 	return rsp.orig, err
 }
+
+// interceptedServer is the Server calling the interceptors set by WithUnaryServerInterceptors before the Server.
+type interceptedServer struct {
+	srv          Server
+	interceptors []grpc.UnaryServerInterceptor
+}
+
+func (s *interceptedServer) Export(ctx context.Context, request Request) (Response, error) {
+	info := &grpc.UnaryServerInfo{Server: s.srv, FullMethod: exportFullMethod}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return s.srv.Export(ctx, req.(Request))
+	}
+	for i := len(s.interceptors) - 1; i >= 0; i-- {
+		interceptor, next := s.interceptors[i], handler
+		handler = func(ctx context.Context, req interface{}) (interface{}, error) {
+			return interceptor(ctx, req, info, next)
+		}
+	}
+	resp, err := handler(ctx, request)
+	// Interceptors may return an error without calling the handler.
+	rsp, _ := resp.(Response)
+	return rsp, err
+}
@@ -15,24 +15,32 @@
 package pmetricotlp
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
 	"net"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/backoff"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/connectivity"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/encoding/gzip"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/durationpb"
 
-	v1 "go.opentelemetry.io/collector/pdata/internal/data/protogen/metrics/v1"
-	"go.opentelemetry.io/collector/pdata/internal/otlp"
+	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 )
 
@@ -42,6 +50,8 @@ var _ json.Marshaler = Response{}
 var _ json.Unmarshaler = Request{}
 var _ json.Marshaler = Request{}
 
+var _ pcommon.ExportRequest = Request{}
+
 var metricsRequestJSON = []byte(`
 	{
 		"resourceMetrics": [
@@ -61,53 +71,37 @@ var metricsRequestJSON = []byte(`
 		]
 	}`)
 
-var metricsTransitionData = [][]byte{
-	[]byte(`
-		{
-		"resourceMetrics": [
-			{
-				"resource": {},
-				"instrumentationLibraryMetrics": [
-					{
-						"instrumentationLibrary": {},
-						"metrics": [
-							{
-								"name": "test_metric"
-							}
-						]
-					}
-				]
-			}
-		]
-		}`),
-	[]byte(`
-		{
-		"resourceMetrics": [
-			{
-				"resource": {},
-				"instrumentationLibraryMetrics": [
-					{
-						"instrumentationLibrary": {},
-						"metrics": [
-							{
-								"name": "test_metric"
-							}
-						]
-					}
-				],
-				"scopeMetrics": [
-					{
-						"scope": {},
-						"metrics": [
-							{
-								"name": "test_metric"
-							}
-						]
-					}
-				]
-			}
-		]
-		}`),
+func TestResponsePartialSuccess(t *testing.T) {
+	mr := NewResponse()
+	assert.Equal(t, int64(0), mr.PartialSuccess().RejectedDataPoints())
+	assert.Equal(t, "", mr.PartialSuccess().ErrorMessage())
+
+	mr.PartialSuccess().SetRejectedDataPoints(5)
+	mr.PartialSuccess().SetErrorMessage("throttled")
+	assert.Equal(t, int64(5), mr.PartialSuccess().RejectedDataPoints())
+	assert.Equal(t, "throttled", mr.PartialSuccess().ErrorMessage())
+
+	jsonBuf, err := mr.MarshalJSON()
+	assert.NoError(t, err)
+	assert.Equal(t, `{"partialSuccess":{"rejectedDataPoints":"5","errorMessage":"throttled"}}`, string(jsonBuf))
+	fromJSON := NewResponse()
+	assert.NoError(t, fromJSON.UnmarshalJSON(jsonBuf))
+	assert.Equal(t, mr, fromJSON)
+
+	protoBuf, err := mr.MarshalProto()
+	assert.NoError(t, err)
+	fromProto := NewResponse()
+	assert.NoError(t, fromProto.UnmarshalProto(protoBuf))
+	assert.Equal(t, mr, fromProto)
+}
+
+func TestResponsePartialSuccessEmptyResponse(t *testing.T) {
+	// The Response returned along with an error by Client.Export has no message.
+	ps := Response{}.PartialSuccess()
+	assert.Equal(t, int64(0), ps.RejectedDataPoints())
+	assert.Equal(t, "", ps.ErrorMessage())
+	ps.SetErrorMessage("ignored")
+	assert.Equal(t, "ignored", ps.ErrorMessage())
 }
 
 func TestRequestToPData(t *testing.T) {
@@ -127,15 +121,214 @@ func TestRequestJSON(t *testing.T) {
 	assert.Equal(t, strings.Join(strings.Fields(string(metricsRequestJSON)), ""), string(got))
 }
 
-func TestRequestJSONTransition(t *testing.T) {
-	for _, data := range metricsTransitionData {
-		mr := NewRequest()
-		assert.NoError(t, mr.UnmarshalJSON(data))
-		assert.Equal(t, "test_metric", mr.Metrics().ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Name())
+func TestRequestJSONIndent(t *testing.T) {
+	mr := NewRequest()
+	assert.NoError(t, mr.UnmarshalJSON(metricsRequestJSON))
+
+	got, err := mr.MarshalJSONIndent("\t", "\t")
+	assert.NoError(t, err)
+	assert.Equal(t, strings.TrimSpace(string(metricsRequestJSON)), string(got))
+}
+
+// stdJSONCodec uses encoding/json, as a compatible library like jsoniter does.
+type stdJSONCodec struct{}
+
+func (stdJSONCodec) Marshal(v interface{}) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func (stdJSONCodec) Unmarshal(data []byte, v interface{}) error {
+	return json.Unmarshal(data, v)
+}
+
+func TestSetJSONCodec(t *testing.T) {
+	SetJSONCodec(stdJSONCodec{})
+	t.Cleanup(func() { SetJSONCodec(nil) })
+
+	md := pmetric.NewMetrics()
+	md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty().SetName("test_metric")
+	req := NewRequestFromMetrics(md)
+	got, err := req.MarshalJSON()
+	require.NoError(t, err)
+	// encoding/json uses the proto field names of the struct tags.
+	assert.Contains(t, string(got), `"resource_metrics":[`)
+	assert.Contains(t, string(got), `"name":"test_metric"`)
+	decoded := NewRequest()
+	require.NoError(t, decoded.UnmarshalJSON(got))
+	assert.Equal(t, req, decoded)
+
+	resp := NewResponse()
+	resp.PartialSuccess().SetErrorMessage("error")
+	got, err = resp.MarshalJSON()
+	require.NoError(t, err)
+	assert.Equal(t, `{"partial_success":{"error_message":"error"}}`, string(got))
+	decodedResp := NewResponse()
+	require.NoError(t, decodedResp.UnmarshalJSON(got))
+	assert.Equal(t, "error", decodedResp.PartialSuccess().ErrorMessage())
+
+	SetJSONCodec(nil)
+	got, err = req.MarshalJSON()
+	require.NoError(t, err)
+	assert.Contains(t, string(got), `"resourceMetrics":[`)
+
+	_, err = jsonpbCodec{}.Marshal("not a proto")
+	assert.EqualError(t, err, "cannot marshal string, not a proto message")
+	assert.EqualError(t, jsonpbCodec{}.Unmarshal([]byte("{}"), "not a proto"), "cannot unmarshal into string, not a proto message")
+}
+
+func TestSetJSONCodecConcurrent(t *testing.T) {
+	t.Cleanup(func() { SetJSONCodec(nil) })
+	req := NewRequest()
+	require.NoError(t, req.UnmarshalJSON(metricsRequestJSON))
+
+	wg := sync.WaitGroup{}
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			SetJSONCodec(stdJSONCodec{})
+			SetJSONCodec(nil)
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			_, err := req.MarshalJSON()
+			assert.NoError(t, err)
+		}
+	}()
+	wg.Wait()
+}
+
+func TestRequestProto(t *testing.T) {
+	mr := NewRequest()
+	assert.NoError(t, mr.UnmarshalJSON(metricsRequestJSON))
 
-		got, err := mr.MarshalJSON()
-		assert.NoError(t, err)
-		assert.Equal(t, strings.Join(strings.Fields(string(metricsRequestJSON)), ""), string(got))
+	buf, err := mr.MarshalProto()
+	assert.NoError(t, err)
+
+	got := NewRequest()
+	assert.NoError(t, got.UnmarshalProto(buf))
+	assert.Equal(t, mr, got)
+}
+
+func TestRequestClone(t *testing.T) {
+	mr := NewRequest()
+	assert.NoError(t, mr.UnmarshalJSON(metricsRequestJSON))
+
+	clone := mr.Clone()
+	assert.Equal(t, mr, clone)
+
+	// Modifying the clone must not change the original.
+	clone.Metrics().ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).SetName("changed")
+	clone.Metrics().ResourceMetrics().AppendEmpty()
+	assert.Equal(t, "test_metric", mr.Metrics().ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Name())
+	assert.Equal(t, 1, mr.Metrics().ResourceMetrics().Len())
+}
+
+func TestNewRequestFromMetrics(t *testing.T) {
+	md := generateMetricsRequest().Metrics()
+
+	shared := NewRequestFromMetrics(md)
+	copied := NewRequestFromMetricsCopy(md)
+	assert.Equal(t, shared, copied)
+
+	// Changes to the Metrics are reflected only in the Request sharing the data.
+	md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).SetName("changed")
+	md.ResourceMetrics().AppendEmpty()
+	assert.Equal(t, md, shared.Metrics())
+	assert.Equal(t, generateMetricsRequest().Metrics(), copied.Metrics())
+}
+
+func TestRequestSize(t *testing.T) {
+	mr := NewRequest()
+	assert.Equal(t, 0, mr.Size())
+
+	assert.NoError(t, mr.UnmarshalJSON(metricsRequestJSON))
+	buf, err := mr.MarshalProto()
+	assert.NoError(t, err)
+	assert.Equal(t, len(buf), mr.Size())
+}
+
+func TestRequestMarshalProtoTo(t *testing.T) {
+	mr := NewRequest()
+	assert.NoError(t, mr.UnmarshalJSON(metricsRequestJSON))
+	expected, err := mr.MarshalProto()
+	require.NoError(t, err)
+
+	// Nil buffer.
+	buf, err := mr.MarshalProtoTo(nil)
+	require.NoError(t, err)
+	assert.Equal(t, expected, buf)
+
+	// The existing content is preserved.
+	buf, err = mr.MarshalProtoTo([]byte("prefix"))
+	require.NoError(t, err)
+	assert.Equal(t, append([]byte("prefix"), expected...), buf)
+
+	// A buffer with enough capacity is reused.
+	reused := make([]byte, 0, len(expected)+10)
+	buf, err = mr.MarshalProtoTo(reused)
+	require.NoError(t, err)
+	assert.Equal(t, expected, buf)
+	assert.Same(t, &reused[:1][0], &buf[0])
+
+	// Empty request.
+	buf, err = NewRequest().MarshalProtoTo(reused[:0])
+	require.NoError(t, err)
+	assert.Empty(t, buf)
+}
+
+func TestRequestItemCount(t *testing.T) {
+	assert.Equal(t, 0, NewRequest().ItemCount())
+
+	md := pmetric.NewMetrics()
+	ms := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics()
+	gauge := ms.AppendEmpty()
+	gauge.SetDataType(pmetric.MetricDataTypeGauge)
+	gauge.Gauge().DataPoints().AppendEmpty()
+	gauge.Gauge().DataPoints().AppendEmpty()
+	sum := ms.AppendEmpty()
+	sum.SetDataType(pmetric.MetricDataTypeSum)
+	sum.Sum().DataPoints().AppendEmpty()
+	ms.AppendEmpty().SetDataType(pmetric.MetricDataTypeHistogram)
+
+	mr := NewRequestFromMetrics(md)
+	assert.Equal(t, 3, mr.ItemCount())
+	assert.Equal(t, 3, mr.Metrics().MetricCount())
+}
+
+func TestRequestJSONFrom(t *testing.T) {
+	mr := NewRequest()
+	assert.NoError(t, mr.UnmarshalJSONFrom(bytes.NewReader(metricsRequestJSON)))
+
+	expected := NewRequest()
+	assert.NoError(t, expected.UnmarshalJSON(metricsRequestJSON))
+	assert.Equal(t, expected, mr)
+}
+
+func TestRequestJSONFromAppends(t *testing.T) {
+	mr := NewRequest()
+	assert.NoError(t, mr.UnmarshalJSONFrom(bytes.NewReader(metricsRequestJSON)))
+	assert.NoError(t, mr.UnmarshalJSONFrom(bytes.NewReader(metricsRequestJSON)))
+	assert.Equal(t, 2, mr.Metrics().ResourceMetrics().Len())
+
+	assert.NoError(t, mr.UnmarshalJSONFrom(strings.NewReader(`{"resourceMetrics":null}`)))
+	assert.NoError(t, mr.UnmarshalJSONFrom(strings.NewReader(`{}`)))
+	assert.Equal(t, 2, mr.Metrics().ResourceMetrics().Len())
+}
+
+func TestRequestJSONFromError(t *testing.T) {
+	for _, data := range []string{
+		``,
+		`[]`,
+		`{"unknown":[]}`,
+		`{"resourceMetrics":{}}`,
+		`{"resourceMetrics":[{"unknown":1}]}`,
+		`{"resourceMetrics":[]`,
+	} {
+		mr := NewRequest()
+		assert.Error(t, mr.UnmarshalJSONFrom(strings.NewReader(data)), data)
 	}
 }
 
@@ -172,7 +365,7 @@ func TestGrpc(t *testing.T) {
 	assert.Equal(t, NewResponse(), resp)
 }
 
-func TestGrpcTransition(t *testing.T) {
+func TestGrpcGzip(t *testing.T) {
 	lis := bufconn.Listen(1024 * 1024)
 	s := grpc.NewServer()
 	RegisterServer(s, &fakeMetricsServer{t: t})
@@ -200,9 +393,7 @@ func TestGrpcTransition(t *testing.T) {
 
 	logClient := NewClient(cc)
 
-	req := generateMetricsRequestWithInstrumentationLibrary()
-	otlp.InstrumentationLibraryMetricsToScope(req.orig.ResourceMetrics)
-	resp, err := logClient.Export(context.Background(), req)
+	resp, err := logClient.Export(context.Background(), generateMetricsRequest(), grpc.UseCompressor(gzip.Name))
 	assert.NoError(t, err)
 	assert.Equal(t, NewResponse(), resp)
 }
@@ -244,7 +435,283 @@ func TestGrpcExport(t *testing.T) {
 
 	metricClient := NewClient(cc)
 
-	resp, err := metricClient.Export(context.Background(), generateMetricsRequestWithInstrumentationLibrary())
+	resp, err := metricClient.Export(context.Background(), generateMetricsRequest())
+	assert.NoError(t, err)
+	assert.Equal(t, NewResponse(), resp)
+}
+
+func TestGrpcClientCallOptions(t *testing.T) {
+	lis := bufconn.Listen(1024 * 1024)
+	s := grpc.NewServer()
+	RegisterServer(s, &fakeMetricsServer{t: t})
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		assert.NoError(t, s.Serve(lis))
+	}()
+	t.Cleanup(func() {
+		s.Stop()
+		wg.Wait()
+	})
+
+	cc, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+			return lis.Dial()
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithBlock())
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		assert.NoError(t, cc.Close())
+	})
+
+	metricClient := NewClient(cc, WithCallOptions(grpc.MaxCallSendMsgSize(1)))
+	_, err = metricClient.Export(context.Background(), generateMetricsRequest())
+	require.Error(t, err)
+	st, okSt := status.FromError(err)
+	require.True(t, okSt)
+	assert.Equal(t, codes.ResourceExhausted, st.Code())
+
+	// Options passed to Export take precedence over the defaults.
+	resp, err := metricClient.Export(context.Background(), generateMetricsRequest(), grpc.MaxCallSendMsgSize(1024*1024))
+	assert.NoError(t, err)
+	assert.Equal(t, NewResponse(), resp)
+}
+
+func TestGrpcClientWaitForReady(t *testing.T) {
+	lis := bufconn.Listen(1024 * 1024)
+	s := grpc.NewServer()
+	RegisterServer(s, &fakeMetricsServer{t: t})
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		assert.NoError(t, s.Serve(lis))
+	}()
+	t.Cleanup(func() {
+		s.Stop()
+		wg.Wait()
+	})
+
+	// The server is not reachable until ready is set.
+	var ready int32
+	cc, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+			if atomic.LoadInt32(&ready) == 0 {
+				return nil, errors.New("not ready")
+			}
+			return lis.Dial()
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithConnectParams(grpc.ConnectParams{Backoff: backoff.Config{BaseDelay: 10 * time.Millisecond, MaxDelay: 10 * time.Millisecond}}))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		assert.NoError(t, cc.Close())
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	for state := cc.GetState(); state != connectivity.TransientFailure; state = cc.GetState() {
+		require.True(t, cc.WaitForStateChange(ctx, state))
+	}
+
+	// Without waiting for the connection to be ready, Export fails.
+	metricClient := NewClient(cc)
+	_, err = metricClient.Export(ctx, generateMetricsRequest())
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+
+	// Waiting for the connection to be ready, Export succeeds once the server is reachable.
+	metricClient = NewClient(cc, WithCallOptions(grpc.WaitForReady(true)))
+	time.AfterFunc(50*time.Millisecond, func() { atomic.StoreInt32(&ready, 1) })
+	resp, err := metricClient.Export(ctx, generateMetricsRequest())
+	assert.NoError(t, err)
+	assert.Equal(t, NewResponse(), resp)
+}
+
+type fakeMetadataServer struct {
+	t *testing.T
+}
+
+func (f fakeMetadataServer) Export(ctx context.Context, _ Request) (Response, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	assert.True(f.t, ok)
+	assert.Equal(f.t, []string{"tenant-1"}, md.Get("X-Tenant-ID"))
+	return NewResponse(), nil
+}
+
+func TestGrpcMetadata(t *testing.T) {
+	lis := bufconn.Listen(1024 * 1024)
+	s := grpc.NewServer()
+	RegisterServer(s, &fakeMetadataServer{t: t})
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		assert.NoError(t, s.Serve(lis))
+	}()
+	t.Cleanup(func() {
+		s.Stop()
+		wg.Wait()
+	})
+
+	cc, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+			return lis.Dial()
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithBlock())
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		assert.NoError(t, cc.Close())
+	})
+
+	metricClient := NewClient(cc)
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "X-Tenant-ID", "tenant-1")
+	resp, err := metricClient.Export(ctx, generateMetricsRequest())
+	assert.NoError(t, err)
+	assert.Equal(t, NewResponse(), resp)
+}
+
+type fakeTrailerServer struct {
+	t *testing.T
+}
+
+func (f fakeTrailerServer) Export(ctx context.Context, _ Request) (Response, error) {
+	assert.NoError(f.t, grpc.SetTrailer(ctx, metadata.Pairs("retry-after", "30")))
+	return Response{}, status.Error(codes.Unavailable, "throttled")
+}
+
+func TestGrpcTrailer(t *testing.T) {
+	lis := bufconn.Listen(1024 * 1024)
+	s := grpc.NewServer()
+	RegisterServer(s, &fakeTrailerServer{t: t})
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		assert.NoError(t, s.Serve(lis))
+	}()
+	t.Cleanup(func() {
+		s.Stop()
+		wg.Wait()
+	})
+
+	cc, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+			return lis.Dial()
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithBlock())
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		assert.NoError(t, cc.Close())
+	})
+
+	metricClient := NewClient(cc)
+
+	var trailer metadata.MD
+	_, err = metricClient.Export(context.Background(), generateMetricsRequest(), grpc.Trailer(&trailer))
+	st, okSt := status.FromError(err)
+	require.True(t, okSt)
+	assert.Equal(t, codes.Unavailable, st.Code())
+	assert.Equal(t, []string{"30"}, trailer.Get("retry-after"))
+}
+
+func TestGrpcServerInterceptors(t *testing.T) {
+	var calls []string
+	recordInterceptor := func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		assert.Equal(t, "/opentelemetry.proto.collector.metrics.v1.MetricsService/Export", info.FullMethod)
+		assert.Equal(t, generateMetricsRequest(), req)
+		calls = append(calls, "record")
+		resp, err := handler(ctx, req)
+		if err == nil {
+			assert.Equal(t, NewResponse(), resp)
+		}
+		return resp, err
+	}
+	authInterceptor := func(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		calls = append(calls, "auth")
+		md, _ := metadata.FromIncomingContext(ctx)
+		if len(md.Get("authorization")) == 0 {
+			return nil, status.Error(codes.Unauthenticated, "missing authorization")
+		}
+		return handler(ctx, req)
+	}
+
+	lis := bufconn.Listen(1024 * 1024)
+	s := grpc.NewServer()
+	RegisterServer(s, &fakeMetricsServer{t: t}, WithUnaryServerInterceptors(recordInterceptor, authInterceptor))
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		assert.NoError(t, s.Serve(lis))
+	}()
+	t.Cleanup(func() {
+		s.Stop()
+		wg.Wait()
+	})
+
+	cc, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+			return lis.Dial()
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithBlock())
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		assert.NoError(t, cc.Close())
+	})
+
+	metricClient := NewClient(cc)
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer token")
+	resp, err := metricClient.Export(ctx, generateMetricsRequest())
+	assert.NoError(t, err)
+	assert.Equal(t, NewResponse(), resp)
+	assert.Equal(t, []string{"record", "auth"}, calls)
+
+	_, err = metricClient.Export(context.Background(), generateMetricsRequest())
+	st, okSt := status.FromError(err)
+	require.True(t, okSt)
+	assert.Equal(t, codes.Unauthenticated, st.Code())
+}
+
+func TestServiceDesc(t *testing.T) {
+	lis := bufconn.Listen(1024 * 1024)
+	s := grpc.NewServer()
+	s.RegisterService(ServiceDesc(), &fakeMetricsServer{t: t})
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		assert.NoError(t, s.Serve(lis))
+	}()
+	t.Cleanup(func() {
+		s.Stop()
+		wg.Wait()
+	})
+
+	// The description matches the service registered by RegisterServer.
+	registered := grpc.NewServer()
+	RegisterServer(registered, &fakeMetricsServer{t: t})
+	assert.Equal(t, registered.GetServiceInfo(), s.GetServiceInfo())
+
+	cc, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+			return lis.Dial()
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithBlock())
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		assert.NoError(t, cc.Close())
+	})
+
+	metricClient := NewClient(cc)
+	resp, err := metricClient.Export(context.Background(), generateMetricsRequest())
 	assert.NoError(t, err)
 	assert.Equal(t, NewResponse(), resp)
 }
@@ -285,6 +752,118 @@ func TestGrpcError(t *testing.T) {
 	assert.Equal(t, Response{}, resp)
 }
 
+func TestGrpcContextDone(t *testing.T) {
+	lis := bufconn.Listen(1024 * 1024)
+	s := grpc.NewServer()
+	RegisterServer(s, &fakeMetricsServer{t: t})
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		assert.NoError(t, s.Serve(lis))
+	}()
+	t.Cleanup(func() {
+		s.Stop()
+		wg.Wait()
+	})
+
+	cc, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+			return lis.Dial()
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithBlock())
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		assert.NoError(t, cc.Close())
+	})
+
+	client := NewClient(cc)
+
+	canceledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+	resp, err := client.Export(canceledCtx, generateMetricsRequest())
+	assert.Equal(t, codes.Canceled, status.Code(err))
+	assert.Equal(t, Response{}, resp)
+	assert.Equal(t, "", resp.PartialSuccess().ErrorMessage())
+
+	expiredCtx, cancel := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancel()
+	resp, err = client.Export(expiredCtx, generateMetricsRequest())
+	assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+	assert.Equal(t, Response{}, resp)
+}
+
+func TestGrpcDefaultTimeout(t *testing.T) {
+	lis := bufconn.Listen(1024 * 1024)
+	s := grpc.NewServer()
+	srv := &deadlineMetricsServer{}
+	RegisterServer(s, srv)
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		assert.NoError(t, s.Serve(lis))
+	}()
+	t.Cleanup(func() {
+		s.Stop()
+		wg.Wait()
+	})
+
+	cc, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+			return lis.Dial()
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithBlock())
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		assert.NoError(t, cc.Close())
+	})
+
+	client := NewClient(cc, WithDefaultTimeout(time.Minute))
+
+	// The default timeout is applied when the caller sets no deadline.
+	start := time.Now()
+	_, err = client.Export(context.Background(), generateMetricsRequest())
+	require.NoError(t, err)
+	require.True(t, srv.hasDeadline)
+	assert.WithinDuration(t, start.Add(time.Minute), srv.deadline, 10*time.Second)
+
+	// A shorter deadline set by the caller wins.
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	callerDeadline, _ := ctx.Deadline()
+	_, err = client.Export(ctx, generateMetricsRequest())
+	require.NoError(t, err)
+	require.True(t, srv.hasDeadline)
+	assert.WithinDuration(t, callerDeadline, srv.deadline, time.Second)
+
+	// A longer deadline set by the caller is shortened to the default timeout.
+	ctx, cancel = context.WithTimeout(context.Background(), time.Hour)
+	defer cancel()
+	start = time.Now()
+	_, err = client.Export(ctx, generateMetricsRequest())
+	require.NoError(t, err)
+	require.True(t, srv.hasDeadline)
+	assert.WithinDuration(t, start.Add(time.Minute), srv.deadline, 10*time.Second)
+
+	// Without the option the caller context is used as is.
+	_, err = NewClient(cc).Export(context.Background(), generateMetricsRequest())
+	require.NoError(t, err)
+	assert.False(t, srv.hasDeadline)
+}
+
+type deadlineMetricsServer struct {
+	deadline    time.Time
+	hasDeadline bool
+}
+
+func (f *deadlineMetricsServer) Export(ctx context.Context, _ Request) (Response, error) {
+	f.deadline, f.hasDeadline = ctx.Deadline()
+	return NewResponse(), nil
+}
+
 type fakeMetricsServer struct {
 	t   *testing.T
 	err error
@@ -304,13 +883,15 @@ func generateMetricsRequest() Request {
 	return NewRequestFromMetrics(md)
 }
 
-func generateMetricsRequestWithInstrumentationLibrary() Request {
-	mr := generateMetricsRequest()
-	mr.orig.ResourceMetrics[0].InstrumentationLibraryMetrics = []*v1.InstrumentationLibraryMetrics{ //nolint:staticcheck // SA1019 ignore this!
-		{
-			Metrics: mr.orig.ResourceMetrics[0].ScopeMetrics[0].Metrics,
-		},
-	}
-	mr.orig.ResourceMetrics[0].ScopeMetrics = []*v1.ScopeMetrics{}
-	return mr
+func TestIsRetryable(t *testing.T) {
+	assert.False(t, IsRetryable(nil))
+	assert.True(t, IsRetryable(status.Error(codes.Unavailable, "unavailable")))
+	assert.False(t, IsRetryable(status.Error(codes.InvalidArgument, "invalid")))
+	assert.False(t, IsRetryable(status.Error(codes.ResourceExhausted, "exhausted")))
+	assert.Equal(t, time.Duration(0), RetryDelay(status.Error(codes.Unavailable, "unavailable")))
+
+	st, err := status.New(codes.ResourceExhausted, "exhausted").WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(time.Second)})
+	require.NoError(t, err)
+	assert.True(t, IsRetryable(st.Err()))
+	assert.Equal(t, time.Second, RetryDelay(st.Err()))
 }
@@ -0,0 +1,167 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pmetricotlp // import "go.opentelemetry.io/collector/pdata/pmetric/pmetricotlp"
+
+import (
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+var (
+	errEmptyName              = errors.New("metric name is empty")
+	errDataTypeNotSet         = errors.New("metric data type is not set")
+	errUnspecifiedTemporality = errors.New("aggregation temporality is unspecified")
+	errZeroTimestamp          = errors.New("timestamp is zero")
+	errExplicitBoundsMismatch = errors.New("number of explicit bounds must be one less than the number of bucket counts")
+	errQuantileOutOfRange     = errors.New("quantile must be between 0 and 1")
+)
+
+// Validate checks the semantic constraints of the OTLP metrics in the Request, that are not enforced
+// by the protocol itself, e.g. sums and histograms must have an aggregation temporality and data points
+// must have a non-zero timestamp. It returns the first violation found, prefixed with its path in the Request,
+// e.g. `resourceMetrics[0].scopeMetrics[0].metrics[1].dataPoints[2]: timestamp is zero`.
+func (mr Request) Validate() error {
+	rms := mr.Metrics().ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		sms := rms.At(i).ScopeMetrics()
+		for j := 0; j < sms.Len(); j++ {
+			ms := sms.At(j).Metrics()
+			for k := 0; k < ms.Len(); k++ {
+				if err := validateMetric(ms.At(k)); err != nil {
+					return fmt.Errorf("resourceMetrics[%d].scopeMetrics[%d].metrics[%d]%w", i, j, k, err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// pathError is an error found at a path relative to the element being validated.
+type pathError struct {
+	path string
+	err  error
+}
+
+func (pe *pathError) Error() string {
+	return pe.path + ": " + pe.err.Error()
+}
+
+func (pe *pathError) Unwrap() error {
+	return pe.err
+}
+
+func validateMetric(m pmetric.Metric) error {
+	if m.Name() == "" {
+		return &pathError{err: errEmptyName}
+	}
+	switch m.DataType() {
+	case pmetric.MetricDataTypeGauge:
+		return validateNumberDataPoints(m.Gauge().DataPoints())
+	case pmetric.MetricDataTypeSum:
+		if m.Sum().AggregationTemporality() == pmetric.MetricAggregationTemporalityUnspecified {
+			return &pathError{err: errUnspecifiedTemporality}
+		}
+		return validateNumberDataPoints(m.Sum().DataPoints())
+	case pmetric.MetricDataTypeHistogram:
+		if m.Histogram().AggregationTemporality() == pmetric.MetricAggregationTemporalityUnspecified {
+			return &pathError{err: errUnspecifiedTemporality}
+		}
+		return validateHistogramDataPoints(m.Histogram().DataPoints())
+	case pmetric.MetricDataTypeExponentialHistogram:
+		if m.ExponentialHistogram().AggregationTemporality() == pmetric.MetricAggregationTemporalityUnspecified {
+			return &pathError{err: errUnspecifiedTemporality}
+		}
+		return validateExponentialHistogramDataPoints(m.ExponentialHistogram().DataPoints())
+	case pmetric.MetricDataTypeSummary:
+		return validateSummaryDataPoints(m.Summary().DataPoints())
+	}
+	return &pathError{err: errDataTypeNotSet}
+}
+
+func validateNumberDataPoints(dps pmetric.NumberDataPointSlice) error {
+	for i := 0; i < dps.Len(); i++ {
+		if dps.At(i).Timestamp() == 0 {
+			return dataPointError(i, errZeroTimestamp)
+		}
+	}
+	return nil
+}
+
+func validateHistogramDataPoints(dps pmetric.HistogramDataPointSlice) error {
+	for i := 0; i < dps.Len(); i++ {
+		dp := dps.At(i)
+		if dp.Timestamp() == 0 {
+			return dataPointError(i, errZeroTimestamp)
+		}
+		if len(dp.BucketCounts()) == 0 {
+			continue
+		}
+		if len(dp.ExplicitBounds()) != len(dp.BucketCounts())-1 {
+			return dataPointError(i, errExplicitBoundsMismatch)
+		}
+		if sum := sumBucketCounts(dp.BucketCounts()); sum != dp.Count() {
+			return dataPointError(i, bucketCountsError(sum, dp.Count()))
+		}
+	}
+	return nil
+}
+
+func validateExponentialHistogramDataPoints(dps pmetric.ExponentialHistogramDataPointSlice) error {
+	for i := 0; i < dps.Len(); i++ {
+		dp := dps.At(i)
+		if dp.Timestamp() == 0 {
+			return dataPointError(i, errZeroTimestamp)
+		}
+		sum := dp.ZeroCount() + sumBucketCounts(dp.Positive().BucketCounts()) + sumBucketCounts(dp.Negative().BucketCounts())
+		if sum != dp.Count() {
+			return dataPointError(i, bucketCountsError(sum, dp.Count()))
+		}
+	}
+	return nil
+}
+
+func validateSummaryDataPoints(dps pmetric.SummaryDataPointSlice) error {
+	for i := 0; i < dps.Len(); i++ {
+		dp := dps.At(i)
+		if dp.Timestamp() == 0 {
+			return dataPointError(i, errZeroTimestamp)
+		}
+		qvs := dp.QuantileValues()
+		for j := 0; j < qvs.Len(); j++ {
+			if q := qvs.At(j).Quantile(); q < 0 || q > 1 {
+				return &pathError{path: fmt.Sprintf(".dataPoints[%d].quantileValues[%d]", i, j), err: errQuantileOutOfRange}
+			}
+		}
+	}
+	return nil
+}
+
+func dataPointError(i int, err error) error {
+	return &pathError{path: fmt.Sprintf(".dataPoints[%d]", i), err: err}
+}
+
+func bucketCountsError(sum uint64, count uint64) error {
+	return fmt.Errorf("bucket counts sum up to %d, expected count %d", sum, count)
+}
+
+func sumBucketCounts(counts []uint64) uint64 {
+	var sum uint64
+	for _, c := range counts {
+		sum += c
+	}
+	return sum
+}
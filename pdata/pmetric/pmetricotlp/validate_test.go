@@ -0,0 +1,153 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pmetricotlp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+func TestRequestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		metric  func(pmetric.Metric)
+		wantErr string
+	}{
+		{
+			name: "valid_gauge",
+			metric: func(m pmetric.Metric) {
+				m.SetDataType(pmetric.MetricDataTypeGauge)
+				m.Gauge().DataPoints().AppendEmpty().SetTimestamp(1)
+			},
+		},
+		{
+			name:    "empty_name",
+			metric:  func(m pmetric.Metric) { m.SetName("") },
+			wantErr: "resourceMetrics[0].scopeMetrics[0].metrics[1]: metric name is empty",
+		},
+		{
+			name:    "data_type_not_set",
+			metric:  func(m pmetric.Metric) {},
+			wantErr: "resourceMetrics[0].scopeMetrics[0].metrics[1]: metric data type is not set",
+		},
+		{
+			name: "gauge_zero_timestamp",
+			metric: func(m pmetric.Metric) {
+				m.SetDataType(pmetric.MetricDataTypeGauge)
+				m.Gauge().DataPoints().AppendEmpty().SetTimestamp(1)
+				m.Gauge().DataPoints().AppendEmpty()
+			},
+			wantErr: "resourceMetrics[0].scopeMetrics[0].metrics[1].dataPoints[1]: timestamp is zero",
+		},
+		{
+			name: "sum_unspecified_temporality",
+			metric: func(m pmetric.Metric) {
+				m.SetDataType(pmetric.MetricDataTypeSum)
+				m.Sum().DataPoints().AppendEmpty().SetTimestamp(1)
+			},
+			wantErr: "resourceMetrics[0].scopeMetrics[0].metrics[1]: aggregation temporality is unspecified",
+		},
+		{
+			name: "valid_histogram",
+			metric: func(m pmetric.Metric) {
+				m.SetDataType(pmetric.MetricDataTypeHistogram)
+				m.Histogram().SetAggregationTemporality(pmetric.MetricAggregationTemporalityCumulative)
+				dp := m.Histogram().DataPoints().AppendEmpty()
+				dp.SetTimestamp(1)
+				dp.SetCount(3)
+				dp.SetBucketCounts([]uint64{1, 2})
+				dp.SetExplicitBounds([]float64{10})
+			},
+		},
+		{
+			name: "histogram_bucket_counts_mismatch",
+			metric: func(m pmetric.Metric) {
+				m.SetDataType(pmetric.MetricDataTypeHistogram)
+				m.Histogram().SetAggregationTemporality(pmetric.MetricAggregationTemporalityCumulative)
+				dp := m.Histogram().DataPoints().AppendEmpty()
+				dp.SetTimestamp(1)
+				dp.SetCount(5)
+				dp.SetBucketCounts([]uint64{1, 2})
+				dp.SetExplicitBounds([]float64{10})
+			},
+			wantErr: "resourceMetrics[0].scopeMetrics[0].metrics[1].dataPoints[0]: bucket counts sum up to 3, expected count 5",
+		},
+		{
+			name: "histogram_explicit_bounds_mismatch",
+			metric: func(m pmetric.Metric) {
+				m.SetDataType(pmetric.MetricDataTypeHistogram)
+				m.Histogram().SetAggregationTemporality(pmetric.MetricAggregationTemporalityDelta)
+				dp := m.Histogram().DataPoints().AppendEmpty()
+				dp.SetTimestamp(1)
+				dp.SetCount(3)
+				dp.SetBucketCounts([]uint64{1, 2})
+			},
+			wantErr: "resourceMetrics[0].scopeMetrics[0].metrics[1].dataPoints[0]: number of explicit bounds must be one less than the number of bucket counts",
+		},
+		{
+			name: "exponential_histogram_counts_mismatch",
+			metric: func(m pmetric.Metric) {
+				m.SetDataType(pmetric.MetricDataTypeExponentialHistogram)
+				m.ExponentialHistogram().SetAggregationTemporality(pmetric.MetricAggregationTemporalityDelta)
+				dp := m.ExponentialHistogram().DataPoints().AppendEmpty()
+				dp.SetTimestamp(1)
+				dp.SetCount(4)
+				dp.SetZeroCount(1)
+				dp.Positive().SetBucketCounts([]uint64{1, 1})
+			},
+			wantErr: "resourceMetrics[0].scopeMetrics[0].metrics[1].dataPoints[0]: bucket counts sum up to 3, expected count 4",
+		},
+		{
+			name: "summary_quantile_out_of_range",
+			metric: func(m pmetric.Metric) {
+				m.SetDataType(pmetric.MetricDataTypeSummary)
+				dp := m.Summary().DataPoints().AppendEmpty()
+				dp.SetTimestamp(1)
+				dp.QuantileValues().AppendEmpty().SetQuantile(0.5)
+				dp.QuantileValues().AppendEmpty().SetQuantile(99)
+			},
+			wantErr: "resourceMetrics[0].scopeMetrics[0].metrics[1].dataPoints[0].quantileValues[1]: quantile must be between 0 and 1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mr := NewRequest()
+			ms := mr.Metrics().ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics()
+			valid := ms.AppendEmpty()
+			valid.SetName("valid")
+			valid.SetDataType(pmetric.MetricDataTypeGauge)
+			valid.Gauge().DataPoints().AppendEmpty().SetTimestamp(1)
+
+			m := ms.AppendEmpty()
+			m.SetName("test_metric")
+			tt.metric(m)
+
+			err := mr.Validate()
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestRequestValidateEmpty(t *testing.T) {
+	assert.NoError(t, NewRequest().Validate())
+}
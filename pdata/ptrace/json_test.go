@@ -52,3 +52,70 @@ func TestTracesJSON_Marshal(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, tracesJSON, string(jsonBuf))
 }
+
+func TestTracesJSON_MarshalOmitEmptyResourceAndScope(t *testing.T) {
+	td := NewTraces()
+	sls := td.ResourceSpans().AppendEmpty().ScopeSpans()
+	sls.AppendEmpty().Spans().AppendEmpty().SetName("testSpan")
+	sl := sls.AppendEmpty()
+	sl.Scope().SetName("name")
+	sl.Spans().AppendEmpty().SetName("testSpan")
+
+	encoder := NewJSONMarshaler(WithOmitEmptyResourceAndScope())
+	jsonBuf, err := encoder.MarshalTraces(td)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"resourceSpans":[{"scopeSpans":[{"spans":[{"traceId":"","spanId":"","parentSpanId":"","name":"testSpan","status":{}}]},{"scope":{"name":"name"},"spans":[{"traceId":"","spanId":"","parentSpanId":"","name":"testSpan","status":{}}]}]}]}`, string(jsonBuf))
+
+	got, err := NewJSONUnmarshaler().UnmarshalTraces(jsonBuf)
+	assert.NoError(t, err)
+	assert.EqualValues(t, td, got)
+
+	// Non empty resource and scope are kept.
+	jsonBuf, err = encoder.MarshalTraces(tracesOTLP)
+	assert.NoError(t, err)
+	assert.Equal(t, tracesJSON, string(jsonBuf))
+}
+
+func TestTracesJSON_OmitZeroTimestamps(t *testing.T) {
+	td := NewTraces()
+	span := td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+	span.SetName("span")
+	span.SetStartTimestamp(1234)
+	span.Events().AppendEmpty().SetName("event")
+
+	jsonBuf, err := NewJSONMarshaler(WithOmitEmptyResourceAndScope()).MarshalTraces(td)
+	assert.NoError(t, err)
+	assert.NotContains(t, string(jsonBuf), `UnixNano":"0"`)
+	assert.NotContains(t, string(jsonBuf), `"endTimeUnixNano"`)
+	assert.Contains(t, string(jsonBuf), `"startTimeUnixNano":"1234"`)
+
+	got, err := NewJSONUnmarshaler().UnmarshalTraces(jsonBuf)
+	assert.NoError(t, err)
+	assert.EqualValues(t, td, got)
+}
+
+func TestTracesJSON_SnakeCaseFieldNames(t *testing.T) {
+	td := NewTraces()
+	span := td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+	span.SetName("span")
+	span.SetStartTimestamp(1234)
+
+	camelCase, err := NewJSONMarshaler().MarshalTraces(td)
+	assert.NoError(t, err)
+	assert.Contains(t, string(camelCase), `"startTimeUnixNano":"1234"`)
+	assert.Contains(t, string(camelCase), `"scopeSpans"`)
+	assert.NotContains(t, string(camelCase), `"start_time_unix_nano"`)
+
+	snakeCase, err := NewJSONMarshaler(WithSnakeCaseFieldNames()).MarshalTraces(td)
+	assert.NoError(t, err)
+	assert.Contains(t, string(snakeCase), `"start_time_unix_nano":"1234"`)
+	assert.Contains(t, string(snakeCase), `"scope_spans"`)
+	assert.NotContains(t, string(snakeCase), `"startTimeUnixNano"`)
+
+	// Both naming conventions are accepted.
+	for _, jsonBuf := range [][]byte{camelCase, snakeCase} {
+		got, err := NewJSONUnmarshaler().UnmarshalTraces(jsonBuf)
+		assert.NoError(t, err)
+		assert.EqualValues(t, td, got)
+	}
+}
@@ -15,6 +15,7 @@
 package ptraceotlp
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
@@ -22,17 +23,21 @@ import (
 	"strings"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/encoding/gzip"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/durationpb"
 
-	v1 "go.opentelemetry.io/collector/pdata/internal/data/protogen/trace/v1"
-	"go.opentelemetry.io/collector/pdata/internal/otlp"
+	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 )
 
@@ -42,6 +47,8 @@ var _ json.Marshaler = Response{}
 var _ json.Unmarshaler = Request{}
 var _ json.Marshaler = Request{}
 
+var _ pcommon.ExportRequest = Request{}
+
 var tracesRequestJSON = []byte(`
 	{
 		"resourceSpans": [
@@ -65,65 +72,37 @@ var tracesRequestJSON = []byte(`
 		]
 	}`)
 
-var tracesTransitionData = [][]byte{
-	[]byte(`
-	{
-		"resourceSpans": [
-			{
-				"resource": {},
-				"instrumentationLibrarySpans": [
-					{
-						"instrumentationLibrary": {},
-						"spans": [
-							{
-								"traceId": "",
-								"spanId":"",
-								"parentSpanId":"",
-								"name": "test_span",
-								"status": {}
-							}
-						]
-					}
-				]
-			}
-		]
-	}`),
-	[]byte(`
-	{
-		"resourceSpans": [
-			{
-				"resource": {},
-				"instrumentationLibrarySpans": [
-					{
-						"instrumentationLibrary": {},
-						"spans": [
-							{
-								"traceId": "",
-								"spanId":"",
-								"parentSpanId":"",
-								"name": "test_span",
-								"status": {}
-							}
-						]
-					}
-				],
-				"scopeSpans": [
-					{
-						"scope": {},
-						"spans": [
-							{
-								"traceId": "",
-								"spanId":"",
-								"parentSpanId":"",
-								"name": "test_span",
-								"status": {}
-							}
-						]
-					}
-				]
-			}
-		]
-	}`),
+func TestResponsePartialSuccess(t *testing.T) {
+	tr := NewResponse()
+	assert.Equal(t, int64(0), tr.PartialSuccess().RejectedSpans())
+	assert.Equal(t, "", tr.PartialSuccess().ErrorMessage())
+
+	tr.PartialSuccess().SetRejectedSpans(5)
+	tr.PartialSuccess().SetErrorMessage("throttled")
+	assert.Equal(t, int64(5), tr.PartialSuccess().RejectedSpans())
+	assert.Equal(t, "throttled", tr.PartialSuccess().ErrorMessage())
+
+	jsonBuf, err := tr.MarshalJSON()
+	assert.NoError(t, err)
+	assert.Equal(t, `{"partialSuccess":{"rejectedSpans":"5","errorMessage":"throttled"}}`, string(jsonBuf))
+	fromJSON := NewResponse()
+	assert.NoError(t, fromJSON.UnmarshalJSON(jsonBuf))
+	assert.Equal(t, tr, fromJSON)
+
+	protoBuf, err := tr.MarshalProto()
+	assert.NoError(t, err)
+	fromProto := NewResponse()
+	assert.NoError(t, fromProto.UnmarshalProto(protoBuf))
+	assert.Equal(t, tr, fromProto)
+}
+
+func TestResponsePartialSuccessEmptyResponse(t *testing.T) {
+	// The Response returned along with an error by Client.Export has no message.
+	ps := Response{}.PartialSuccess()
+	assert.Equal(t, int64(0), ps.RejectedSpans())
+	assert.Equal(t, "", ps.ErrorMessage())
+	ps.SetErrorMessage("ignored")
+	assert.Equal(t, "ignored", ps.ErrorMessage())
 }
 
 func TestRequestToPData(t *testing.T) {
@@ -143,15 +122,209 @@ func TestRequestJSON(t *testing.T) {
 	assert.Equal(t, strings.Join(strings.Fields(string(tracesRequestJSON)), ""), string(got))
 }
 
-func TestRequestJSONTransition(t *testing.T) {
-	for _, data := range tracesTransitionData {
-		tr := NewRequest()
-		assert.NoError(t, tr.UnmarshalJSON(data))
-		assert.Equal(t, "test_span", tr.Traces().ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).Name())
+func TestRequestJSONIndent(t *testing.T) {
+	tr := NewRequest()
+	assert.NoError(t, tr.UnmarshalJSON(tracesRequestJSON))
+
+	got, err := tr.MarshalJSONIndent("\t", "\t")
+	assert.NoError(t, err)
+	assert.Contains(t, string(got), "\n\t\t\"resourceSpans\": [")
+	assert.Equal(t, strings.Join(strings.Fields(string(tracesRequestJSON)), ""), strings.Join(strings.Fields(string(got)), ""))
+}
+
+// stdJSONCodec uses encoding/json, as a compatible library like jsoniter does.
+type stdJSONCodec struct{}
 
-		got, err := tr.MarshalJSON()
-		assert.NoError(t, err)
-		assert.Equal(t, strings.Join(strings.Fields(string(tracesRequestJSON)), ""), string(got))
+func (stdJSONCodec) Marshal(v interface{}) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func (stdJSONCodec) Unmarshal(data []byte, v interface{}) error {
+	return json.Unmarshal(data, v)
+}
+
+func TestSetJSONCodec(t *testing.T) {
+	SetJSONCodec(stdJSONCodec{})
+	t.Cleanup(func() { SetJSONCodec(nil) })
+
+	td := ptrace.NewTraces()
+	td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty().SetName("test_span")
+	req := NewRequestFromTraces(td)
+	got, err := req.MarshalJSON()
+	require.NoError(t, err)
+	// encoding/json uses the proto field names of the struct tags.
+	assert.Contains(t, string(got), `"resource_spans":[`)
+	assert.Contains(t, string(got), `"name":"test_span"`)
+	decoded := NewRequest()
+	require.NoError(t, decoded.UnmarshalJSON(got))
+	assert.Equal(t, req, decoded)
+
+	resp := NewResponse()
+	resp.PartialSuccess().SetErrorMessage("error")
+	got, err = resp.MarshalJSON()
+	require.NoError(t, err)
+	assert.Equal(t, `{"partial_success":{"error_message":"error"}}`, string(got))
+	decodedResp := NewResponse()
+	require.NoError(t, decodedResp.UnmarshalJSON(got))
+	assert.Equal(t, "error", decodedResp.PartialSuccess().ErrorMessage())
+
+	SetJSONCodec(nil)
+	got, err = req.MarshalJSON()
+	require.NoError(t, err)
+	assert.Contains(t, string(got), `"resourceSpans":[`)
+
+	_, err = jsonpbCodec{}.Marshal("not a proto")
+	assert.EqualError(t, err, "cannot marshal string, not a proto message")
+	assert.EqualError(t, jsonpbCodec{}.Unmarshal([]byte("{}"), "not a proto"), "cannot unmarshal into string, not a proto message")
+}
+
+func TestSetJSONCodecConcurrent(t *testing.T) {
+	t.Cleanup(func() { SetJSONCodec(nil) })
+	req := NewRequest()
+	require.NoError(t, req.UnmarshalJSON(tracesRequestJSON))
+
+	wg := sync.WaitGroup{}
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			SetJSONCodec(stdJSONCodec{})
+			SetJSONCodec(nil)
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			_, err := req.MarshalJSON()
+			assert.NoError(t, err)
+		}
+	}()
+	wg.Wait()
+}
+
+func TestRequestSize(t *testing.T) {
+	tr := NewRequest()
+	assert.Equal(t, 0, tr.Size())
+
+	assert.NoError(t, tr.UnmarshalJSON(tracesRequestJSON))
+	buf, err := tr.MarshalProto()
+	assert.NoError(t, err)
+	assert.Equal(t, len(buf), tr.Size())
+}
+
+func TestRequestProto(t *testing.T) {
+	tr := NewRequest()
+	assert.NoError(t, tr.UnmarshalJSON(tracesRequestJSON))
+
+	buf, err := tr.MarshalProto()
+	assert.NoError(t, err)
+
+	got := NewRequest()
+	assert.NoError(t, got.UnmarshalProto(buf))
+	assert.Equal(t, tr, got)
+}
+
+func TestRequestClone(t *testing.T) {
+	tr := NewRequest()
+	assert.NoError(t, tr.UnmarshalJSON(tracesRequestJSON))
+
+	clone := tr.Clone()
+	assert.Equal(t, tr, clone)
+
+	// Modifying the clone must not change the original.
+	clone.Traces().ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).SetName("changed")
+	clone.Traces().ResourceSpans().AppendEmpty()
+	assert.Equal(t, "test_span", tr.Traces().ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).Name())
+	assert.Equal(t, 1, tr.Traces().ResourceSpans().Len())
+}
+
+func TestNewRequestFromTraces(t *testing.T) {
+	td := generateTracesRequest().Traces()
+
+	shared := NewRequestFromTraces(td)
+	copied := NewRequestFromTracesCopy(td)
+	assert.Equal(t, shared, copied)
+
+	// Changes to the Traces are reflected only in the Request sharing the data.
+	td.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).SetName("changed")
+	td.ResourceSpans().AppendEmpty()
+	assert.Equal(t, td, shared.Traces())
+	assert.Equal(t, generateTracesRequest().Traces(), copied.Traces())
+}
+
+func TestRequestMarshalProtoTo(t *testing.T) {
+	tr := NewRequest()
+	assert.NoError(t, tr.UnmarshalJSON(tracesRequestJSON))
+	expected, err := tr.MarshalProto()
+	require.NoError(t, err)
+
+	// Nil buffer.
+	buf, err := tr.MarshalProtoTo(nil)
+	require.NoError(t, err)
+	assert.Equal(t, expected, buf)
+
+	// The existing content is preserved.
+	buf, err = tr.MarshalProtoTo([]byte("prefix"))
+	require.NoError(t, err)
+	assert.Equal(t, append([]byte("prefix"), expected...), buf)
+
+	// A buffer with enough capacity is reused.
+	reused := make([]byte, 0, len(expected)+10)
+	buf, err = tr.MarshalProtoTo(reused)
+	require.NoError(t, err)
+	assert.Equal(t, expected, buf)
+	assert.Same(t, &reused[:1][0], &buf[0])
+
+	// Empty request.
+	buf, err = NewRequest().MarshalProtoTo(reused[:0])
+	require.NoError(t, err)
+	assert.Empty(t, buf)
+}
+
+func TestRequestItemCount(t *testing.T) {
+	assert.Equal(t, 0, NewRequest().ItemCount())
+
+	td := ptrace.NewTraces()
+	ss := td.ResourceSpans().AppendEmpty().ScopeSpans()
+	ss.AppendEmpty().Spans().AppendEmpty()
+	ss.AppendEmpty().Spans().AppendEmpty()
+	ss.AppendEmpty().Spans().AppendEmpty()
+	ss.AppendEmpty()
+
+	assert.Equal(t, 3, NewRequestFromTraces(td).ItemCount())
+}
+
+func TestRequestJSONFrom(t *testing.T) {
+	tr := NewRequest()
+	assert.NoError(t, tr.UnmarshalJSONFrom(bytes.NewReader(tracesRequestJSON)))
+
+	expected := NewRequest()
+	assert.NoError(t, expected.UnmarshalJSON(tracesRequestJSON))
+	assert.Equal(t, expected, tr)
+}
+
+func TestRequestJSONFromAppends(t *testing.T) {
+	tr := NewRequest()
+	assert.NoError(t, tr.UnmarshalJSONFrom(bytes.NewReader(tracesRequestJSON)))
+	assert.NoError(t, tr.UnmarshalJSONFrom(bytes.NewReader(tracesRequestJSON)))
+	assert.Equal(t, 2, tr.Traces().ResourceSpans().Len())
+
+	assert.NoError(t, tr.UnmarshalJSONFrom(strings.NewReader(`{"resourceSpans":null}`)))
+	assert.NoError(t, tr.UnmarshalJSONFrom(strings.NewReader(`{}`)))
+	assert.Equal(t, 2, tr.Traces().ResourceSpans().Len())
+}
+
+func TestRequestJSONFromError(t *testing.T) {
+	for _, data := range []string{
+		``,
+		`[]`,
+		`{"unknown":[]}`,
+		`{"resourceSpans":{}}`,
+		`{"resourceSpans":[{"unknown":1}]}`,
+		`{"resourceSpans":[]`,
+	} {
+		tr := NewRequest()
+		assert.Error(t, tr.UnmarshalJSONFrom(strings.NewReader(data)), data)
 	}
 }
 
@@ -188,7 +361,7 @@ func TestGrpc(t *testing.T) {
 	assert.Equal(t, NewResponse(), resp)
 }
 
-func TestGrpcTransition(t *testing.T) {
+func TestGrpcGzip(t *testing.T) {
 	lis := bufconn.Listen(1024 * 1024)
 	s := grpc.NewServer()
 	RegisterServer(s, &fakeTracesServer{t: t})
@@ -216,9 +389,7 @@ func TestGrpcTransition(t *testing.T) {
 
 	logClient := NewClient(cc)
 
-	req := generateTracesRequestWithInstrumentationLibrary()
-	otlp.InstrumentationLibrarySpansToScope(req.orig.ResourceSpans)
-	resp, err := logClient.Export(context.Background(), req)
+	resp, err := logClient.Export(context.Background(), generateTracesRequest(), grpc.UseCompressor(gzip.Name))
 	assert.NoError(t, err)
 	assert.Equal(t, NewResponse(), resp)
 }
@@ -260,12 +431,109 @@ func TestGrpcExport(t *testing.T) {
 	})
 	traceClient := NewClient(cc)
 
-	req := generateTracesRequestWithInstrumentationLibrary()
+	req := generateTracesRequest()
 	resp, err := traceClient.Export(context.Background(), req)
 	assert.NoError(t, err)
 	assert.Equal(t, NewResponse(), resp)
 }
 
+func TestGrpcServerInterceptors(t *testing.T) {
+	var calls []string
+	recordInterceptor := func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		assert.Equal(t, "/opentelemetry.proto.collector.trace.v1.TraceService/Export", info.FullMethod)
+		assert.Equal(t, generateTracesRequest(), req)
+		calls = append(calls, "record")
+		resp, err := handler(ctx, req)
+		if err == nil {
+			assert.Equal(t, NewResponse(), resp)
+		}
+		return resp, err
+	}
+	authInterceptor := func(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		calls = append(calls, "auth")
+		md, _ := metadata.FromIncomingContext(ctx)
+		if len(md.Get("authorization")) == 0 {
+			return nil, status.Error(codes.Unauthenticated, "missing authorization")
+		}
+		return handler(ctx, req)
+	}
+
+	lis := bufconn.Listen(1024 * 1024)
+	s := grpc.NewServer()
+	RegisterServer(s, &fakeTracesServer{t: t}, WithUnaryServerInterceptors(recordInterceptor, authInterceptor))
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		assert.NoError(t, s.Serve(lis))
+	}()
+	t.Cleanup(func() {
+		s.Stop()
+		wg.Wait()
+	})
+
+	cc, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+			return lis.Dial()
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithBlock())
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		assert.NoError(t, cc.Close())
+	})
+
+	traceClient := NewClient(cc)
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer token")
+	resp, err := traceClient.Export(ctx, generateTracesRequest())
+	assert.NoError(t, err)
+	assert.Equal(t, NewResponse(), resp)
+	assert.Equal(t, []string{"record", "auth"}, calls)
+
+	_, err = traceClient.Export(context.Background(), generateTracesRequest())
+	st, okSt := status.FromError(err)
+	require.True(t, okSt)
+	assert.Equal(t, codes.Unauthenticated, st.Code())
+}
+
+func TestServiceDesc(t *testing.T) {
+	lis := bufconn.Listen(1024 * 1024)
+	s := grpc.NewServer()
+	s.RegisterService(ServiceDesc(), &fakeTracesServer{t: t})
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		assert.NoError(t, s.Serve(lis))
+	}()
+	t.Cleanup(func() {
+		s.Stop()
+		wg.Wait()
+	})
+
+	// The description matches the service registered by RegisterServer.
+	registered := grpc.NewServer()
+	RegisterServer(registered, &fakeTracesServer{t: t})
+	assert.Equal(t, registered.GetServiceInfo(), s.GetServiceInfo())
+
+	cc, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+			return lis.Dial()
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithBlock())
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		assert.NoError(t, cc.Close())
+	})
+
+	traceClient := NewClient(cc)
+	resp, err := traceClient.Export(context.Background(), generateTracesRequest())
+	assert.NoError(t, err)
+	assert.Equal(t, NewResponse(), resp)
+}
+
 func TestGrpcError(t *testing.T) {
 	lis := bufconn.Listen(1024 * 1024)
 	s := grpc.NewServer()
@@ -302,6 +570,118 @@ func TestGrpcError(t *testing.T) {
 	assert.Equal(t, Response{}, resp)
 }
 
+func TestGrpcContextDone(t *testing.T) {
+	lis := bufconn.Listen(1024 * 1024)
+	s := grpc.NewServer()
+	RegisterServer(s, &fakeTracesServer{t: t})
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		assert.NoError(t, s.Serve(lis))
+	}()
+	t.Cleanup(func() {
+		s.Stop()
+		wg.Wait()
+	})
+
+	cc, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+			return lis.Dial()
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithBlock())
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		assert.NoError(t, cc.Close())
+	})
+
+	client := NewClient(cc)
+
+	canceledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+	resp, err := client.Export(canceledCtx, generateTracesRequest())
+	assert.Equal(t, codes.Canceled, status.Code(err))
+	assert.Equal(t, Response{}, resp)
+	assert.Equal(t, "", resp.PartialSuccess().ErrorMessage())
+
+	expiredCtx, cancel := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancel()
+	resp, err = client.Export(expiredCtx, generateTracesRequest())
+	assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+	assert.Equal(t, Response{}, resp)
+}
+
+func TestGrpcDefaultTimeout(t *testing.T) {
+	lis := bufconn.Listen(1024 * 1024)
+	s := grpc.NewServer()
+	srv := &deadlineTracesServer{}
+	RegisterServer(s, srv)
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		assert.NoError(t, s.Serve(lis))
+	}()
+	t.Cleanup(func() {
+		s.Stop()
+		wg.Wait()
+	})
+
+	cc, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+			return lis.Dial()
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithBlock())
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		assert.NoError(t, cc.Close())
+	})
+
+	client := NewClient(cc, WithDefaultTimeout(time.Minute))
+
+	// The default timeout is applied when the caller sets no deadline.
+	start := time.Now()
+	_, err = client.Export(context.Background(), generateTracesRequest())
+	require.NoError(t, err)
+	require.True(t, srv.hasDeadline)
+	assert.WithinDuration(t, start.Add(time.Minute), srv.deadline, 10*time.Second)
+
+	// A shorter deadline set by the caller wins.
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	callerDeadline, _ := ctx.Deadline()
+	_, err = client.Export(ctx, generateTracesRequest())
+	require.NoError(t, err)
+	require.True(t, srv.hasDeadline)
+	assert.WithinDuration(t, callerDeadline, srv.deadline, time.Second)
+
+	// A longer deadline set by the caller is shortened to the default timeout.
+	ctx, cancel = context.WithTimeout(context.Background(), time.Hour)
+	defer cancel()
+	start = time.Now()
+	_, err = client.Export(ctx, generateTracesRequest())
+	require.NoError(t, err)
+	require.True(t, srv.hasDeadline)
+	assert.WithinDuration(t, start.Add(time.Minute), srv.deadline, 10*time.Second)
+
+	// Without the option the caller context is used as is.
+	_, err = NewClient(cc).Export(context.Background(), generateTracesRequest())
+	require.NoError(t, err)
+	assert.False(t, srv.hasDeadline)
+}
+
+type deadlineTracesServer struct {
+	deadline    time.Time
+	hasDeadline bool
+}
+
+func (f *deadlineTracesServer) Export(ctx context.Context, _ Request) (Response, error) {
+	f.deadline, f.hasDeadline = ctx.Deadline()
+	return NewResponse(), nil
+}
+
 type fakeTracesServer struct {
 	t   *testing.T
 	err error
@@ -318,13 +698,15 @@ func generateTracesRequest() Request {
 	return NewRequestFromTraces(td)
 }
 
-func generateTracesRequestWithInstrumentationLibrary() Request {
-	tr := generateTracesRequest()
-	tr.orig.ResourceSpans[0].InstrumentationLibrarySpans = []*v1.InstrumentationLibrarySpans{ //nolint:staticcheck // SA1019 ignore this!
-		{
-			Spans: tr.orig.ResourceSpans[0].ScopeSpans[0].Spans,
-		},
-	}
-	tr.orig.ResourceSpans[0].ScopeSpans = []*v1.ScopeSpans{}
-	return tr
+func TestIsRetryable(t *testing.T) {
+	assert.False(t, IsRetryable(nil))
+	assert.True(t, IsRetryable(status.Error(codes.Unavailable, "unavailable")))
+	assert.False(t, IsRetryable(status.Error(codes.InvalidArgument, "invalid")))
+	assert.False(t, IsRetryable(status.Error(codes.ResourceExhausted, "exhausted")))
+	assert.Equal(t, time.Duration(0), RetryDelay(status.Error(codes.Unavailable, "unavailable")))
+
+	st, err := status.New(codes.ResourceExhausted, "exhausted").WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(time.Second)})
+	require.NoError(t, err)
+	assert.True(t, IsRetryable(st.Err()))
+	assert.Equal(t, time.Second, RetryDelay(st.Err()))
 }
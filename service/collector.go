@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"os"
 	"os/signal"
+	"reflect"
 	"runtime"
 	"syscall"
 
@@ -34,6 +35,7 @@ import (
 	"go.uber.org/zap"
 
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config"
 	"go.opentelemetry.io/collector/extension/ballastextension"
 	"go.opentelemetry.io/collector/service/internal"
 	"go.opentelemetry.io/collector/service/internal/telemetrylogs"
@@ -83,6 +85,10 @@ type Collector struct {
 	service *service
 	state   *atomic.Int32
 
+	// cfgMap is the config.Map of the config of the running service, nil if the ConfigProvider
+	// cannot provide it, see configMapProvider.
+	cfgMap *config.Map
+
 	// shutdownChan is used to terminate the collector.
 	shutdownChan chan struct{}
 
@@ -109,7 +115,8 @@ func New(set CollectorSettings) (*Collector, error) {
 			TracerProvider: trace.NewNoopTracerProvider(),
 			MeterProvider:  nonrecording.NewNoopMeterProvider(),
 		},
-		asyncErrorChannel: make(chan error),
+		// Buffered so the first fatal error is never dropped, see serviceHost.ReportFatalError.
+		asyncErrorChannel: make(chan error, 1),
 
 		set:          set,
 		state:        atomic.NewInt32(int32(Starting)),
@@ -146,9 +153,10 @@ func (col *Collector) runAndWaitForShutdownEvent(ctx context.Context) error {
 	col.telemetry.Logger.Info("Everything is ready. Begin running and processing data.")
 
 	col.signalsChannel = make(chan os.Signal, 1)
-	// Only notify with SIGTERM and SIGINT if graceful shutdown is enabled.
+	// Only notify with SIGTERM, SIGINT and SIGHUP if graceful shutdown is enabled,
+	// so the config is not reloaded on SIGHUP either when it is disabled.
 	if !col.set.DisableGracefulShutdown {
-		signal.Notify(col.signalsChannel, os.Interrupt, syscall.SIGTERM)
+		signal.Notify(col.signalsChannel, os.Interrupt, syscall.SIGTERM, syscall.SIGHUP)
 	}
 
 	col.setCollectorState(Running)
@@ -162,19 +170,20 @@ LOOP:
 			}
 
 			col.telemetry.Logger.Warn("Config updated, restart service")
-			col.setCollectorState(Closing)
-
-			if err = col.service.Shutdown(ctx); err != nil {
-				return fmt.Errorf("failed to shutdown the retiring config: %w", err)
-			}
-			if err = col.setupConfigurationComponents(ctx); err != nil {
-				return fmt.Errorf("failed to setup configuration components: %w", err)
+			if err = col.reloadConfiguration(ctx); err != nil {
+				return err
 			}
 		case err := <-col.asyncErrorChannel:
 			col.telemetry.Logger.Error("Asynchronous error received, terminating process", zap.Error(err))
 			break LOOP
 		case s := <-col.signalsChannel:
 			col.telemetry.Logger.Info("Received signal from OS", zap.String("signal", s.String()))
+			if s == syscall.SIGHUP {
+				if err := col.reloadConfiguration(ctx); err != nil {
+					return err
+				}
+				continue
+			}
 			break LOOP
 		case <-col.shutdownChan:
 			col.telemetry.Logger.Info("Received shutdown request")
@@ -189,6 +198,102 @@ LOOP:
 	return col.shutdown(ctx)
 }
 
+// reloadConfiguration loads the new config and restarts the service with it. If the new config fails
+// to load or its components cannot be built, the error is logged and the current service keeps running.
+// If the components of the new config fail to start, the service is restarted with the current config.
+// If the new config is equal to the current one, the service is not restarted.
+//
+// If the change, as reported by config.Map.Diff, is limited to some pipelines and the components they use,
+// only these pipelines are restarted, see restartPipelines.
+func (col *Collector) reloadConfiguration(ctx context.Context) error {
+	cfg, err := col.set.ConfigProvider.Get(ctx, col.set.Factories)
+	if err != nil {
+		col.telemetry.Logger.Error("Failed to reload the config, keep running the current config", zap.Error(err))
+		return nil
+	}
+	current := col.service
+	if reflect.DeepEqual(cfg, current.config) {
+		col.telemetry.Logger.Info("Config unchanged, service not restarted")
+		return nil
+	}
+
+	cfgMap := col.configMap()
+	if col.cfgMap != nil && cfgMap != nil {
+		if pipelines, ok := pipelinesToRestart(current.config, cfg, col.cfgMap.Diff(cfgMap)); ok && len(pipelines) > 0 {
+			return col.restartPipelines(ctx, cfg, cfgMap, pipelines)
+		}
+	}
+
+	srv, telemetry, err := col.buildService(cfg)
+	if err != nil {
+		col.telemetry.Logger.Error("Failed to build the reloaded config, keep running the current config", zap.Error(err))
+		return nil
+	}
+
+	col.setCollectorState(Closing)
+	if err = current.Shutdown(ctx); err != nil {
+		return fmt.Errorf("failed to shutdown the retiring config: %w", err)
+	}
+	if err = col.startService(ctx, srv, telemetry); err != nil {
+		col.telemetry.Logger.Error("Failed to start the reloaded config, restart the current config", zap.Error(err))
+		if err = col.setupService(ctx, current.config); err != nil {
+			return fmt.Errorf("failed to restart the current config: %w", err)
+		}
+		col.setCollectorState(Running)
+		col.telemetry.Logger.Info("Current config restarted")
+		return nil
+	}
+	col.cfgMap = cfgMap
+	col.setCollectorState(Running)
+	col.telemetry.Logger.Info("Config reloaded")
+	return nil
+}
+
+// restartPipelines restarts the given pipelines of the running service with the new config, along with their
+// receivers and exporters, while the extensions and the other pipelines keep running. The errors are handled
+// as by reloadConfiguration: the new components are built before the current ones are shutdown, and if they
+// fail to start the current pipelines are built again and restarted.
+func (col *Collector) restartPipelines(ctx context.Context, cfg *config.Config, cfgMap *config.Map, pipelines []config.ComponentID) error {
+	srv := col.service
+	current := srv.config
+	updated, err := srv.buildPipelines(cfg, pipelines)
+	if err != nil {
+		col.telemetry.Logger.Error("Failed to build the reloaded pipelines, keep running the current config", zap.Error(err))
+		return nil
+	}
+
+	if err = srv.shutdownPipelines(ctx, pipelines); err != nil {
+		return fmt.Errorf("failed to shutdown the retiring pipelines: %w", err)
+	}
+	if err = srv.startPipelines(ctx, cfg, pipelines, updated); err != nil {
+		col.telemetry.Logger.Error("Failed to start the reloaded pipelines, restart the current pipelines", zap.Error(err))
+		if updated, err = srv.buildPipelines(current, pipelines); err != nil {
+			return fmt.Errorf("failed to rebuild the current pipelines: %w", err)
+		}
+		if err = srv.startPipelines(ctx, current, pipelines, updated); err != nil {
+			return fmt.Errorf("failed to restart the current pipelines: %w", err)
+		}
+		col.telemetry.Logger.Info("Current pipelines restarted")
+		return nil
+	}
+	col.cfgMap = cfgMap
+	ids := make([]string, 0, len(pipelines))
+	for _, id := range pipelines {
+		ids = append(ids, id.String())
+	}
+	col.telemetry.Logger.Info("Pipelines reloaded", zap.Strings("pipelines", ids))
+	return nil
+}
+
+// configMap returns the config.Map of the config returned by the last successful ConfigProvider.Get,
+// or nil if the ConfigProvider cannot provide it.
+func (col *Collector) configMap() *config.Map {
+	if mp, ok := col.set.ConfigProvider.(configMapProvider); ok {
+		return mp.getMap()
+	}
+	return nil
+}
+
 // setupConfigurationComponents loads the config and starts the components. If all the steps succeeds it
 // sets the col.service with the service currently running.
 func (col *Collector) setupConfigurationComponents(ctx context.Context) error {
@@ -198,40 +303,62 @@ func (col *Collector) setupConfigurationComponents(ctx context.Context) error {
 	if err != nil {
 		return fmt.Errorf("failed to get config: %w", err)
 	}
+	col.cfgMap = col.configMap()
 
-	col.telemetry.MetricsLevel = cfg.Telemetry.Metrics.Level
+	return col.setupService(ctx, cfg)
+}
 
-	if col.telemetry.Logger, err = telemetrylogs.NewLogger(cfg.Service.Telemetry.Logs, col.set.LoggingOptions); err != nil {
-		return fmt.Errorf("failed to get logger: %w", err)
+// setupService builds and starts the components for the given config, see buildService and startService.
+func (col *Collector) setupService(ctx context.Context, cfg *config.Config) error {
+	srv, telemetry, err := col.buildService(cfg)
+	if err != nil {
+		return err
 	}
+	return col.startService(ctx, srv, telemetry)
+}
 
-	if !col.set.SkipSettingGRPCLogger {
-		telemetrylogs.SetColGRPCLogger(col.telemetry.Logger, cfg.Service.Telemetry.Logs.Level)
+// buildService builds the components for the given config, without starting them, and returns
+// the service along with the telemetry settings for the config.
+func (col *Collector) buildService(cfg *config.Config) (*service, component.TelemetrySettings, error) {
+	var err error
+	telemetry := col.telemetry
+	telemetry.MetricsLevel = cfg.Telemetry.Metrics.Level
+	if telemetry.Logger, err = telemetrylogs.NewLogger(cfg.Service.Telemetry.Logs, col.set.LoggingOptions); err != nil {
+		return nil, telemetry, fmt.Errorf("failed to get logger: %w", err)
 	}
 
-	col.service, err = newService(&svcSettings{
+	srv, err := newService(&svcSettings{
 		BuildInfo:           col.set.BuildInfo,
 		Factories:           col.set.Factories,
 		Config:              cfg,
-		Telemetry:           col.telemetry,
+		Telemetry:           telemetry,
 		ZPagesSpanProcessor: col.zPagesSpanProcessor,
 		AsyncErrorChannel:   col.asyncErrorChannel,
 	})
-	if err != nil {
-		return err
+	return srv, telemetry, err
+}
+
+// startService starts the components of srv and, once they are all started, sets the col.service with it.
+// If the components fail to start, the ones already started are shutdown, so that they release their
+// resources such as the ports they listen on, and the col.service is left unchanged.
+func (col *Collector) startService(ctx context.Context, srv *service, telemetry component.TelemetrySettings) error {
+	// The gRPC logger is global, it is set before the components start using it.
+	if !col.set.SkipSettingGRPCLogger {
+		telemetrylogs.SetColGRPCLogger(telemetry.Logger, srv.config.Service.Telemetry.Logs.Level)
 	}
 
+	if err := srv.Start(ctx); err != nil {
+		return multierr.Append(err, srv.Shutdown(ctx))
+	}
+	col.telemetry = telemetry
+	col.service = srv
+
 	// TODO: This should be part of the service initialization, which should be responsible to create TelemetrySettings.
 	// For the moment happens here, since it needs service.Config and Logger.
 	// It is called once because that is how it is implemented using sync.Once.
-	if err = col.set.telemetry.init(col); err != nil {
-		return err
+	if err := col.set.telemetry.init(col); err != nil {
+		return multierr.Append(err, srv.Shutdown(ctx))
 	}
-
-	if err = col.service.Start(ctx); err != nil {
-		return err
-	}
-
 	return nil
 }
 
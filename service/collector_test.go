@@ -19,10 +19,13 @@ import (
 	"bufio"
 	"context"
 	"errors"
+	"net"
 	"net/http"
+	"os"
 	"path/filepath"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"syscall"
 	"testing"
 	"time"
@@ -36,8 +39,10 @@ import (
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config"
 	"go.opentelemetry.io/collector/config/mapconverter/overwritepropertiesmapconverter"
+	"go.opentelemetry.io/collector/extension/zpagesextension"
 	"go.opentelemetry.io/collector/internal/testcomponents"
 	"go.opentelemetry.io/collector/internal/testutil"
+	"go.opentelemetry.io/collector/receiver/otlpreceiver"
 	"go.opentelemetry.io/collector/service/featuregate"
 )
 
@@ -306,6 +311,311 @@ func TestCollectorClosedStateOnStartUpError(t *testing.T) {
 	assert.Equal(t, Closed, col.GetState())
 }
 
+// reloadConfigProvider wraps a ConfigProvider, allowing tests to trigger a config reload,
+// and to make the reloaded configs fail to load or differ from the initial one.
+type reloadConfigProvider struct {
+	ConfigProvider
+	watcher chan error
+	getErr  error
+	modify  func(cfg *config.Config)
+	gets    int32
+}
+
+func (p *reloadConfigProvider) Get(ctx context.Context, factories component.Factories) (*config.Config, error) {
+	if atomic.AddInt32(&p.gets, 1) == 1 {
+		return p.ConfigProvider.Get(ctx, factories)
+	}
+	if p.getErr != nil {
+		return nil, p.getErr
+	}
+	cfg, err := p.ConfigProvider.Get(ctx, factories)
+	if err == nil && p.modify != nil {
+		p.modify(cfg)
+	}
+	return cfg, err
+}
+
+func (p *reloadConfigProvider) Watch() <-chan error {
+	return p.watcher
+}
+
+func TestCollectorReloadConfig(t *testing.T) {
+	zpagesID := config.NewComponentID("zpages")
+	zpagesEndpoint := testutil.GetAvailableLocalAddress(t)
+	setZPagesEndpoint := func(endpoint string) func(cfg *config.Config) {
+		return func(cfg *config.Config) {
+			cfg.Extensions[zpagesID].(*zpagesextension.Config).TCPAddr.Endpoint = endpoint
+		}
+	}
+
+	var testCases = []struct {
+		name    string
+		getErr  error
+		modify  func(cfg *config.Config)
+		reload  func(col *Collector, p *reloadConfigProvider)
+		log     string
+		restart bool
+		// endpoint is the zpages endpoint expected after the reload.
+		endpoint string
+	}{
+		{
+			name: "watch_unchanged",
+			reload: func(col *Collector, p *reloadConfigProvider) {
+				p.watcher <- nil
+			},
+			log:      "Config unchanged, service not restarted",
+			endpoint: zpagesEndpoint,
+		},
+		{
+			name:   "watch_invalid",
+			getErr: errors.New("invalid config"),
+			reload: func(col *Collector, p *reloadConfigProvider) {
+				p.watcher <- nil
+			},
+			log:      "Failed to reload the config, keep running the current config",
+			endpoint: zpagesEndpoint,
+		},
+		{
+			name: "sighup_unchanged",
+			reload: func(col *Collector, p *reloadConfigProvider) {
+				col.signalsChannel <- syscall.SIGHUP
+			},
+			log:      "Config unchanged, service not restarted",
+			endpoint: zpagesEndpoint,
+		},
+		{
+			name:   "sighup_invalid",
+			getErr: errors.New("invalid config"),
+			reload: func(col *Collector, p *reloadConfigProvider) {
+				col.signalsChannel <- syscall.SIGHUP
+			},
+			log:      "Failed to reload the config, keep running the current config",
+			endpoint: zpagesEndpoint,
+		},
+		{
+			name:   "sighup_changed",
+			modify: setZPagesEndpoint("localhost:0"),
+			reload: func(col *Collector, p *reloadConfigProvider) {
+				col.signalsChannel <- syscall.SIGHUP
+			},
+			log:      "Config reloaded",
+			restart:  true,
+			endpoint: "localhost:0",
+		},
+		{
+			name:   "sighup_start_failure",
+			modify: setZPagesEndpoint("localhost:-1"),
+			reload: func(col *Collector, p *reloadConfigProvider) {
+				col.signalsChannel <- syscall.SIGHUP
+			},
+			log:      "Current config restarted",
+			restart:  true,
+			endpoint: zpagesEndpoint,
+		},
+		{
+			// The zpages extension of the reloaded config listens on the same endpoint as the current one,
+			// so the current config can only be restarted if it is shutdown after the receiver fails to start.
+			name: "sighup_start_failure_after_listening",
+			modify: func(cfg *config.Config) {
+				zpages2 := zpagesextension.NewFactory().CreateDefaultConfig().(*zpagesextension.Config)
+				zpages2.ExtensionSettings = config.NewExtensionSettings(config.NewComponentIDWithName("zpages", "2"))
+				zpages2.TCPAddr.Endpoint = testutil.GetAvailableLocalAddress(t)
+				cfg.Extensions[zpages2.ID()] = zpages2
+				cfg.Service.Extensions = append(cfg.Service.Extensions, zpages2.ID())
+				cfg.Receivers[config.NewComponentID("otlp")].(*otlpreceiver.Config).GRPC.NetAddr.Endpoint = "localhost:-1"
+			},
+			reload: func(col *Collector, p *reloadConfigProvider) {
+				col.signalsChannel <- syscall.SIGHUP
+			},
+			log:      "Current config restarted",
+			restart:  true,
+			endpoint: zpagesEndpoint,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			factories, err := testcomponents.NewDefaultFactories()
+			require.NoError(t, err)
+
+			cfgSet := newDefaultConfigProviderSettings([]string{
+				filepath.Join("testdata", "otelcol-config.yaml"),
+				"yaml:service::telemetry::metrics::address: " + testutil.GetAvailableLocalAddress(t),
+				"yaml:extensions::zpages::endpoint: " + zpagesEndpoint,
+			})
+			cfgProvider, err := NewConfigProvider(cfgSet)
+			require.NoError(t, err)
+			provider := &reloadConfigProvider{
+				ConfigProvider: cfgProvider,
+				watcher:        make(chan error),
+				getErr:         test.getErr,
+				modify:         test.modify,
+			}
+
+			// The reload outcome is only observable through the logs, once it is done.
+			logs := make(chan string, 100)
+			col, err := New(CollectorSettings{
+				BuildInfo:      component.NewDefaultBuildInfo(),
+				Factories:      factories,
+				ConfigProvider: provider,
+				LoggingOptions: []zap.Option{zap.Hooks(func(entry zapcore.Entry) error {
+					select {
+					case logs <- entry.Message:
+					default:
+					}
+					return nil
+				})},
+				telemetry: newColTelemetry(featuregate.NewRegistry()),
+			})
+			require.NoError(t, err)
+
+			wg := startCollector(context.Background(), t, col)
+
+			assert.Eventually(t, func() bool {
+				return Running == col.GetState()
+			}, 2*time.Second, 200*time.Millisecond)
+
+			srv := col.service
+			test.reload(col, provider)
+			waitForLog(t, logs, test.log)
+			assert.Equal(t, int32(2), atomic.LoadInt32(&provider.gets))
+			assert.Equal(t, Running, col.GetState())
+
+			if test.restart {
+				assert.NotSame(t, srv, col.service)
+			} else {
+				assert.Same(t, srv, col.service)
+			}
+			assert.Equal(t, test.endpoint, col.service.config.Extensions[zpagesID].(*zpagesextension.Config).TCPAddr.Endpoint)
+
+			col.Shutdown()
+			wg.Wait()
+			assert.Equal(t, Closed, col.GetState())
+		})
+	}
+}
+
+func TestCollectorReloadPipelines(t *testing.T) {
+	otlpID := config.NewComponentID("otlp")
+	zpagesID := config.NewComponentID("zpages")
+	receiverEndpoint := testutil.GetAvailableLocalAddress(t)
+	reloadedEndpoint := testutil.GetAvailableLocalAddress(t)
+	zpagesEndpoint := testutil.GetAvailableLocalAddress(t)
+	receiverConfig := func(endpoint string) string {
+		return "receivers::otlp::protocols::grpc::endpoint: " + endpoint
+	}
+
+	var testCases = []struct {
+		name     string
+		reloaded string
+		log      string
+		// restart is true if the whole service is restarted, and not only the pipelines.
+		restart bool
+		// endpoint is the receiver endpoint expected after the reload.
+		endpoint string
+	}{
+		{
+			name:     "receiver_changed",
+			reloaded: receiverConfig(reloadedEndpoint),
+			log:      "Pipelines reloaded",
+			endpoint: reloadedEndpoint,
+		},
+		{
+			name:     "receiver_start_failure",
+			reloaded: receiverConfig("localhost:-1"),
+			log:      "Current pipelines restarted",
+			endpoint: receiverEndpoint,
+		},
+		{
+			name:     "extension_changed",
+			reloaded: receiverConfig(receiverEndpoint) + "\nextensions::zpages::endpoint: localhost:0",
+			log:      "Config reloaded",
+			restart:  true,
+			endpoint: receiverEndpoint,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			factories, err := testcomponents.NewDefaultFactories()
+			require.NoError(t, err)
+
+			// The reloaded changes are written to the last location, which overrides the others.
+			reloadFile := filepath.Join(t.TempDir(), "reload.yaml")
+			require.NoError(t, os.WriteFile(reloadFile, []byte(receiverConfig(receiverEndpoint)), 0600))
+			cfgProvider, err := NewConfigProvider(newDefaultConfigProviderSettings([]string{
+				filepath.Join("testdata", "otelcol-config.yaml"),
+				"yaml:service::telemetry::metrics::address: " + testutil.GetAvailableLocalAddress(t),
+				"yaml:extensions::zpages::endpoint: " + zpagesEndpoint,
+				reloadFile,
+			}))
+			require.NoError(t, err)
+
+			logs := make(chan string, 100)
+			col, err := New(CollectorSettings{
+				BuildInfo:      component.NewDefaultBuildInfo(),
+				Factories:      factories,
+				ConfigProvider: cfgProvider,
+				LoggingOptions: []zap.Option{zap.Hooks(func(entry zapcore.Entry) error {
+					select {
+					case logs <- entry.Message:
+					default:
+					}
+					return nil
+				})},
+				telemetry: newColTelemetry(featuregate.NewRegistry()),
+			})
+			require.NoError(t, err)
+
+			wg := startCollector(context.Background(), t, col)
+
+			assert.Eventually(t, func() bool {
+				return Running == col.GetState()
+			}, 2*time.Second, 200*time.Millisecond)
+
+			srv := col.service
+			zpages, _ := srv.host.GetExtension(zpagesID)
+			receiver := srv.host.GetReceivers()[config.TracesDataType][otlpID]
+			require.NoError(t, os.WriteFile(reloadFile, []byte(test.reloaded), 0600))
+			col.signalsChannel <- syscall.SIGHUP
+			waitForLog(t, logs, test.log)
+			assert.Equal(t, Running, col.GetState())
+
+			if test.restart {
+				assert.NotSame(t, srv, col.service)
+			} else {
+				// Only the pipeline of the receiver is restarted, the extensions keep running.
+				assert.Same(t, srv, col.service)
+				ext, _ := col.service.host.GetExtension(zpagesID)
+				assert.Same(t, zpages, ext)
+				assert.NotSame(t, receiver, col.service.host.GetReceivers()[config.TracesDataType][otlpID])
+			}
+			assert.Equal(t, test.endpoint, col.service.config.Receivers[otlpID].(*otlpreceiver.Config).GRPC.NetAddr.Endpoint)
+			conn, err := net.Dial("tcp", test.endpoint)
+			require.NoError(t, err)
+			assert.NoError(t, conn.Close())
+
+			col.Shutdown()
+			wg.Wait()
+			assert.Equal(t, Closed, col.GetState())
+		})
+	}
+}
+
+func waitForLog(t *testing.T, logs <-chan string, msg string) {
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case log := <-logs:
+			if log == msg {
+				return
+			}
+		case <-timeout:
+			require.Failf(t, "log not found", "expected log %q", msg)
+		}
+	}
+}
+
 type mockColTelemetry struct{}
 
 func (tel *mockColTelemetry) init(*Collector) error {
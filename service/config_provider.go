@@ -61,11 +61,23 @@ type ConfigProvider interface {
 	Shutdown(ctx context.Context) error
 }
 
+// configMapProvider is implemented by the ConfigProvider able to return the config.Map from which the config
+// returned by the last successful Get was unmarshalled. It allows the Collector to only restart the pipelines
+// affected by a change on reload, using config.Map.Diff, instead of restarting the whole service.
+type configMapProvider interface {
+	getMap() *config.Map
+}
+
 type configProvider struct {
 	mapResolver       *mapResolver
 	configUnmarshaler configunmarshaler.ConfigUnmarshaler
+
+	// cfgMap is the config.Map of the config returned by the last successful Get.
+	cfgMap *config.Map
 }
 
+var _ configMapProvider = (*configProvider)(nil)
+
 // ConfigProviderSettings are the settings to configure the behavior of the ConfigProvider.
 type ConfigProviderSettings struct {
 	// Locations from where the config.Map is retrieved, and merged in the given order.
@@ -131,9 +143,14 @@ func (cm *configProvider) Get(ctx context.Context, factories component.Factories
 		return nil, fmt.Errorf("invalid configuration: %w", err)
 	}
 
+	cm.cfgMap = retMap
 	return cfg, nil
 }
 
+func (cm *configProvider) getMap() *config.Map {
+	return cm.cfgMap
+}
+
 func (cm *configProvider) Watch() <-chan error {
 	return cm.mapResolver.Watch()
 }
@@ -45,7 +45,9 @@ func flags() *flag.FlagSet {
 	flagSet := new(flag.FlagSet)
 
 	flagSet.Var(configFlag, "config", "Locations to the config file(s), note that only a"+
-		" single location can be set per flag entry e.g. `-config=file:/path/to/first --config=file:path/to/second`.")
+		" single location can be set per flag entry e.g. `-config=file:/path/to/first --config=file:path/to/second`."+
+		" The \"file\", \"env\" and \"yaml\" schemes are supported, e.g. `--config=env:OTEL_CONFIG`,"+
+		" configurations are merged in the given order.")
 
 	flagSet.Var(setFlag, "set",
 		"Set arbitrary component config property. The component has to be defined in the config file and the flag"+
@@ -15,10 +15,17 @@
 package service // import "go.opentelemetry.io/collector/service"
 
 import (
+	"context"
+	"fmt"
+	"sync"
+
 	"go.opentelemetry.io/contrib/zpages"
+	"go.uber.org/atomic"
 
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenterror"
 	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/internal/configunmarshaler"
 	"go.opentelemetry.io/collector/service/internal/builder"
 	"go.opentelemetry.io/collector/service/internal/extensions"
 )
@@ -34,13 +41,135 @@ type serviceHost struct {
 	builtReceivers  builder.Receivers
 	builtPipelines  builder.BuiltPipelines
 	builtExtensions extensions.Extensions
+
+	fatalErrorMu       sync.Mutex
+	fatalError         error
+	droppedFatalErrors atomic.Int64
+
+	fatalErrorSubscribersMu     sync.Mutex
+	fatalErrorSubscribers       []chan error
+	fatalErrorSubscribersClosed bool
+
+	statusMu sync.RWMutex
+	statuses map[config.ComponentID]component.StatusEvent
+
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	ready chan struct{}
+}
+
+// Context returns a context that is cancelled when the service begins shutdown, before any
+// component is shut down. Long-running goroutines started by the components can select on it
+// to observe the service shutdown.
+func (host *serviceHost) Context() context.Context {
+	return host.ctx
+}
+
+// Ready returns a channel that is closed once all the extensions, exporters, processors and receivers
+// are started and the extensions are notified that the pipelines are ready. It is never closed if
+// the service fails to start. Components can wait on it to run post-startup actions without polling.
+func (host *serviceHost) Ready() <-chan struct{} {
+	return host.ready
 }
 
 // ReportFatalError is used to report to the host that the receiver encountered
 // a fatal error (i.e.: an error that the instance can't recover from) after
 // its start function has already returned.
+//
+// Reporting never blocks: the first reported error is always recorded, and if a previous
+// error was not yet consumed from the asyncErrorChannel the error is dropped and counted.
 func (host *serviceHost) ReportFatalError(err error) {
-	host.asyncErrorChannel <- err
+	host.fatalErrorMu.Lock()
+	if host.fatalError == nil {
+		host.fatalError = err
+	}
+	host.fatalErrorMu.Unlock()
+
+	select {
+	case host.asyncErrorChannel <- err:
+	default:
+		host.droppedFatalErrors.Inc()
+	}
+
+	host.fatalErrorSubscribersMu.Lock()
+	defer host.fatalErrorSubscribersMu.Unlock()
+	for _, sub := range host.fatalErrorSubscribers {
+		select {
+		case sub <- err:
+		default:
+		}
+	}
+}
+
+// fatalErrorSubscriberBufferSize is the number of fatal errors buffered for every subscriber.
+const fatalErrorSubscriberBufferSize = 8
+
+// SubscribeFatalErrors returns a channel receiving a copy of every error reported via ReportFatalError
+// after the call, without taking them from the service, which still shuts down on the first one.
+// Reporting never blocks: errors are dropped for a subscriber that doesn't keep up with them.
+// The channel is closed once the service is shut down.
+func (host *serviceHost) SubscribeFatalErrors() <-chan error {
+	host.fatalErrorSubscribersMu.Lock()
+	defer host.fatalErrorSubscribersMu.Unlock()
+	sub := make(chan error, fatalErrorSubscriberBufferSize)
+	if host.fatalErrorSubscribersClosed {
+		close(sub)
+		return sub
+	}
+	host.fatalErrorSubscribers = append(host.fatalErrorSubscribers, sub)
+	return sub
+}
+
+// closeFatalErrorSubscribers closes the channels returned by SubscribeFatalErrors, errors reported
+// afterwards are no longer sent to the subscribers.
+func (host *serviceHost) closeFatalErrorSubscribers() {
+	host.fatalErrorSubscribersMu.Lock()
+	defer host.fatalErrorSubscribersMu.Unlock()
+	for _, sub := range host.fatalErrorSubscribers {
+		close(sub)
+	}
+	host.fatalErrorSubscribers = nil
+	host.fatalErrorSubscribersClosed = true
+}
+
+// firstFatalError returns the first error reported via ReportFatalError, if any.
+func (host *serviceHost) firstFatalError() error {
+	host.fatalErrorMu.Lock()
+	defer host.fatalErrorMu.Unlock()
+	return host.fatalError
+}
+
+// ReportComponentStatus is used by components to report their status transitions to the host,
+// the last reported status of every component is kept. Errors reported via ReportFatalError
+// are considered a component.StatusPermanentError when aggregating the statuses.
+func (host *serviceHost) ReportComponentStatus(id config.ComponentID, status component.Status, err error) {
+	host.statusMu.Lock()
+	defer host.statusMu.Unlock()
+	if host.statuses == nil {
+		host.statuses = make(map[config.ComponentID]component.StatusEvent)
+	}
+	host.statuses[id] = component.StatusEvent{Status: status, Err: err}
+}
+
+// GetComponentStatuses returns the last status reported by every component.
+func (host *serviceHost) GetComponentStatuses() map[config.ComponentID]component.StatusEvent {
+	host.statusMu.RLock()
+	defer host.statusMu.RUnlock()
+	statuses := make(map[config.ComponentID]component.StatusEvent, len(host.statuses))
+	for id, ev := range host.statuses {
+		statuses[id] = ev
+	}
+	return statuses
+}
+
+// GetAggregateStatus returns the most severe status reported by any component,
+// see component.AggregateStatus.
+func (host *serviceHost) GetAggregateStatus() component.Status {
+	if host.firstFatalError() != nil {
+		return component.StatusPermanentError
+	}
+	return component.AggregateStatus(host.GetComponentStatuses())
 }
 
 func (host *serviceHost) GetFactory(kind component.Kind, componentType config.Type) component.Factory {
@@ -57,10 +186,106 @@ func (host *serviceHost) GetFactory(kind component.Kind, componentType config.Ty
 	return nil
 }
 
+// GetFactoryE is like GetFactory, but returns an error wrapping componenterror.ErrUnknownKind if the kind
+// is unknown, or componenterror.ErrFactoryNotFound if no factory is registered for the component type.
+func (host *serviceHost) GetFactoryE(kind component.Kind, componentType config.Type) (component.Factory, error) {
+	if factory := host.GetFactory(kind, componentType); factory != nil {
+		return factory, nil
+	}
+	switch kind {
+	case component.KindReceiver, component.KindProcessor, component.KindExporter, component.KindExtension:
+		return nil, fmt.Errorf("%w for component type %q", componenterror.ErrFactoryNotFound, componentType)
+	}
+	return nil, fmt.Errorf("%w %d", componenterror.ErrUnknownKind, kind)
+}
+
 func (host *serviceHost) GetExtensions() map[config.ComponentID]component.Extension {
 	return host.builtExtensions.ToMap()
 }
 
+// GetExtension returns the extension with the given id, and false if no such extension is configured.
+func (host *serviceHost) GetExtension(id config.ComponentID) (component.Extension, bool) {
+	return host.builtExtensions.Get(id)
+}
+
 func (host *serviceHost) GetExporters() map[config.DataType]map[config.ComponentID]component.Exporter {
 	return host.builtExporters.ToMapByDataType()
 }
+
+// GetReceivers returns the receivers grouped by the data types of the pipelines they are attached to.
+// The map contains an entry, never nil, for every config.DataType.
+func (host *serviceHost) GetReceivers() map[config.DataType]map[config.ComponentID]component.Receiver {
+	return host.builtReceivers.ToMapByDataType()
+}
+
+// GetExportersForDataType returns the exporters for the given config.DataType,
+// an empty map is returned if there are no exporters for that data type.
+func (host *serviceHost) GetExportersForDataType(dataType config.DataType) map[config.ComponentID]component.Exporter {
+	return host.builtExporters.ToMapForDataType(dataType)
+}
+
+// GetPipelines returns the receivers, the ordered processors and the exporters of every pipeline, by pipeline ID.
+func (host *serviceHost) GetPipelines() map[config.ComponentID]component.PipelineInfo {
+	pipelines := make(map[config.ComponentID]component.PipelineInfo, len(host.builtPipelines))
+	for id, bp := range host.builtPipelines {
+		pipelines[id] = component.PipelineInfo{
+			Receivers:  append([]config.ComponentID(nil), bp.Config.Receivers...),
+			Processors: append([]config.ComponentID(nil), bp.Config.Processors...),
+			Exporters:  append([]config.ComponentID(nil), bp.Config.Exporters...),
+		}
+	}
+	return pipelines
+}
+
+// ServiceHost is a component.Host built by NewServiceHost, which can start and shut down its components.
+type ServiceHost interface {
+	component.Host
+
+	// Start starts the extensions, then the pipelines, from the exporters to the receivers.
+	Start(ctx context.Context) error
+
+	// Shutdown shuts down the components in the reverse order they are started.
+	Shutdown(ctx context.Context) error
+}
+
+type builtServiceHost struct {
+	*serviceHost
+	srv *service
+}
+
+func (h *builtServiceHost) Start(ctx context.Context) error {
+	return h.srv.Start(ctx)
+}
+
+func (h *builtServiceHost) Shutdown(ctx context.Context) error {
+	return h.srv.Shutdown(ctx)
+}
+
+// NewServiceHost unmarshals and validates the collector configuration in cfgMap, builds all its components
+// using the factories and the telemetry settings, and returns their host, without starting them.
+//
+// It allows to test components against the host of a real service, e.g. a processor in a pipeline
+// with other components. Fatal errors reported by the components can be observed with the
+// SubscribeFatalErrors method of the host. Unlike a running collector, nothing consumes the fatal
+// errors otherwise: only the first one is buffered, the following ones are dropped unless subscribed.
+func NewServiceHost(factories component.Factories, cfgMap *config.Map, telemetry component.TelemetrySettings) (ServiceHost, error) {
+	cfg, err := configunmarshaler.NewDefault().Unmarshal(cfgMap, factories)
+	if err != nil {
+		return nil, fmt.Errorf("cannot unmarshal the configuration: %w", err)
+	}
+	if err = cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid configuration: %w", err)
+	}
+
+	srv, err := newService(&svcSettings{
+		BuildInfo:         component.NewDefaultBuildInfo(),
+		Factories:         factories,
+		Config:            cfg,
+		Telemetry:         telemetry,
+		AsyncErrorChannel: make(chan error, 1),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &builtServiceHost{serviceHost: srv.host, srv: srv}, nil
+}
@@ -30,19 +30,28 @@ import (
 // builtExporter is an exporter that is built based on a config. It can have
 // a trace and/or a metrics consumer and have a shutdown function.
 type builtExporter struct {
+	id            config.ComponentID
 	logger        *zap.Logger
 	expByDataType map[config.DataType]component.Exporter
 }
 
-// Start the exporter.
+// Start the exporter. If any of its components fails to start, the ones already started are shutdown.
 func (bexp *builtExporter) Start(ctx context.Context, host component.Host) error {
 	var errs error
+	var started []component.Exporter
 	bexp.logger.Info("Exporter is starting...")
 	for _, exporter := range bexp.expByDataType {
-		errs = multierr.Append(errs, exporter.Start(ctx, components.NewHostWrapper(host, bexp.logger)))
+		if err := exporter.Start(ctx, components.NewHostWrapper(host, bexp.id, bexp.logger)); err != nil {
+			errs = multierr.Append(errs, err)
+			continue
+		}
+		started = append(started, exporter)
 	}
 
 	if errs != nil {
+		for _, exporter := range started {
+			errs = multierr.Append(errs, exporter.Shutdown(ctx))
+		}
 		return errs
 	}
 	bexp.logger.Info("Exporter started.")
@@ -86,12 +95,14 @@ func (bexp *builtExporter) getLogsExporter() component.LogsExporter {
 // Exporters is a map of exporters created from exporter configs.
 type Exporters map[config.ComponentID]*builtExporter
 
-// StartAll starts all exporters.
+// StartAll starts all exporters. If an exporter fails to start, the exporters already started are shutdown.
 func (exps Exporters) StartAll(ctx context.Context, host component.Host) error {
-	for _, exp := range exps {
+	started := make(Exporters, len(exps))
+	for id, exp := range exps {
 		if err := exp.Start(ctx, host); err != nil {
-			return err
+			return multierr.Append(err, started.ShutdownAll(ctx))
 		}
+		started[id] = exp
 	}
 	return nil
 }
@@ -123,6 +134,17 @@ func (exps Exporters) ToMapByDataType() map[config.DataType]map[config.Component
 	return exportersMap
 }
 
+// ToMapForDataType returns the exporters for the given config.DataType, never nil.
+func (exps Exporters) ToMapForDataType(dataType config.DataType) map[config.ComponentID]component.Exporter {
+	exportersMap := make(map[config.ComponentID]component.Exporter, len(exps))
+	for expID, bexp := range exps {
+		if exp, ok := bexp.expByDataType[dataType]; ok {
+			exportersMap[expID] = exp
+		}
+	}
+	return exportersMap
+}
+
 // Map of config.DataType to the id of the Pipeline that requires the data type.
 type dataTypeRequirements map[config.DataType]config.ComponentID
 
@@ -209,6 +231,7 @@ func buildExporter(
 	inputDataTypes dataTypeRequirements,
 ) (*builtExporter, error) {
 	exporter := &builtExporter{
+		id:            cfg.ID(),
 		logger:        set.Logger,
 		expByDataType: make(map[config.DataType]component.Exporter, 3),
 	}
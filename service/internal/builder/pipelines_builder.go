@@ -49,18 +49,25 @@ type builtPipeline struct {
 // BuiltPipelines is a map of build pipelines created from pipeline configs.
 type BuiltPipelines map[config.ComponentID]*builtPipeline
 
+// StartProcessors starts the processors of all pipelines. If a processor fails to start, the processors
+// already started are shutdown.
 func (bps BuiltPipelines) StartProcessors(ctx context.Context, host component.Host) error {
+	var started []component.Processor
 	for _, bp := range bps {
 		bp.logger.Info("Pipeline is starting...")
-		hostWrapper := components.NewHostWrapper(host, bp.logger)
 		// Start in reverse order, starting from the back of processors pipeline.
 		// This is important so that processors that are earlier in the pipeline and
 		// reference processors that are later in the pipeline do not start sending
 		// data to later pipelines which are not yet started.
 		for i := len(bp.processors) - 1; i >= 0; i-- {
-			if err := bp.processors[i].Start(ctx, hostWrapper); err != nil {
+			if err := bp.processors[i].Start(ctx, components.NewHostWrapper(host, bp.Config.Processors[i], bp.logger)); err != nil {
+				// Shutdown in the reverse order of the start.
+				for j := len(started) - 1; j >= 0; j-- {
+					err = multierr.Append(err, started[j].Shutdown(ctx))
+				}
 				return err
 			}
+			started = append(started, bp.processors[i])
 		}
 		bp.logger.Info("Pipeline is started.")
 	}
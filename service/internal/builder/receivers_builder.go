@@ -35,13 +35,16 @@ var errUnusedReceiver = errors.New("receiver defined but not used by any pipelin
 // builtReceiver is a receiver that is built based on a config. It can have
 // a trace and/or a metrics component.
 type builtReceiver struct {
+	id       config.ComponentID
 	logger   *zap.Logger
 	receiver component.Receiver
+	// dataTypes are the data types of the pipelines the receiver is attached to.
+	dataTypes []config.DataType
 }
 
 // Start starts the receiver.
 func (rcv *builtReceiver) Start(ctx context.Context, host component.Host) error {
-	return rcv.receiver.Start(ctx, components.NewHostWrapper(host, rcv.logger))
+	return rcv.receiver.Start(ctx, components.NewHostWrapper(host, rcv.id, rcv.logger))
 }
 
 // Shutdown stops the receiver.
@@ -62,19 +65,39 @@ func (rcvs Receivers) ShutdownAll(ctx context.Context) error {
 	return err
 }
 
-// StartAll starts all receivers.
+// StartAll starts all receivers. If a receiver fails to start, the receivers already started are shutdown.
 func (rcvs Receivers) StartAll(ctx context.Context, host component.Host) error {
-	for _, rcv := range rcvs {
+	started := make(Receivers, len(rcvs))
+	for id, rcv := range rcvs {
 		rcv.logger.Info("Receiver is starting...")
 
 		if err := rcv.Start(ctx, host); err != nil {
-			return err
+			return multierr.Append(err, started.ShutdownAll(ctx))
 		}
+		started[id] = rcv
 		rcv.logger.Info("Receiver started.")
 	}
 	return nil
 }
 
+// ToMapByDataType returns the receivers grouped by the data types of the pipelines they are attached to.
+// The map contains an entry, never nil, for every config.DataType.
+func (rcvs Receivers) ToMapByDataType() map[config.DataType]map[config.ComponentID]component.Receiver {
+	receiversMap := make(map[config.DataType]map[config.ComponentID]component.Receiver)
+
+	receiversMap[config.TracesDataType] = make(map[config.ComponentID]component.Receiver, len(rcvs))
+	receiversMap[config.MetricsDataType] = make(map[config.ComponentID]component.Receiver, len(rcvs))
+	receiversMap[config.LogsDataType] = make(map[config.ComponentID]component.Receiver, len(rcvs))
+
+	for rcvID, rcv := range rcvs {
+		for _, dt := range rcv.dataTypes {
+			receiversMap[dt][rcvID] = rcv.receiver
+		}
+	}
+
+	return receiversMap
+}
+
 // receiversBuilder builds receivers from config.
 type receiversBuilder struct {
 	config         *config.Config
@@ -222,6 +245,7 @@ func attachReceiverToPipelines(
 		}
 	}
 	rcv.receiver = createdReceiver
+	rcv.dataTypes = append(rcv.dataTypes, dataType)
 
 	set.Logger.Info("Receiver was built.", zap.String("datatype", string(dataType)))
 
@@ -242,6 +266,7 @@ func (rb *receiversBuilder) buildReceiver(ctx context.Context, set component.Rec
 		return nil, fmt.Errorf("receiver factory not found for: %v", cfg.ID())
 	}
 	rcv := &builtReceiver{
+		id:     id,
 		logger: set.Logger,
 	}
 
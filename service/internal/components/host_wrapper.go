@@ -15,30 +15,173 @@
 package components // import "go.opentelemetry.io/collector/service/internal/components"
 
 import (
+	"context"
+	"fmt"
 	"net/http"
 
 	"go.uber.org/zap"
 
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenterror"
+	"go.opentelemetry.io/collector/config"
+)
+
+// The capabilities of the service host beyond component.Host, which the hostWrapper forwards when the wrapped
+// host supports them, since components receive the wrapper and could not cast the host to access them.
+type (
+	extensionGetter interface {
+		GetExtension(id config.ComponentID) (component.Extension, bool)
+	}
+	receiversGetter interface {
+		GetReceivers() map[config.DataType]map[config.ComponentID]component.Receiver
+	}
+	pipelinesGetter interface {
+		GetPipelines() map[config.ComponentID]component.PipelineInfo
+	}
+	contextProvider interface {
+		Context() context.Context
+	}
+	readyNotifier interface {
+		Ready() <-chan struct{}
+	}
+	factoryGetter interface {
+		GetFactoryE(kind component.Kind, componentType config.Type) (component.Factory, error)
+	}
+	fatalErrorsSubscriber interface {
+		SubscribeFatalErrors() <-chan error
+	}
+	statusReporter interface {
+		ReportComponentStatus(id config.ComponentID, status component.Status, err error)
+		GetComponentStatuses() map[config.ComponentID]component.StatusEvent
+		GetAggregateStatus() component.Status
+	}
+	zPagesRegisterer interface {
+		RegisterZPages(mux *http.ServeMux, pathPrefix string)
+	}
+)
+
+var (
+	_ extensionGetter       = (*hostWrapper)(nil)
+	_ receiversGetter       = (*hostWrapper)(nil)
+	_ pipelinesGetter       = (*hostWrapper)(nil)
+	_ contextProvider       = (*hostWrapper)(nil)
+	_ readyNotifier         = (*hostWrapper)(nil)
+	_ factoryGetter         = (*hostWrapper)(nil)
+	_ fatalErrorsSubscriber = (*hostWrapper)(nil)
+	_ statusReporter        = (*hostWrapper)(nil)
+	_ zPagesRegisterer      = (*hostWrapper)(nil)
 )
 
 // hostWrapper adds behavior on top of the component.Host being passed when starting the built components.
 type hostWrapper struct {
 	component.Host
 	*zap.Logger
+	id config.ComponentID
 }
 
-func NewHostWrapper(host component.Host, logger *zap.Logger) component.Host {
+// NewHostWrapper returns a component.Host wrapping host for the component with the given id,
+// the id is used to identify the component in the reported fatal errors.
+func NewHostWrapper(host component.Host, id config.ComponentID, logger *zap.Logger) component.Host {
 	return &hostWrapper{
 		host,
 		logger,
+		id,
 	}
 }
 
+// ReportFatalError reports the error to the wrapped host, wrapped with the ID of the component,
+// so that the logs of the service name the failed component.
 func (hw *hostWrapper) ReportFatalError(err error) {
 	// The logger from the built component already identifies the component.
 	hw.Logger.Error("Component fatal error", zap.Error(err))
-	hw.Host.ReportFatalError(err)
+	hw.Host.ReportFatalError(fmt.Errorf("component %q reported a fatal error: %w", hw.id, err))
+}
+
+// GetExtension forwards the lookup of a single extension to the wrapped host, if supported.
+// Components receive the wrapper, so without this they could not cast the host to access it.
+func (hw *hostWrapper) GetExtension(id config.ComponentID) (component.Extension, bool) {
+	if extHost, ok := hw.Host.(extensionGetter); ok {
+		return extHost.GetExtension(id)
+	}
+	return nil, false
+}
+
+// GetReceivers forwards the lookup of the receivers to the wrapped host, if supported.
+func (hw *hostWrapper) GetReceivers() map[config.DataType]map[config.ComponentID]component.Receiver {
+	if rcvHost, ok := hw.Host.(receiversGetter); ok {
+		return rcvHost.GetReceivers()
+	}
+	return nil
+}
+
+// GetPipelines forwards the lookup of the pipelines to the wrapped host, if supported.
+func (hw *hostWrapper) GetPipelines() map[config.ComponentID]component.PipelineInfo {
+	if pipelinesHost, ok := hw.Host.(pipelinesGetter); ok {
+		return pipelinesHost.GetPipelines()
+	}
+	return nil
+}
+
+// Context forwards the lookup of the service context to the wrapped host, if supported,
+// otherwise a context that is never cancelled is returned.
+func (hw *hostWrapper) Context() context.Context {
+	if ctxHost, ok := hw.Host.(contextProvider); ok {
+		return ctxHost.Context()
+	}
+	return context.Background()
+}
+
+// Ready forwards the lookup of the service readiness channel to the wrapped host, if supported,
+// otherwise a nil channel, which is never ready, is returned.
+func (hw *hostWrapper) Ready() <-chan struct{} {
+	if readyHost, ok := hw.Host.(readyNotifier); ok {
+		return readyHost.Ready()
+	}
+	return nil
+}
+
+// GetFactoryE forwards the request to the wrapped host, if supported, otherwise the factory is retrieved with
+// GetFactory, and an error wrapping componenterror.ErrFactoryNotFound is returned if there is none.
+func (hw *hostWrapper) GetFactoryE(kind component.Kind, componentType config.Type) (component.Factory, error) {
+	if factoryHost, ok := hw.Host.(factoryGetter); ok {
+		return factoryHost.GetFactoryE(kind, componentType)
+	}
+	if factory := hw.Host.GetFactory(kind, componentType); factory != nil {
+		return factory, nil
+	}
+	return nil, fmt.Errorf("%w for component type %q", componenterror.ErrFactoryNotFound, componentType)
+}
+
+// SubscribeFatalErrors forwards the subscription to the fatal errors to the wrapped host, if supported,
+// otherwise a nil channel, which never receives any error, is returned.
+func (hw *hostWrapper) SubscribeFatalErrors() <-chan error {
+	if errorsHost, ok := hw.Host.(fatalErrorsSubscriber); ok {
+		return errorsHost.SubscribeFatalErrors()
+	}
+	return nil
+}
+
+// ReportComponentStatus forwards the status reported by a component to the wrapped host, if supported.
+func (hw *hostWrapper) ReportComponentStatus(id config.ComponentID, status component.Status, err error) {
+	if statusHost, ok := hw.Host.(statusReporter); ok {
+		statusHost.ReportComponentStatus(id, status, err)
+	}
+}
+
+// GetComponentStatuses forwards the lookup of the component statuses to the wrapped host, if supported.
+func (hw *hostWrapper) GetComponentStatuses() map[config.ComponentID]component.StatusEvent {
+	if statusHost, ok := hw.Host.(statusReporter); ok {
+		return statusHost.GetComponentStatuses()
+	}
+	return nil
+}
+
+// GetAggregateStatus forwards the lookup of the aggregate status to the wrapped host, if supported.
+func (hw *hostWrapper) GetAggregateStatus() component.Status {
+	if statusHost, ok := hw.Host.(statusReporter); ok {
+		return statusHost.GetAggregateStatus()
+	}
+	return component.StatusHealthy
 }
 
 // RegisterZPages is used by zpages extension to register handles from service.
@@ -46,9 +189,7 @@ func (hw *hostWrapper) ReportFatalError(err error) {
 // the interface, for the time being expose the interface here.
 // TODO: Find a better way to add the service zpages to the extension. This a temporary fix.
 func (hw *hostWrapper) RegisterZPages(mux *http.ServeMux, pathPrefix string) {
-	if zpagesHost, ok := hw.Host.(interface {
-		RegisterZPages(mux *http.ServeMux, pathPrefix string)
-	}); ok {
+	if zpagesHost, ok := hw.Host.(zPagesRegisterer); ok {
 		zpagesHost.RegisterZPages(mux, pathPrefix)
 	}
 }
@@ -15,15 +15,233 @@
 package components
 
 import (
+	"context"
 	"errors"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"go.uber.org/zap"
 
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenterror"
 	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config"
 )
 
 func Test_newHostWrapper(t *testing.T) {
-	hw := NewHostWrapper(componenttest.NewNopHost(), zap.NewNop())
+	hw := NewHostWrapper(componenttest.NewNopHost(), config.NewComponentID("nop"), zap.NewNop())
 	hw.ReportFatalError(errors.New("test error"))
 }
+
+type fatalErrorHost struct {
+	component.Host
+	err error
+}
+
+func (fh *fatalErrorHost) ReportFatalError(err error) {
+	fh.err = err
+}
+
+func TestHostWrapperReportFatalError(t *testing.T) {
+	host := &fatalErrorHost{Host: componenttest.NewNopHost()}
+	hw := NewHostWrapper(host, config.NewComponentIDWithName("otlp", "2"), zap.NewNop())
+	errTest := errors.New("test error")
+	hw.ReportFatalError(errTest)
+	assert.EqualError(t, host.err, `component "otlp/2" reported a fatal error: test error`)
+	assert.ErrorIs(t, host.err, errTest)
+}
+
+type extensionHost struct {
+	component.Host
+	ext component.Extension
+}
+
+func (eh *extensionHost) GetExtension(id config.ComponentID) (component.Extension, bool) {
+	if id != config.NewComponentID("nop") {
+		return nil, false
+	}
+	return eh.ext, true
+}
+
+func TestHostWrapperGetExtension(t *testing.T) {
+	host := &extensionHost{Host: componenttest.NewNopHost(), ext: struct{ component.Extension }{}}
+	hw := NewHostWrapper(host, config.NewComponentID("nop"), zap.NewNop()).(extensionGetter)
+
+	got, ok := hw.GetExtension(config.NewComponentID("nop"))
+	assert.True(t, ok)
+	assert.Equal(t, host.ext, got)
+
+	_, ok = hw.GetExtension(config.NewComponentID("other"))
+	assert.False(t, ok)
+
+	// The wrapped host does not support GetExtension.
+	hw = NewHostWrapper(componenttest.NewNopHost(), config.NewComponentID("nop"), zap.NewNop()).(extensionGetter)
+	_, ok = hw.GetExtension(config.NewComponentID("nop"))
+	assert.False(t, ok)
+}
+
+type receiversHost struct {
+	component.Host
+	receivers map[config.DataType]map[config.ComponentID]component.Receiver
+}
+
+func (rh *receiversHost) GetReceivers() map[config.DataType]map[config.ComponentID]component.Receiver {
+	return rh.receivers
+}
+
+func TestHostWrapperGetReceivers(t *testing.T) {
+	host := &receiversHost{
+		Host: componenttest.NewNopHost(),
+		receivers: map[config.DataType]map[config.ComponentID]component.Receiver{
+			config.TracesDataType: {config.NewComponentID("nop"): struct{ component.Receiver }{}},
+		},
+	}
+	hw := NewHostWrapper(host, config.NewComponentID("nop"), zap.NewNop()).(receiversGetter)
+	assert.Equal(t, host.receivers, hw.GetReceivers())
+
+	// The wrapped host does not support GetReceivers.
+	hw = NewHostWrapper(componenttest.NewNopHost(), config.NewComponentID("nop"), zap.NewNop()).(receiversGetter)
+	assert.Nil(t, hw.GetReceivers())
+}
+
+type pipelinesHost struct {
+	component.Host
+	pipelines map[config.ComponentID]component.PipelineInfo
+}
+
+func (ph *pipelinesHost) GetPipelines() map[config.ComponentID]component.PipelineInfo {
+	return ph.pipelines
+}
+
+func TestHostWrapperGetPipelines(t *testing.T) {
+	host := &pipelinesHost{
+		Host: componenttest.NewNopHost(),
+		pipelines: map[config.ComponentID]component.PipelineInfo{
+			config.NewComponentID("traces"): {
+				Receivers:  []config.ComponentID{config.NewComponentID("nop")},
+				Processors: []config.ComponentID{config.NewComponentID("batch"), config.NewComponentID("nop")},
+				Exporters:  []config.ComponentID{config.NewComponentID("nop")},
+			},
+		},
+	}
+	hw := NewHostWrapper(host, config.NewComponentID("nop"), zap.NewNop()).(pipelinesGetter)
+	assert.Equal(t, host.pipelines, hw.GetPipelines())
+
+	// The wrapped host does not support GetPipelines.
+	hw = NewHostWrapper(componenttest.NewNopHost(), config.NewComponentID("nop"), zap.NewNop()).(pipelinesGetter)
+	assert.Nil(t, hw.GetPipelines())
+}
+
+type contextHost struct {
+	component.Host
+	ctx context.Context
+}
+
+func (ch *contextHost) Context() context.Context {
+	return ch.ctx
+}
+
+func TestHostWrapperContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	host := &contextHost{Host: componenttest.NewNopHost(), ctx: ctx}
+	hw := NewHostWrapper(host, config.NewComponentID("nop"), zap.NewNop()).(contextProvider)
+	assert.NoError(t, hw.Context().Err())
+	cancel()
+	assert.ErrorIs(t, hw.Context().Err(), context.Canceled)
+
+	// The wrapped host does not support Context.
+	hw = NewHostWrapper(componenttest.NewNopHost(), config.NewComponentID("nop"), zap.NewNop()).(contextProvider)
+	assert.Equal(t, context.Background(), hw.Context())
+}
+
+type statusHost struct {
+	component.Host
+	statuses map[config.ComponentID]component.StatusEvent
+}
+
+func (sh *statusHost) ReportComponentStatus(id config.ComponentID, status component.Status, err error) {
+	sh.statuses[id] = component.StatusEvent{Status: status, Err: err}
+}
+
+func (sh *statusHost) GetComponentStatuses() map[config.ComponentID]component.StatusEvent {
+	return sh.statuses
+}
+
+func (sh *statusHost) GetAggregateStatus() component.Status {
+	return component.AggregateStatus(sh.statuses)
+}
+
+func TestHostWrapperComponentStatus(t *testing.T) {
+	host := &statusHost{Host: componenttest.NewNopHost(), statuses: map[config.ComponentID]component.StatusEvent{}}
+	hw := NewHostWrapper(host, config.NewComponentID("nop"), zap.NewNop()).(statusReporter)
+
+	hw.ReportComponentStatus(config.NewComponentID("nop"), component.StatusRecoverableError, errors.New("retry"))
+	assert.Equal(t, host.statuses, hw.GetComponentStatuses())
+	assert.Equal(t, component.StatusRecoverableError, hw.GetAggregateStatus())
+
+	// The wrapped host does not support status reporting.
+	hw = NewHostWrapper(componenttest.NewNopHost(), config.NewComponentID("nop"), zap.NewNop()).(statusReporter)
+	hw.ReportComponentStatus(config.NewComponentID("nop"), component.StatusRecoverableError, errors.New("retry"))
+	assert.Nil(t, hw.GetComponentStatuses())
+	assert.Equal(t, component.StatusHealthy, hw.GetAggregateStatus())
+}
+
+type readyHost struct {
+	component.Host
+	ready chan struct{}
+}
+
+func (rh *readyHost) Ready() <-chan struct{} {
+	return rh.ready
+}
+
+func TestHostWrapperReady(t *testing.T) {
+	host := &readyHost{Host: componenttest.NewNopHost(), ready: make(chan struct{})}
+	hw := NewHostWrapper(host, config.NewComponentID("nop"), zap.NewNop()).(readyNotifier)
+	assert.Equal(t, (<-chan struct{})(host.ready), hw.Ready())
+
+	// The wrapped host does not support Ready.
+	hw = NewHostWrapper(componenttest.NewNopHost(), config.NewComponentID("nop"), zap.NewNop()).(readyNotifier)
+	assert.Nil(t, hw.Ready())
+}
+
+type fatalErrorsHost struct {
+	component.Host
+	errs chan error
+}
+
+func (feh *fatalErrorsHost) SubscribeFatalErrors() <-chan error {
+	return feh.errs
+}
+
+func TestHostWrapperSubscribeFatalErrors(t *testing.T) {
+	host := &fatalErrorsHost{Host: componenttest.NewNopHost(), errs: make(chan error)}
+	hw := NewHostWrapper(host, config.NewComponentID("nop"), zap.NewNop()).(fatalErrorsSubscriber)
+	assert.Equal(t, (<-chan error)(host.errs), hw.SubscribeFatalErrors())
+
+	// The wrapped host does not support SubscribeFatalErrors.
+	hw = NewHostWrapper(componenttest.NewNopHost(), config.NewComponentID("nop"), zap.NewNop()).(fatalErrorsSubscriber)
+	assert.Nil(t, hw.SubscribeFatalErrors())
+}
+
+type factoryHost struct {
+	component.Host
+	err error
+}
+
+func (fh *factoryHost) GetFactoryE(component.Kind, config.Type) (component.Factory, error) {
+	return nil, fh.err
+}
+
+func TestHostWrapperGetFactoryE(t *testing.T) {
+	errTest := errors.New("test error")
+	hw := NewHostWrapper(&factoryHost{Host: componenttest.NewNopHost(), err: errTest}, config.NewComponentID("nop"), zap.NewNop()).(factoryGetter)
+	_, err := hw.GetFactoryE(component.KindReceiver, "nop")
+	assert.Equal(t, errTest, err)
+
+	// The wrapped host does not support GetFactoryE.
+	hw = NewHostWrapper(componenttest.NewNopHost(), config.NewComponentID("nop"), zap.NewNop()).(factoryGetter)
+	factory, err := hw.GetFactoryE(component.KindReceiver, "nop")
+	assert.Nil(t, factory)
+	assert.ErrorIs(t, err, componenterror.ErrFactoryNotFound)
+}
@@ -17,6 +17,7 @@ package extensions // import "go.opentelemetry.io/collector/service/internal/ext
 import (
 	"context"
 	"fmt"
+	"sort"
 
 	"go.uber.org/multierr"
 	"go.uber.org/zap"
@@ -29,14 +30,18 @@ import (
 // builtExtension is an extension that is built based on a config. It can have
 // a start function and have a shutdown function.
 type builtExtension struct {
+	id        config.ComponentID
 	logger    *zap.Logger
 	extension component.Extension
+	// order is the position of the extension in the service configuration,
+	// extensions are started in this order and shutdown in reverse order.
+	order int
 }
 
 // Start the extension.
 func (ext *builtExtension) Start(ctx context.Context, host component.Host) error {
 	ext.logger.Info("Extension is starting...")
-	if err := ext.extension.Start(ctx, components.NewHostWrapper(host, ext.logger)); err != nil {
+	if err := ext.extension.Start(ctx, components.NewHostWrapper(host, ext.id, ext.logger)); err != nil {
 		return err
 	}
 	ext.logger.Info("Extension started.")
@@ -53,28 +58,34 @@ var _ component.Extension = (*builtExtension)(nil)
 // Extensions is a map of extensions created from extension configs.
 type Extensions map[config.ComponentID]*builtExtension
 
-// StartAll starts all extensions.
+// StartAll starts all extensions, in the order they are configured in the service.
+// If an extension fails to start, the extensions already started are shutdown in reverse order.
 func (exts Extensions) StartAll(ctx context.Context, host component.Host) error {
-	for _, ext := range exts {
+	ordered := exts.ordered()
+	for i, ext := range ordered {
 		if err := ext.Start(ctx, host); err != nil {
+			for j := i - 1; j >= 0; j-- {
+				err = multierr.Append(err, ordered[j].Shutdown(ctx))
+			}
 			return err
 		}
 	}
 	return nil
 }
 
-// ShutdownAll stops all extensions.
+// ShutdownAll stops all extensions, in the reverse order they were started.
 func (exts Extensions) ShutdownAll(ctx context.Context) error {
 	var errs error
-	for _, ext := range exts {
-		errs = multierr.Append(errs, ext.Shutdown(ctx))
+	ordered := exts.ordered()
+	for i := len(ordered) - 1; i >= 0; i-- {
+		errs = multierr.Append(errs, ordered[i].Shutdown(ctx))
 	}
 
 	return errs
 }
 
 func (exts Extensions) NotifyPipelineReady() error {
-	for _, ext := range exts {
+	for _, ext := range exts.ordered() {
 		if pw, ok := ext.extension.(component.PipelineWatcher); ok {
 			if err := pw.Ready(); err != nil {
 				ext.logger.Error("Error notifying extension that the pipeline was started.")
@@ -89,7 +100,9 @@ func (exts Extensions) NotifyPipelineReady() error {
 func (exts Extensions) NotifyPipelineNotReady() error {
 	// Notify extensions in reverse order.
 	var errs error
-	for _, ext := range exts {
+	ordered := exts.ordered()
+	for i := len(ordered) - 1; i >= 0; i-- {
+		ext := ordered[i]
 		if pw, ok := ext.extension.(component.PipelineWatcher); ok {
 			if err := pw.NotReady(); err != nil {
 				ext.logger.Error("Error notifying extension that the pipeline was shutdown.")
@@ -101,6 +114,25 @@ func (exts Extensions) NotifyPipelineNotReady() error {
 	return errs
 }
 
+// ordered returns the extensions sorted in the order they are configured in the service.
+func (exts Extensions) ordered() []*builtExtension {
+	ordered := make([]*builtExtension, 0, len(exts))
+	for _, ext := range exts {
+		ordered = append(ordered, ext)
+	}
+	sort.Slice(ordered, func(i, j int) bool { return ordered[i].order < ordered[j].order })
+	return ordered
+}
+
+// Get returns the extension with the given id, and false if no such extension is built.
+func (exts Extensions) Get(id config.ComponentID) (component.Extension, bool) {
+	ext, ok := exts[id]
+	if !ok {
+		return nil, false
+	}
+	return ext.extension, true
+}
+
 func (exts Extensions) ToMap() map[config.ComponentID]component.Extension {
 	result := make(map[config.ComponentID]component.Extension, len(exts))
 	for extID, v := range exts {
@@ -117,7 +149,7 @@ func Build(
 	factories map[config.Type]component.ExtensionFactory,
 ) (Extensions, error) {
 	extensions := make(Extensions)
-	for _, extID := range config.Service.Extensions {
+	for i, extID := range config.Service.Extensions {
 		extCfg, existsCfg := config.Extensions[extID]
 		if !existsCfg {
 			return nil, fmt.Errorf("extension %q is not configured", extID)
@@ -144,6 +176,7 @@ func Build(
 			return nil, err
 		}
 
+		ext.order = i
 		extensions[extID] = ext
 	}
 
@@ -152,6 +185,7 @@ func Build(
 
 func buildExtension(ctx context.Context, factory component.ExtensionFactory, creationSet component.ExtensionCreateSettings, cfg config.Extension) (*builtExtension, error) {
 	ext := &builtExtension{
+		id:     cfg.ID(),
 		logger: creationSet.Logger,
 	}
 
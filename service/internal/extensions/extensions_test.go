@@ -16,10 +16,12 @@ package extensions
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/component/componenttest"
@@ -123,6 +125,95 @@ func TestService_setupExtensions(t *testing.T) {
 	}
 }
 
+type recordingExtension struct {
+	component.StartFunc
+	component.ShutdownFunc
+}
+
+func TestExtensionsStartShutdownOrder(t *testing.T) {
+	var calls []string
+	factory := component.NewExtensionFactory(
+		"rec",
+		func() config.Extension {
+			cfg := config.NewExtensionSettings(config.NewComponentID("rec"))
+			return &cfg
+		},
+		func(ctx context.Context, set component.ExtensionCreateSettings, extension config.Extension) (component.Extension, error) {
+			name := extension.ID().String()
+			return &recordingExtension{
+				StartFunc: func(context.Context, component.Host) error {
+					calls = append(calls, "start "+name)
+					return nil
+				},
+				ShutdownFunc: func(context.Context) error {
+					calls = append(calls, "shutdown "+name)
+					return nil
+				},
+			}, nil
+		},
+	)
+
+	cfg := &config.Config{Extensions: map[config.ComponentID]config.Extension{}}
+	for _, name := range []string{"c", "a", "d", "b"} {
+		extCfg := factory.CreateDefaultConfig()
+		extCfg.SetIDName(name)
+		cfg.Extensions[extCfg.ID()] = extCfg
+		cfg.Service.Extensions = append(cfg.Service.Extensions, extCfg.ID())
+	}
+
+	exts, err := Build(componenttest.NewNopTelemetrySettings(), component.NewDefaultBuildInfo(), cfg, map[config.Type]component.ExtensionFactory{factory.Type(): factory})
+	require.NoError(t, err)
+	require.NoError(t, exts.StartAll(context.Background(), componenttest.NewNopHost()))
+	require.NoError(t, exts.ShutdownAll(context.Background()))
+
+	assert.Equal(t, []string{
+		"start rec/c", "start rec/a", "start rec/d", "start rec/b",
+		"shutdown rec/b", "shutdown rec/d", "shutdown rec/a", "shutdown rec/c",
+	}, calls)
+}
+
+func TestExtensionsStartFailure(t *testing.T) {
+	var calls []string
+	factory := component.NewExtensionFactory(
+		"rec",
+		func() config.Extension {
+			cfg := config.NewExtensionSettings(config.NewComponentID("rec"))
+			return &cfg
+		},
+		func(ctx context.Context, set component.ExtensionCreateSettings, extension config.Extension) (component.Extension, error) {
+			name := extension.ID().String()
+			return &recordingExtension{
+				StartFunc: func(context.Context, component.Host) error {
+					if name == "rec/d" {
+						return errors.New("start failed")
+					}
+					calls = append(calls, "start "+name)
+					return nil
+				},
+				ShutdownFunc: func(context.Context) error {
+					calls = append(calls, "shutdown "+name)
+					return nil
+				},
+			}, nil
+		},
+	)
+
+	cfg := &config.Config{Extensions: map[config.ComponentID]config.Extension{}}
+	for _, name := range []string{"c", "a", "d", "b"} {
+		extCfg := factory.CreateDefaultConfig()
+		extCfg.SetIDName(name)
+		cfg.Extensions[extCfg.ID()] = extCfg
+		cfg.Service.Extensions = append(cfg.Service.Extensions, extCfg.ID())
+	}
+
+	exts, err := Build(componenttest.NewNopTelemetrySettings(), component.NewDefaultBuildInfo(), cfg, map[config.Type]component.ExtensionFactory{factory.Type(): factory})
+	require.NoError(t, err)
+	assert.EqualError(t, exts.StartAll(context.Background(), componenttest.NewNopHost()), "start failed")
+
+	// The extensions started before the failing one are shutdown, the following ones are never started.
+	assert.Equal(t, []string{"start rec/c", "start rec/a", "shutdown rec/a", "shutdown rec/c"}, calls)
+}
+
 func newBadExtensionFactory() component.ExtensionFactory {
 	return component.NewExtensionFactory(
 		"bf",
@@ -27,7 +27,9 @@ import (
 	"go.opentelemetry.io/collector/config"
 	"go.opentelemetry.io/collector/config/configtest"
 	"go.opentelemetry.io/collector/config/experimental/configsource"
+	"go.opentelemetry.io/collector/config/mapprovider/envmapprovider"
 	"go.opentelemetry.io/collector/config/mapprovider/filemapprovider"
+	"go.opentelemetry.io/collector/config/mapprovider/yamlmapprovider"
 )
 
 type mockProvider struct {
@@ -243,6 +245,29 @@ func TestMapResolver(t *testing.T) {
 	assert.NoError(t, errC)
 }
 
+func TestMapResolverMergeOrder(t *testing.T) {
+	t.Setenv("OTEL_CONFIG", "exporters:\n  nop:\n    endpoint: env\n    timeout: 1s\n")
+
+	resolver, err := newMapResolver(
+		[]string{
+			"file:" + filepath.Join("testdata", "otelcol-nop.yaml"),
+			"env:OTEL_CONFIG",
+			"yaml:exporters::nop::timeout: 2s",
+		},
+		makeMapProvidersMap(filemapprovider.New(), envmapprovider.New(), yamlmapprovider.New()), nil)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		assert.NoError(t, resolver.Shutdown(context.Background()))
+	})
+
+	cfgMap, err := resolver.Resolve(context.Background())
+	require.NoError(t, err)
+	// Values from the file are kept, later locations override earlier ones.
+	assert.True(t, cfgMap.IsSet("service::pipelines::traces"))
+	assert.Equal(t, "env", cfgMap.Get("exporters::nop::endpoint"))
+	assert.Equal(t, "2s", cfgMap.Get("exporters::nop::timeout"))
+}
+
 func TestMapResolverNoLocations(t *testing.T) {
 	_, err := newMapResolver([]string{}, makeMapProvidersMap(filemapprovider.New()), nil)
 	assert.Error(t, err)
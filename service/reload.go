@@ -0,0 +1,299 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service // import "go.opentelemetry.io/collector/service"
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"go.uber.org/multierr"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/service/internal/builder"
+)
+
+// pipelinesToRestart returns the pipelines to restart to apply the changed keys of the config.Map, as returned
+// by config.Map.Diff, when moving from the current to the new config. A pipeline is restarted if its own config,
+// or the config of one of its receivers, processors or exporters changed, along with all the pipelines sharing
+// a receiver or an exporter with it, since these components are restarted with the pipeline.
+//
+// It returns false if the change is not limited to the pipelines, e.g. an extension or the telemetry changed,
+// and the whole service must be restarted.
+func pipelinesToRestart(current, cfg *config.Config, changed []string) ([]config.ComponentID, bool) {
+	restart := map[config.ComponentID]struct{}{}
+	for _, key := range changed {
+		parts := strings.Split(key, config.KeyDelimiter)
+		if len(parts) < 2 {
+			return nil, false
+		}
+		var pipelineID bool
+		switch {
+		case parts[0] == "receivers" || parts[0] == "processors" || parts[0] == "exporters":
+		case parts[0] == "service" && parts[1] == "pipelines" && len(parts) >= 3:
+			parts = parts[1:]
+			pipelineID = true
+		default:
+			return nil, false
+		}
+		id, err := config.NewComponentIDFromString(parts[1])
+		if err != nil {
+			return nil, false
+		}
+		for _, c := range []*config.Config{current, cfg} {
+			for pid, pipeline := range c.Pipelines {
+				if pipelineID && pid == id || !pipelineID && usesComponent(pipeline, parts[0], id) {
+					restart[pid] = struct{}{}
+				}
+			}
+		}
+	}
+
+	// Add the pipelines sharing a receiver or an exporter with a pipeline to restart, until none is added.
+	for added := true; added; {
+		added = false
+		for _, c := range []*config.Config{current, cfg} {
+			for pid, pipeline := range c.Pipelines {
+				if _, ok := restart[pid]; ok {
+					continue
+				}
+				if sharesComponents(pipeline, current, cfg, restart) {
+					restart[pid] = struct{}{}
+					added = true
+				}
+			}
+		}
+	}
+
+	ids := make([]config.ComponentID, 0, len(restart))
+	for id := range restart {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i].String() < ids[j].String() })
+	return ids, true
+}
+
+// usesComponent returns true if the pipeline uses the component with the given id, of the kind given
+// by its config.Map key: "receivers", "processors" or "exporters".
+func usesComponent(pipeline *config.Pipeline, kind string, id config.ComponentID) bool {
+	var ids []config.ComponentID
+	switch kind {
+	case "receivers":
+		ids = pipeline.Receivers
+	case "processors":
+		ids = pipeline.Processors
+	case "exporters":
+		ids = pipeline.Exporters
+	}
+	for _, cid := range ids {
+		if cid == id {
+			return true
+		}
+	}
+	return false
+}
+
+// sharesComponents returns true if the pipeline uses a receiver or an exporter used by one of the pipelines
+// of the current or the new config in restart.
+func sharesComponents(pipeline *config.Pipeline, current, cfg *config.Config, restart map[config.ComponentID]struct{}) bool {
+	for _, c := range []*config.Config{current, cfg} {
+		for pid := range restart {
+			other, ok := c.Pipelines[pid]
+			if !ok {
+				continue
+			}
+			for _, id := range pipeline.Receivers {
+				if usesComponent(other, "receivers", id) {
+					return true
+				}
+			}
+			for _, id := range pipeline.Exporters {
+				if usesComponent(other, "exporters", id) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// pipelinesConfig returns a config with only the given pipelines of cfg, the ones that are not in cfg are
+// ignored, and the receivers, processors and exporters they use.
+func pipelinesConfig(cfg *config.Config, ids []config.ComponentID) *config.Config {
+	sub := &config.Config{
+		Receivers:  make(map[config.ComponentID]config.Receiver),
+		Processors: make(map[config.ComponentID]config.Processor),
+		Exporters:  make(map[config.ComponentID]config.Exporter),
+		Service: config.Service{
+			Telemetry: cfg.Service.Telemetry,
+			Pipelines: make(config.Pipelines),
+		},
+	}
+	for _, pid := range ids {
+		pipeline, ok := cfg.Pipelines[pid]
+		if !ok {
+			continue
+		}
+		sub.Pipelines[pid] = pipeline
+		for _, id := range pipeline.Receivers {
+			sub.Receivers[id] = cfg.Receivers[id]
+		}
+		for _, id := range pipeline.Processors {
+			sub.Processors[id] = cfg.Processors[id]
+		}
+		for _, id := range pipeline.Exporters {
+			sub.Exporters[id] = cfg.Exporters[id]
+		}
+	}
+	return sub
+}
+
+// pipelineComponents are the receivers, the pipelines with their processors, and the exporters of some of the
+// pipelines of the service, which can be started and shutdown without the other pipelines.
+type pipelineComponents struct {
+	exporters builder.Exporters
+	pipelines builder.BuiltPipelines
+	receivers builder.Receivers
+}
+
+// start starts the components from the exporters to the receivers. If they fail to start, the ones already
+// started are shutdown.
+func (pc *pipelineComponents) start(ctx context.Context, host component.Host) error {
+	if err := pc.exporters.StartAll(ctx, host); err != nil {
+		return fmt.Errorf("cannot start exporters: %w", err)
+	}
+	if err := pc.pipelines.StartProcessors(ctx, host); err != nil {
+		return multierr.Append(fmt.Errorf("cannot start processors: %w", err), pc.exporters.ShutdownAll(ctx))
+	}
+	if err := pc.receivers.StartAll(ctx, host); err != nil {
+		return multierr.Combine(fmt.Errorf("cannot start receivers: %w", err), pc.pipelines.ShutdownProcessors(ctx), pc.exporters.ShutdownAll(ctx))
+	}
+	return nil
+}
+
+// shutdown shuts down the components in the reverse order they are started.
+func (pc *pipelineComponents) shutdown(ctx context.Context) error {
+	var errs error
+	if err := pc.receivers.ShutdownAll(ctx); err != nil {
+		errs = multierr.Append(errs, fmt.Errorf("failed to shutdown receivers: %w", err))
+	}
+	if err := pc.pipelines.ShutdownProcessors(ctx); err != nil {
+		errs = multierr.Append(errs, fmt.Errorf("failed to shutdown processors: %w", err))
+	}
+	if err := pc.exporters.ShutdownAll(ctx); err != nil {
+		errs = multierr.Append(errs, fmt.Errorf("failed to shutdown exporters: %w", err))
+	}
+	return errs
+}
+
+// buildPipelines builds the components of the given pipelines of cfg, without starting them.
+func (srv *service) buildPipelines(cfg *config.Config, ids []config.ComponentID) (*pipelineComponents, error) {
+	sub := pipelinesConfig(cfg, ids)
+	if err := validateFactories(sub, srv.host.factories); err != nil {
+		return nil, err
+	}
+
+	pc := &pipelineComponents{}
+	var err error
+	if pc.exporters, err = builder.BuildExporters(srv.telemetry, srv.buildInfo, sub, srv.host.factories.Exporters); err != nil {
+		return nil, fmt.Errorf("cannot build exporters: %w", err)
+	}
+	if pc.pipelines, err = builder.BuildPipelines(srv.telemetry, srv.buildInfo, sub, pc.exporters, srv.host.factories.Processors); err != nil {
+		return nil, fmt.Errorf("cannot build pipelines: %w", err)
+	}
+	if pc.receivers, err = builder.BuildReceivers(srv.telemetry, srv.buildInfo, sub, pc.pipelines, srv.host.factories.Receivers); err != nil {
+		return nil, fmt.Errorf("cannot build receivers: %w", err)
+	}
+	return pc, nil
+}
+
+// shutdownPipelines shuts down the given pipelines of the running service, along with their receivers and exporters.
+// The extensions are notified that the pipelines are not ready until startPipelines is called.
+func (srv *service) shutdownPipelines(ctx context.Context, ids []config.ComponentID) error {
+	var errs error
+	if err := srv.host.builtExtensions.NotifyPipelineNotReady(); err != nil {
+		errs = multierr.Append(errs, fmt.Errorf("failed to notify that pipeline is not ready: %w", err))
+	}
+	return multierr.Append(errs, srv.pipelineComponents(ids).shutdown(ctx))
+}
+
+// startPipelines starts the components built by buildPipelines for the given pipelines of cfg. Once they are all
+// started they replace the components previously shutdown by shutdownPipelines, and cfg becomes the service config.
+// If the components fail to start, the ones already started are shutdown and the service is left unchanged.
+func (srv *service) startPipelines(ctx context.Context, cfg *config.Config, ids []config.ComponentID, pc *pipelineComponents) error {
+	if err := pc.start(ctx, srv.host); err != nil {
+		return err
+	}
+
+	previous := srv.pipelineComponents(ids)
+	exporters := make(builder.Exporters, len(srv.host.builtExporters))
+	for id, exp := range srv.host.builtExporters {
+		if _, ok := previous.exporters[id]; !ok {
+			exporters[id] = exp
+		}
+	}
+	for id, exp := range pc.exporters {
+		exporters[id] = exp
+	}
+	pipelines := make(builder.BuiltPipelines, len(srv.host.builtPipelines))
+	for id, bp := range srv.host.builtPipelines {
+		if _, ok := previous.pipelines[id]; !ok {
+			pipelines[id] = bp
+		}
+	}
+	for id, bp := range pc.pipelines {
+		pipelines[id] = bp
+	}
+	receivers := make(builder.Receivers, len(srv.host.builtReceivers))
+	for id, rcv := range srv.host.builtReceivers {
+		if _, ok := previous.receivers[id]; !ok {
+			receivers[id] = rcv
+		}
+	}
+	for id, rcv := range pc.receivers {
+		receivers[id] = rcv
+	}
+	srv.host.builtExporters, srv.host.builtPipelines, srv.host.builtReceivers = exporters, pipelines, receivers
+	srv.config = cfg
+
+	return srv.host.builtExtensions.NotifyPipelineReady()
+}
+
+// pipelineComponents returns the components of the given pipelines of the running service. The receivers and
+// exporters they use must not be used by the other pipelines, see pipelinesToRestart.
+func (srv *service) pipelineComponents(ids []config.ComponentID) *pipelineComponents {
+	sub := pipelinesConfig(srv.config, ids)
+	pc := &pipelineComponents{
+		exporters: make(builder.Exporters),
+		pipelines: make(builder.BuiltPipelines),
+		receivers: make(builder.Receivers),
+	}
+	for pid := range sub.Pipelines {
+		pc.pipelines[pid] = srv.host.builtPipelines[pid]
+	}
+	for id := range sub.Receivers {
+		if rcv, ok := srv.host.builtReceivers[id]; ok {
+			pc.receivers[id] = rcv
+		}
+	}
+	for id := range sub.Exporters {
+		if exp, ok := srv.host.builtExporters[id]; ok {
+			pc.exporters[id] = exp
+		}
+	}
+	return pc
+}
@@ -0,0 +1,161 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/collector/config"
+)
+
+func TestPipelinesToRestart(t *testing.T) {
+	traces := config.NewComponentID("traces")
+	traces2 := config.NewComponentIDWithName("traces", "2")
+	metrics := config.NewComponentID("metrics")
+	logs := config.NewComponentID("logs")
+	otlp := config.NewComponentID("otlp")
+	jaeger := config.NewComponentID("jaeger")
+	filelog := config.NewComponentID("filelog")
+	batch := config.NewComponentID("batch")
+	logging := config.NewComponentID("logging")
+	prometheus := config.NewComponentID("prometheus")
+	file := config.NewComponentID("file")
+
+	current := &config.Config{Service: config.Service{Pipelines: config.Pipelines{
+		traces:  {Receivers: []config.ComponentID{jaeger}, Processors: []config.ComponentID{batch}, Exporters: []config.ComponentID{otlp}},
+		traces2: {Receivers: []config.ComponentID{jaeger}, Exporters: []config.ComponentID{logging}},
+		metrics: {Receivers: []config.ComponentID{otlp}, Processors: []config.ComponentID{batch}, Exporters: []config.ComponentID{prometheus}},
+		logs:    {Receivers: []config.ComponentID{filelog}, Exporters: []config.ComponentID{file}},
+	}}}
+
+	var testCases = []struct {
+		name      string
+		cfg       *config.Config
+		changed   []string
+		pipelines []config.ComponentID
+		partial   bool
+	}{
+		{
+			name:      "receiver",
+			changed:   []string{"receivers::jaeger::protocols::grpc::endpoint"},
+			pipelines: []config.ComponentID{traces, traces2},
+			partial:   true,
+		},
+		{
+			// The otlp exporter of the traces pipeline is not the otlp receiver.
+			name:      "receiver_same_id_as_exporter",
+			changed:   []string{"receivers::otlp::protocols::grpc::endpoint"},
+			pipelines: []config.ComponentID{metrics},
+			partial:   true,
+		},
+		{
+			// The traces2 pipeline shares the receiver of the traces pipeline.
+			name:      "processor",
+			changed:   []string{"processors::batch::timeout"},
+			pipelines: []config.ComponentID{metrics, traces, traces2},
+			partial:   true,
+		},
+		{
+			name:      "exporter",
+			changed:   []string{"exporters::file::path"},
+			pipelines: []config.ComponentID{logs},
+			partial:   true,
+		},
+		{
+			name:      "pipeline",
+			changed:   []string{"service::pipelines::traces/2::processors"},
+			pipelines: []config.ComponentID{traces, traces2},
+			partial:   true,
+		},
+		{
+			name:      "unused_component",
+			changed:   []string{"exporters::kafka::brokers"},
+			pipelines: []config.ComponentID{},
+			partial:   true,
+		},
+		{
+			// The added pipeline shares the receiver of the traces pipelines and the exporter of the logs pipeline.
+			name: "added_pipeline",
+			cfg: &config.Config{Service: config.Service{Pipelines: config.Pipelines{
+				traces:  current.Pipelines[traces],
+				traces2: current.Pipelines[traces2],
+				metrics: current.Pipelines[metrics],
+				logs:    current.Pipelines[logs],
+				config.NewComponentIDWithName("logs", "2"): {Receivers: []config.ComponentID{jaeger}, Exporters: []config.ComponentID{file}},
+			}}},
+			changed:   []string{"service::pipelines::logs/2::receivers", "service::pipelines::logs/2::exporters"},
+			pipelines: []config.ComponentID{logs, config.NewComponentIDWithName("logs", "2"), traces, traces2},
+			partial:   true,
+		},
+		{
+			name:    "extension",
+			changed: []string{"receivers::otlp::protocols::grpc::endpoint", "extensions::zpages::endpoint"},
+		},
+		{
+			name:    "service_extensions",
+			changed: []string{"service::extensions"},
+		},
+		{
+			name:    "telemetry",
+			changed: []string{"service::telemetry::logs::level"},
+		},
+		{
+			name:    "invalid_id",
+			changed: []string{"receivers::/invalid"},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := test.cfg
+			if cfg == nil {
+				cfg = current
+			}
+			pipelines, partial := pipelinesToRestart(current, cfg, test.changed)
+			assert.Equal(t, test.partial, partial)
+			if test.partial {
+				assert.Equal(t, test.pipelines, pipelines)
+			}
+		})
+	}
+}
+
+func TestPipelinesConfig(t *testing.T) {
+	nopID := config.NewComponentID("nop")
+	traces := config.NewComponentID("traces")
+	cfg := &config.Config{
+		Receivers:  map[config.ComponentID]config.Receiver{nopID: nil, config.NewComponentID("otlp"): nil},
+		Processors: map[config.ComponentID]config.Processor{nopID: nil},
+		Exporters:  map[config.ComponentID]config.Exporter{nopID: nil, config.NewComponentID("otlp"): nil},
+		Extensions: map[config.ComponentID]config.Extension{nopID: nil},
+		Service: config.Service{
+			Extensions: []config.ComponentID{nopID},
+			Pipelines: config.Pipelines{
+				traces: {Receivers: []config.ComponentID{nopID}, Processors: []config.ComponentID{nopID}, Exporters: []config.ComponentID{nopID}},
+				config.NewComponentID("metrics"): {Receivers: []config.ComponentID{config.NewComponentID("otlp")}, Exporters: []config.ComponentID{config.NewComponentID("otlp")}},
+			},
+		},
+	}
+
+	sub := pipelinesConfig(cfg, []config.ComponentID{traces, config.NewComponentID("logs")})
+	assert.Equal(t, config.Pipelines{traces: cfg.Pipelines[traces]}, sub.Pipelines)
+	assert.Equal(t, map[config.ComponentID]config.Receiver{nopID: nil}, sub.Receivers)
+	assert.Equal(t, map[config.ComponentID]config.Processor{nopID: nil}, sub.Processors)
+	assert.Equal(t, map[config.ComponentID]config.Exporter{nopID: nil}, sub.Exporters)
+	assert.Empty(t, sub.Extensions)
+	assert.Empty(t, sub.Service.Extensions)
+}
@@ -17,8 +17,11 @@ package service // import "go.opentelemetry.io/collector/service"
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 
 	"go.uber.org/multierr"
+	"go.uber.org/zap"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config"
@@ -32,9 +35,17 @@ type service struct {
 	config    *config.Config
 	telemetry component.TelemetrySettings
 	host      *serviceHost
+	// started is the number of steps of Start that completed, out of the extensions, exporters, processors
+	// and receivers. Shutdown only shuts down the components of these steps, since a step failing to start
+	// already shuts down the components it started.
+	started int
 }
 
 func newService(set *svcSettings) (*service, error) {
+	if err := validateFactories(set.Config, set.Factories); err != nil {
+		return nil, err
+	}
+
 	srv := &service{
 		buildInfo: set.BuildInfo,
 		config:    set.Config,
@@ -45,6 +56,8 @@ func newService(set *svcSettings) (*service, error) {
 			asyncErrorChannel:   set.AsyncErrorChannel,
 		},
 	}
+	srv.host.ctx, srv.host.cancel = context.WithCancel(context.Background())
+	srv.host.ready = make(chan struct{})
 
 	var err error
 	if srv.host.builtExtensions, err = extensions.Build(srv.telemetry, srv.buildInfo, srv.config, srv.host.factories.Extensions); err != nil {
@@ -72,34 +85,86 @@ func newService(set *svcSettings) (*service, error) {
 	return srv, nil
 }
 
+// validateFactories returns an error listing every component in the config whose type has no registered factory,
+// so that the service fails fast instead of failing when the component is first used.
+func validateFactories(cfg *config.Config, factories component.Factories) error {
+	var missing []string
+	for id := range cfg.Receivers {
+		if _, ok := factories.Receivers[id.Type()]; !ok {
+			missing = append(missing, fmt.Sprintf("receiver %q (type %q)", id, id.Type()))
+		}
+	}
+	for id := range cfg.Processors {
+		if _, ok := factories.Processors[id.Type()]; !ok {
+			missing = append(missing, fmt.Sprintf("processor %q (type %q)", id, id.Type()))
+		}
+	}
+	for id := range cfg.Exporters {
+		if _, ok := factories.Exporters[id.Type()]; !ok {
+			missing = append(missing, fmt.Sprintf("exporter %q (type %q)", id, id.Type()))
+		}
+	}
+	for id := range cfg.Extensions {
+		if _, ok := factories.Extensions[id.Type()]; !ok {
+			missing = append(missing, fmt.Sprintf("extension %q (type %q)", id, id.Type()))
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+	sort.Strings(missing)
+	return fmt.Errorf("factories not available for components: %s", strings.Join(missing, ", "))
+}
+
 func (srv *service) Start(ctx context.Context) error {
 	srv.telemetry.Logger.Info("Starting extensions...")
 	if err := srv.host.builtExtensions.StartAll(ctx, srv.host); err != nil {
 		return fmt.Errorf("failed to start extensions: %w", err)
 	}
+	srv.started++
 
 	srv.telemetry.Logger.Info("Starting exporters...")
 	if err := srv.host.builtExporters.StartAll(ctx, srv.host); err != nil {
 		return fmt.Errorf("cannot start exporters: %w", err)
 	}
+	srv.started++
 
 	srv.telemetry.Logger.Info("Starting processors...")
 	if err := srv.host.builtPipelines.StartProcessors(ctx, srv.host); err != nil {
 		return fmt.Errorf("cannot start processors: %w", err)
 	}
+	srv.started++
 
 	srv.telemetry.Logger.Info("Starting receivers...")
 	if err := srv.host.builtReceivers.StartAll(ctx, srv.host); err != nil {
 		return fmt.Errorf("cannot start receivers: %w", err)
 	}
+	srv.started++
 
-	return srv.host.builtExtensions.NotifyPipelineReady()
+	if err := srv.host.builtExtensions.NotifyPipelineReady(); err != nil {
+		return err
+	}
+
+	close(srv.host.ready)
+	return nil
 }
 
+// Shutdown stops all components in the reverse order they were started: receivers, processors,
+// exporters and finally extensions. Errors are accumulated and the remaining components are
+// always shutdown, if the context is done before all components are shutdown its error is returned too.
+// After a failed Start, only the components that started are shutdown.
 func (srv *service) Shutdown(ctx context.Context) error {
 	// Accumulate errors and proceed with shutting down remaining components.
 	var errs error
 
+	// Signal the shutdown to the components before shutting them down.
+	srv.host.cancel()
+
+	if fatalErr := srv.host.firstFatalError(); fatalErr != nil {
+		srv.telemetry.Logger.Error("Shutting down after a fatal error reported by a component",
+			zap.Error(fatalErr), zap.Int64("dropped_fatal_errors", srv.host.droppedFatalErrors.Load()))
+	}
+
 	if err := srv.host.builtExtensions.NotifyPipelineNotReady(); err != nil {
 		errs = multierr.Append(errs, fmt.Errorf("failed to notify that pipeline is not ready: %w", err))
 	}
@@ -107,25 +172,31 @@ func (srv *service) Shutdown(ctx context.Context) error {
 	// Pipeline shutdown order is the reverse of building/starting: first receivers, then flushing pipelines
 	// giving senders a chance to send all their data. This may take time, the allowed
 	// time should be part of configuration.
-
-	srv.telemetry.Logger.Info("Stopping receivers...")
-	if err := srv.host.builtReceivers.ShutdownAll(ctx); err != nil {
-		errs = multierr.Append(errs, fmt.Errorf("failed to shutdown receivers: %w", err))
+	steps := []struct {
+		name     string
+		shutdown func(context.Context) error
+	}{
+		{name: "receivers", shutdown: srv.host.builtReceivers.ShutdownAll},
+		{name: "processors", shutdown: srv.host.builtPipelines.ShutdownProcessors},
+		{name: "exporters", shutdown: srv.host.builtExporters.ShutdownAll},
+		{name: "extensions", shutdown: srv.host.builtExtensions.ShutdownAll},
 	}
-
-	srv.telemetry.Logger.Info("Stopping processors...")
-	if err := srv.host.builtPipelines.ShutdownProcessors(ctx); err != nil {
-		errs = multierr.Append(errs, fmt.Errorf("failed to shutdown processors: %w", err))
+	for i, step := range steps {
+		// The steps are in the reverse order of Start.
+		if len(steps)-i > srv.started {
+			continue
+		}
+		srv.telemetry.Logger.Info("Stopping " + step.name + "...")
+		if err := step.shutdown(ctx); err != nil {
+			errs = multierr.Append(errs, fmt.Errorf("failed to shutdown %s: %w", step.name, err))
+		}
 	}
 
-	srv.telemetry.Logger.Info("Stopping exporters...")
-	if err := srv.host.builtExporters.ShutdownAll(ctx); err != nil {
-		errs = multierr.Append(errs, fmt.Errorf("failed to shutdown exporters: %w", err))
-	}
+	// Fatal errors reported by the components are no longer relevant once they are all shutdown.
+	srv.host.closeFatalErrorSubscribers()
 
-	srv.telemetry.Logger.Info("Stopping extensions...")
-	if err := srv.host.builtExtensions.ShutdownAll(ctx); err != nil {
-		errs = multierr.Append(errs, fmt.Errorf("failed to shutdown extensions: %w", err))
+	if err := ctx.Err(); err != nil {
+		errs = multierr.Append(errs, fmt.Errorf("shutdown did not complete before the context was done: %w", err))
 	}
 
 	return errs
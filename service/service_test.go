@@ -16,6 +16,7 @@ package service
 
 import (
 	"context"
+	"errors"
 	"path/filepath"
 	"testing"
 
@@ -23,8 +24,10 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenterror"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/config/configtest"
 	"go.opentelemetry.io/collector/service/servicetest"
 )
 
@@ -54,6 +57,24 @@ func TestService_GetFactory(t *testing.T) {
 	assert.Nil(t, srv.host.GetFactory(42, "nop"))
 }
 
+func TestService_GetFactoryE(t *testing.T) {
+	factories, err := componenttest.NopFactories()
+	require.NoError(t, err)
+	srv := createExampleService(t, factories)
+
+	factory, err := srv.host.GetFactoryE(component.KindExporter, "nop")
+	require.NoError(t, err)
+	assert.Equal(t, factories.Exporters["nop"], factory)
+
+	_, err = srv.host.GetFactoryE(component.KindReceiver, "wrongtype")
+	assert.ErrorIs(t, err, componenterror.ErrFactoryNotFound)
+	assert.EqualError(t, err, `factory not found for component type "wrongtype"`)
+
+	_, err = srv.host.GetFactoryE(42, "nop")
+	assert.ErrorIs(t, err, componenterror.ErrUnknownKind)
+	assert.EqualError(t, err, "unknown component kind 42")
+}
+
 func TestService_GetExtensions(t *testing.T) {
 	factories, err := componenttest.NopFactories()
 	require.NoError(t, err)
@@ -70,6 +91,25 @@ func TestService_GetExtensions(t *testing.T) {
 	assert.Contains(t, extMap, config.NewComponentID("nop"))
 }
 
+func TestService_GetExtension(t *testing.T) {
+	factories, err := componenttest.NopFactories()
+	require.NoError(t, err)
+	srv := createExampleService(t, factories)
+
+	assert.NoError(t, srv.Start(context.Background()))
+	t.Cleanup(func() {
+		assert.NoError(t, srv.Shutdown(context.Background()))
+	})
+
+	ext, ok := srv.host.GetExtension(config.NewComponentID("nop"))
+	assert.True(t, ok)
+	assert.Equal(t, srv.host.GetExtensions()[config.NewComponentID("nop")], ext)
+
+	ext, ok = srv.host.GetExtension(config.NewComponentIDWithName("nop", "missing"))
+	assert.False(t, ok)
+	assert.Nil(t, ext)
+}
+
 func TestService_GetExporters(t *testing.T) {
 	factories, err := componenttest.NopFactories()
 	require.NoError(t, err)
@@ -90,6 +130,259 @@ func TestService_GetExporters(t *testing.T) {
 	assert.Contains(t, expMap[config.LogsDataType], config.NewComponentID("nop"))
 }
 
+func TestService_GetReceivers(t *testing.T) {
+	factories, err := componenttest.NopFactories()
+	require.NoError(t, err)
+	srv := createExampleService(t, factories)
+
+	assert.NoError(t, srv.Start(context.Background()))
+	t.Cleanup(func() {
+		assert.NoError(t, srv.Shutdown(context.Background()))
+	})
+
+	rcvMap := srv.host.GetReceivers()
+	assert.Len(t, rcvMap, 3)
+	for _, dt := range []config.DataType{config.TracesDataType, config.MetricsDataType, config.LogsDataType} {
+		assert.Len(t, rcvMap[dt], 1)
+		assert.Contains(t, rcvMap[dt], config.NewComponentID("nop"))
+	}
+
+	// The same receiver is shared by all the pipelines.
+	assert.Same(t, rcvMap[config.TracesDataType][config.NewComponentID("nop")], rcvMap[config.LogsDataType][config.NewComponentID("nop")])
+}
+
+func TestService_GetPipelines(t *testing.T) {
+	factories, err := componenttest.NopFactories()
+	require.NoError(t, err)
+	srv := createExampleService(t, factories)
+
+	assert.NoError(t, srv.Start(context.Background()))
+	t.Cleanup(func() {
+		assert.NoError(t, srv.Shutdown(context.Background()))
+	})
+
+	pipelines := srv.host.GetPipelines()
+	assert.Len(t, pipelines, 3)
+	for _, dt := range []config.DataType{config.TracesDataType, config.MetricsDataType, config.LogsDataType} {
+		assert.Equal(t, component.PipelineInfo{
+			Receivers:  []config.ComponentID{config.NewComponentID("nop")},
+			Processors: []config.ComponentID{config.NewComponentID("nop")},
+			Exporters:  []config.ComponentID{config.NewComponentID("nop")},
+		}, pipelines[config.NewComponentID(dt)])
+	}
+
+	// The returned slices are copies of the pipelines configuration.
+	pipelines[config.NewComponentID(config.TracesDataType)].Processors[0] = config.NewComponentID("other")
+	assert.Equal(t, config.NewComponentID("nop"), srv.host.GetPipelines()[config.NewComponentID(config.TracesDataType)].Processors[0])
+}
+
+func TestService_Context(t *testing.T) {
+	factories, err := componenttest.NopFactories()
+	require.NoError(t, err)
+	srv := createExampleService(t, factories)
+
+	assert.NoError(t, srv.Start(context.Background()))
+	ctx := srv.host.Context()
+	assert.NoError(t, ctx.Err())
+
+	assert.NoError(t, srv.Shutdown(context.Background()))
+	select {
+	case <-ctx.Done():
+	default:
+		t.Fatal("context not cancelled on shutdown")
+	}
+}
+
+func TestService_Ready(t *testing.T) {
+	factories, err := componenttest.NopFactories()
+	require.NoError(t, err)
+	srv := createExampleService(t, factories)
+
+	ready := srv.host.Ready()
+	select {
+	case <-ready:
+		t.Fatal("ready before start")
+	default:
+	}
+
+	assert.NoError(t, srv.Start(context.Background()))
+	t.Cleanup(func() {
+		assert.NoError(t, srv.Shutdown(context.Background()))
+	})
+	select {
+	case <-ready:
+	default:
+		t.Fatal("not ready after start")
+	}
+}
+
+func TestService_GetExportersForDataType(t *testing.T) {
+	factories, err := componenttest.NopFactories()
+	require.NoError(t, err)
+	srv := createExampleService(t, factories)
+
+	assert.NoError(t, srv.Start(context.Background()))
+	t.Cleanup(func() {
+		assert.NoError(t, srv.Shutdown(context.Background()))
+	})
+
+	for _, dt := range []config.DataType{config.TracesDataType, config.MetricsDataType, config.LogsDataType} {
+		expMap := srv.host.GetExportersForDataType(dt)
+		assert.Len(t, expMap, 1)
+		assert.Equal(t, srv.host.GetExporters()[dt], expMap)
+	}
+
+	expMap := srv.host.GetExportersForDataType("unknown")
+	assert.NotNil(t, expMap)
+	assert.Empty(t, expMap)
+}
+
+func TestService_ShutdownContextDone(t *testing.T) {
+	factories, err := componenttest.NopFactories()
+	require.NoError(t, err)
+	srv := createExampleService(t, factories)
+
+	require.NoError(t, srv.Start(context.Background()))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = srv.Shutdown(ctx)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Contains(t, err.Error(), "shutdown did not complete before the context was done")
+}
+
+func TestService_ReportFatalError(t *testing.T) {
+	host := &serviceHost{asyncErrorChannel: make(chan error, 1)}
+
+	assert.NoError(t, host.firstFatalError())
+
+	// None of the calls must block, even though nobody reads from the channel.
+	host.ReportFatalError(errors.New("first"))
+	host.ReportFatalError(errors.New("second"))
+	host.ReportFatalError(errors.New("third"))
+
+	assert.EqualError(t, <-host.asyncErrorChannel, "first")
+	assert.EqualError(t, host.firstFatalError(), "first")
+	assert.Equal(t, int64(2), host.droppedFatalErrors.Load())
+}
+
+func TestService_SubscribeFatalErrors(t *testing.T) {
+	factories, err := componenttest.NopFactories()
+	require.NoError(t, err)
+	srv := createExampleService(t, factories)
+	srv.host.asyncErrorChannel = make(chan error, 1)
+	require.NoError(t, srv.Start(context.Background()))
+
+	sub1 := srv.host.SubscribeFatalErrors()
+	sub2 := srv.host.SubscribeFatalErrors()
+	srv.host.ReportFatalError(errors.New("fatal"))
+
+	// Every subscriber and the service receive the error.
+	assert.EqualError(t, <-sub1, "fatal")
+	assert.EqualError(t, <-sub2, "fatal")
+	assert.EqualError(t, <-srv.host.asyncErrorChannel, "fatal")
+
+	// Reporting doesn't block on subscribers that don't read the errors.
+	for i := 0; i < 2*fatalErrorSubscriberBufferSize; i++ {
+		srv.host.ReportFatalError(errors.New("dropped"))
+	}
+	assert.Len(t, sub1, fatalErrorSubscriberBufferSize)
+
+	require.NoError(t, srv.Shutdown(context.Background()))
+	for range sub2 {
+	}
+	_, ok := <-srv.host.SubscribeFatalErrors()
+	assert.False(t, ok, "subscription after shutdown must be closed")
+
+	// Reporting after shutdown must not send on the closed channels.
+	srv.host.ReportFatalError(errors.New("after shutdown"))
+}
+
+func TestService_ReportComponentStatus(t *testing.T) {
+	host := &serviceHost{asyncErrorChannel: make(chan error, 1)}
+	assert.Empty(t, host.GetComponentStatuses())
+	assert.Equal(t, component.StatusHealthy, host.GetAggregateStatus())
+
+	rcvID := config.NewComponentID("nop")
+	expID := config.NewComponentIDWithName("nop", "exp")
+	host.ReportComponentStatus(rcvID, component.StatusStarting, nil)
+	host.ReportComponentStatus(expID, component.StatusHealthy, nil)
+	assert.Equal(t, component.StatusStarting, host.GetAggregateStatus())
+
+	errRetry := errors.New("retry")
+	host.ReportComponentStatus(rcvID, component.StatusRecoverableError, errRetry)
+	assert.Equal(t, map[config.ComponentID]component.StatusEvent{
+		rcvID: {Status: component.StatusRecoverableError, Err: errRetry},
+		expID: {Status: component.StatusHealthy},
+	}, host.GetComponentStatuses())
+	assert.Equal(t, component.StatusRecoverableError, host.GetAggregateStatus())
+
+	host.ReportFatalError(errors.New("fatal"))
+	assert.Equal(t, component.StatusPermanentError, host.GetAggregateStatus())
+}
+
+func TestService_MissingFactories(t *testing.T) {
+	factories, err := componenttest.NopFactories()
+	require.NoError(t, err)
+	cfg, err := servicetest.LoadConfigAndValidate(filepath.Join("testdata", "otelcol-nop.yaml"), factories)
+	require.NoError(t, err)
+
+	delete(factories.Receivers, "nop")
+	delete(factories.Extensions, "nop")
+	_, err = newService(&svcSettings{
+		BuildInfo: component.NewDefaultBuildInfo(),
+		Factories: factories,
+		Telemetry: componenttest.NewNopTelemetrySettings(),
+		Config:    cfg,
+	})
+	assert.EqualError(t, err, `factories not available for components: extension "nop" (type "nop"), receiver "nop" (type "nop")`)
+}
+
+func TestNewServiceHost(t *testing.T) {
+	factories, err := componenttest.NopFactories()
+	require.NoError(t, err)
+	cfgMap, err := configtest.LoadConfigMap(filepath.Join("testdata", "otelcol-nop.yaml"))
+	require.NoError(t, err)
+
+	host, err := NewServiceHost(factories, cfgMap, componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+	assert.Len(t, host.GetExtensions(), 1)
+	assert.Len(t, host.GetExporters()[config.TracesDataType], 1)
+
+	require.NoError(t, host.Start(context.Background()))
+	readyHost, ok := host.(interface{ Ready() <-chan struct{} })
+	require.True(t, ok)
+	select {
+	case <-readyHost.Ready():
+	default:
+		t.Fatal("not ready after start")
+	}
+
+	// Reporting more fatal errors than buffered never blocks, subscribers still receive them.
+	errs := host.(interface{ SubscribeFatalErrors() <-chan error }).SubscribeFatalErrors()
+	host.ReportFatalError(errors.New("first"))
+	host.ReportFatalError(errors.New("second"))
+	assert.EqualError(t, <-errs, "first")
+	assert.EqualError(t, <-errs, "second")
+	assert.NoError(t, host.Shutdown(context.Background()))
+}
+
+func TestNewServiceHostError(t *testing.T) {
+	factories, err := componenttest.NopFactories()
+	require.NoError(t, err)
+
+	cfgMap, err := configtest.LoadConfigMap(filepath.Join("testdata", "otelcol-invalid.yaml"))
+	require.NoError(t, err)
+	_, err = NewServiceHost(factories, cfgMap, componenttest.NewNopTelemetrySettings())
+	assert.ErrorContains(t, err, "invalid configuration")
+
+	cfgMap = config.NewMapFromStringMap(map[string]interface{}{
+		"receivers": map[string]interface{}{"unknown": nil},
+	})
+	_, err = NewServiceHost(factories, cfgMap, componenttest.NewNopTelemetrySettings())
+	assert.ErrorContains(t, err, "cannot unmarshal the configuration")
+}
+
 func createExampleService(t *testing.T, factories component.Factories) *service {
 	// Create some factories.
 	cfg, err := servicetest.LoadConfigAndValidate(filepath.Join("testdata", "otelcol-nop.yaml"), factories)
@@ -52,7 +52,7 @@ type CollectorSettings struct {
 	BuildInfo component.BuildInfo
 
 	// DisableGracefulShutdown disables the automatic graceful shutdown
-	// of the collector on SIGINT or SIGTERM.
+	// of the collector on SIGINT or SIGTERM, and the config reload on SIGHUP.
 	// Users who want to handle signals themselves can disable this behavior
 	// and manually handle the signals to shutdown the collector.
 	DisableGracefulShutdown bool
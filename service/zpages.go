@@ -22,6 +22,7 @@ import (
 	otelzpages "go.opentelemetry.io/contrib/zpages"
 
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config"
 	"go.opentelemetry.io/collector/internal/version"
 	"go.opentelemetry.io/collector/service/featuregate"
 	"go.opentelemetry.io/collector/service/internal/zpages"
@@ -89,11 +90,43 @@ func (host *serviceHost) handlePipelinezRequest(w http.ResponseWriter, r *http.R
 		zpages.WriteHTMLComponentHeader(w, zpages.ComponentHeaderData{
 			Name: componentKind + ": " + fullName,
 		})
+		zpages.WriteHTMLPropertiesTable(w, zpages.PropertiesTableData{
+			Name:       "Used by pipelines",
+			Properties: host.getComponentPipelinesProperties(componentKind, componentName),
+		})
 		// TODO: Add config + status info.
 	}
 	zpages.WriteHTMLPageFooter(w)
 }
 
+// getComponentPipelinesProperties returns the pipelines using the component with their data type,
+// showing the fan-out of the receivers and exporters used by multiple pipelines.
+func (host *serviceHost) getComponentPipelinesProperties(componentKind, componentName string) [][2]string {
+	var props [][2]string
+	for pipelineID, p := range host.builtPipelines {
+		var ids []config.ComponentID
+		switch componentKind {
+		case "receiver":
+			ids = p.Config.Receivers
+		case "processor":
+			ids = p.Config.Processors
+		case "exporter":
+			ids = p.Config.Exporters
+		}
+		for _, id := range ids {
+			if id.String() == componentName {
+				props = append(props, [2]string{pipelineID.String(), string(pipelineID.Type())})
+				break
+			}
+		}
+	}
+
+	sort.Slice(props, func(i, j int) bool {
+		return props[i][0] < props[j][0]
+	})
+	return props
+}
+
 func (host *serviceHost) getPipelinesSummaryTableData() zpages.SummaryPipelinesTableData {
 	data := zpages.SummaryPipelinesTableData{}
 
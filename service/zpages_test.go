@@ -0,0 +1,59 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/component/componenttest"
+)
+
+func TestService_Pipelinez(t *testing.T) {
+	factories, err := componenttest.NopFactories()
+	require.NoError(t, err)
+	srv := createExampleService(t, factories)
+
+	mux := http.NewServeMux()
+	srv.host.RegisterZPages(mux, "/debug")
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	resp, err := http.Get(server.URL + "/debug/pipelinez")
+	require.NoError(t, err)
+	body, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	for _, pipeline := range []string{"traces", "metrics", "logs"} {
+		assert.Contains(t, string(body), "zpipelinename="+pipeline+"&zcomponentname=nop&zcomponentkind=receiver")
+	}
+
+	assert.Equal(t, [][2]string{{"logs", "logs"}, {"metrics", "metrics"}, {"traces", "traces"}},
+		srv.host.getComponentPipelinesProperties("receiver", "nop"))
+	assert.Empty(t, srv.host.getComponentPipelinesProperties("exporter", "unknown"))
+
+	resp, err = http.Get(server.URL + "/debug/pipelinez?zpipelinename=traces&zcomponentname=nop&zcomponentkind=exporter")
+	require.NoError(t, err)
+	body, err = ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	assert.Contains(t, string(body), "Used by pipelines")
+}